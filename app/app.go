@@ -86,6 +86,7 @@ import (
 	ibcporttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/spf13/cast"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmjson "github.com/tendermint/tendermint/libs/json"
@@ -97,6 +98,7 @@ import (
 	"github.com/ignite-hq/cli/ignite/pkg/openapiconsole"
 
 	"github.com/b9lab/checkers/app/upgrades/v1tov2"
+	"github.com/b9lab/checkers/app/upgrades/v2tov3"
 	"github.com/b9lab/checkers/docs"
 
 	checkersmodule "github.com/b9lab/checkers/x/checkers"
@@ -161,14 +163,15 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
-		checkersmoduletypes.ModuleName: nil,
+		authtypes.FeeCollectorName:        nil,
+		distrtypes.ModuleName:             nil,
+		minttypes.ModuleName:              {authtypes.Minter},
+		stakingtypes.BondedPoolName:       {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:               {authtypes.Burner},
+		ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		checkersmoduletypes.ModuleName:    nil,
+		checkersmoduletypes.BetEscrowName: nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -237,6 +240,8 @@ type App struct {
 	sm *module.SimulationManager
 	// The configurator is used only by upgrade handlers
 	configurator module.Configurator
+	// The client context that reaches the node, set once the node has started
+	nodeClientCtx client.Context
 }
 
 // New returns a reference to an initialized blockchain app
@@ -400,6 +405,7 @@ func NewApp(
 
 	app.CheckersKeeper = *checkersmodulekeeper.NewKeeper(
 		app.BankKeeper,
+		app.DistrKeeper,
 		appCodec,
 		keys[checkersmoduletypes.StoreKey],
 		keys[checkersmoduletypes.MemStoreKey],
@@ -505,6 +511,8 @@ func NewApp(
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
+	// NOTE: Crisis module must occur last so that the invariants it asserts at genesis see
+	// the state of all other modules.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -516,7 +524,6 @@ func NewApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		ibchost.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -526,6 +533,7 @@ func NewApp(
 		feegrant.ModuleName,
 		checkersmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
+		crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -714,6 +722,14 @@ func (app *App) RegisterTxService(clientCtx client.Context) {
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
+	app.nodeClientCtx = clientCtx
+}
+
+// RegisterGRPCServer implements the Application.RegisterGRPCServer method. On top of the query router, it
+// registers the streaming services, which are served from the node's event bus.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	checkersmodule.AppModuleBasic{}.RegisterWatchService(app.nodeClientCtx, server)
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -758,6 +774,12 @@ func (app *App) setupUpgradeHandlers() {
 			return app.mm.RunMigrations(ctx, app.configurator, vm)
 		},
 	)
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v2tov3.UpgradeName,
+		func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+			return app.mm.RunMigrations(ctx, app.configurator, vm)
+		},
+	)
 
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
@@ -775,6 +797,9 @@ func (app *App) setupUpgradeHandlers() {
 
 	switch upgradeInfo.Name {
 	case v1tov2.UpgradeName:
+	case v2tov3.UpgradeName:
+		// No store is added or deleted, the checkers module migrates its own store in place
+		storeUpgrades = &storetypes.StoreUpgrades{}
 	}
 
 	if storeUpgrades != nil {
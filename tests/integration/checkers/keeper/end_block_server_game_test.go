@@ -15,6 +15,7 @@ func (suite *IntegrationTestSuite) TestForfeitUnplayedNotPaid() {
 	suite.Require().True(found)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
@@ -44,6 +45,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefunded() {
 	game1, found := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
@@ -74,14 +76,15 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 	game1, found := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 11)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -91,7 +94,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[10]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
@@ -103,12 +106,10 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
-		Denom:   "coin",
 	})
 	suite.RequireBankBalance(balCarol, carol)
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
@@ -122,6 +123,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEvenZero() {
 	keeper := suite.app.CheckersKeeper
 	game1, _ := keeper.GetStoredGame(suite.ctx, "1")
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
@@ -140,12 +142,10 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEvenZero() {
 func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
-		Denom:   "coin",
 	})
 	suite.RequireBankBalance(balCarol, carol)
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
@@ -159,13 +159,14 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 	keeper := suite.app.CheckersKeeper
 	game1, _ := keeper.GetStoredGame(suite.ctx, "1")
 	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 
 	keeper.ForfeitExpiredGames(goCtx)
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 11)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -175,7 +176,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[10]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
@@ -208,6 +209,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaid() {
 	suite.Require().True(found)
 	oldDeadline := types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
@@ -247,13 +249,14 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 	suite.Require().True(found)
 	oldDeadline := types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 12)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -263,7 +266,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[10]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
@@ -275,12 +278,10 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Red:     bob,
 		Black:   carol,
-		Wager:   0,
-		Denom:   "coin",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   carol,
@@ -302,6 +303,7 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEvenZero() {
 	game1, _ := keeper.GetStoredGame(suite.ctx, "1")
 	oldDeadline := types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 
 	suite.RequireBankBalance(balAlice, alice)
@@ -320,12 +322,10 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEvenZero() {
 func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Red:     bob,
 		Black:   carol,
-		Wager:   0,
-		Denom:   "coin",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   carol,
@@ -347,13 +347,14 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedvenZero
 	game1, _ := keeper.GetStoredGame(suite.ctx, "1")
 	oldDeadline := types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(suite.ctx, &game1)
 	keeper.SetStoredGame(suite.ctx, game1)
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 12)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -363,7 +364,7 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedvenZero
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[10]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
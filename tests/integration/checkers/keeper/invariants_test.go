@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *IntegrationTestSuite) TestInvariantsHoldAfterMoves() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	invariant := keeper.AllInvariants(suite.app.CheckersKeeper)
+	msg, broken := invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msg, broken = invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	msg, broken = invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+	suite.msgServer.Resign(goCtx, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	msg, broken = invariant(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *IntegrationTestSuite) TestEscrowSolvencyInvariantBroken() {
+	suite.setupSuiteWithOneGameForPlayMove()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	aliceAddress, _ := sdk.AccAddressFromBech32(alice)
+	suite.Require().Nil(suite.app.BankKeeper.SendCoinsFromAccountToModule(
+		suite.ctx, aliceAddress, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	msg, broken := keeper.EscrowSolvencyInvariant(suite.app.CheckersKeeper)(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Equal("checkers: escrow-solvency invariant\n"+
+		"\tmodule account balance: 46stake\n"+
+		"\tsum of escrowed wagers and prize pools: 45stake\n\n", msg)
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -62,6 +63,27 @@ func (suite *IntegrationTestSuite) SetupTest() {
 	suite.queryClient = queryClient
 }
 
+// createLiveGame creates the game and has the players other than its creator accept it, so that it goes live.
+func (suite *IntegrationTestSuite) createLiveGame(goCtx context.Context, msg *types.MsgCreateGame) (*types.MsgCreateGameResponse, error) {
+	createResponse, err := suite.msgServer.CreateGame(goCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+	for i, player := range []string{msg.Black, msg.Red} {
+		if player == msg.Creator || (i == 1 && msg.Red == msg.Black) {
+			continue
+		}
+		_, err = suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+			Creator:   player,
+			GameIndex: createResponse.GameIndex,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return createResponse, nil
+}
+
 func makeBalance(address string, balance int64, denom string) banktypes.Balance {
 	return banktypes.Balance{
 		Address: address,
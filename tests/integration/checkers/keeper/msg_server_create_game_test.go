@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/b9lab/checkers/x/checkers/testutil"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,32 +13,36 @@ func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	keeper := suite.app.CheckersKeeper
 	systemInfo, found := keeper.GetSystemInfo(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found1)
 	suite.Require().EqualValues(types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(0),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(suite.ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       uint64(0),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(suite.ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game1)
 }
 
@@ -52,8 +57,7 @@ func (suite *IntegrationTestSuite) TestCreateGameDidNotPay() {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
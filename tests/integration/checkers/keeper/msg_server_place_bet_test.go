@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *IntegrationTestSuite) setupSuiteWithOneBetOnBlack() {
+	suite.setupSuiteWithOneGameForRejectGame()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	_, err := suite.msgServer.PlaceBet(goCtx, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	suite.Require().Nil(err)
+}
+
+func (suite *IntegrationTestSuite) TestPlaceBetEscrowedApart() {
+	suite.setupSuiteWithOneBetOnBlack()
+	betEscrowAddress := authtypes.NewModuleAddress(types.BetEscrowName).String()
+	suite.RequireBankBalance(balAlice-10, alice)
+	suite.RequireBankBalance(10, betEscrowAddress)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+	msg, broken := keeper.AllInvariants(suite.app.CheckersKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *IntegrationTestSuite) TestRejectGameRefundsBet() {
+	suite.setupSuiteWithOneBetOnBlack()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	_, err := suite.msgServer.RejectGame(goCtx, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(0, authtypes.NewModuleAddress(types.BetEscrowName).String())
+	msg, broken := keeper.AllInvariants(suite.app.CheckersKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *IntegrationTestSuite) TestResignPaysWinningBet() {
+	suite.setupSuiteWithOneBetOnBlack()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	_, err := suite.msgServer.Resign(goCtx, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(0, authtypes.NewModuleAddress(types.BetEscrowName).String())
+	msg, broken := keeper.AllInvariants(suite.app.CheckersKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
@@ -9,12 +9,11 @@ import (
 func (suite *IntegrationTestSuite) setupSuiteWithOneGameForPlayMove() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -33,25 +32,28 @@ func (suite *IntegrationTestSuite) TestPlayMoveSavedGame() {
 	systemInfo, found := keeper.GetSystemInfo(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(1),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(suite.ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       uint64(1),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(suite.ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHistory: []uint64{928273061913851149},
+		PositionHash:    928273061913851149,
 	}, game1)
 }
 
@@ -79,12 +81,10 @@ func (suite *IntegrationTestSuite) TestPlayMovePlayerPaid() {
 func (suite *IntegrationTestSuite) TestPlayMovePlayerPaidEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
-		Denom:   "stake",
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
@@ -107,27 +107,18 @@ func (suite *IntegrationTestSuite) TestPlayMovePlayerPaidEvenZero() {
 func (suite *IntegrationTestSuite) TestPlayMoveCannotPayFails() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createResponse, err := suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   balCarol + 1,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", int64(balCarol+1))),
 	})
+	suite.Require().Nil(createResponse)
+	suite.Require().Equal("spendable 10000000coin,10000000stake is below 10000001stake: black cannot pay the wager", err.Error())
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
 	suite.RequireBankBalance(balCarol, carol)
 	suite.RequireBankBalance(0, checkersModuleAddress)
-	playMoveResponse, err := suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   carol,
-		GameIndex: "2",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	suite.Require().Nil(playMoveResponse)
-	suite.Require().Equal("black cannot pay the wager: 10000000stake is smaller than 10000001stake: insufficient funds", err.Error())
 }
 
 func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
@@ -143,9 +134,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 9)
 
-	playEvent := events[3]
+	playEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -155,10 +146,11 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 			{Key: "captured-y", Value: "-1"},
 			{Key: "winner", Value: "*"},
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+			{Key: "position-hash", Value: "928273061913851149"},
 		},
 	}, playEvent)
 
-	transferEvent := events[5]
+	transferEvent := events[8]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: checkersModuleAddress},
@@ -170,12 +162,10 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
-		Denom:   "stake",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   carol,
@@ -187,9 +177,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 9)
 
-	playEvent := events[3]
+	playEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -199,10 +189,11 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 			{Key: "captured-y", Value: "-1"},
 			{Key: "winner", Value: "*"},
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+			{Key: "position-hash", Value: "928273061913851149"},
 		},
 	}, playEvent)
 
-	transferEvent := events[5]
+	transferEvent := events[8]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: checkersModuleAddress},
@@ -243,35 +234,18 @@ func (suite *IntegrationTestSuite) TestPlayMove2PlayerPaid() {
 func (suite *IntegrationTestSuite) TestPlayMove2CannotPayFails() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	createResponse, err := suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   balCarol + 1,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", int64(balCarol+1))),
 	})
+	suite.Require().Nil(createResponse)
+	suite.Require().Equal("spendable 10000000coin,10000000stake is below 10000001stake: red cannot pay the wager", err.Error())
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
 	suite.RequireBankBalance(balCarol, carol)
 	suite.RequireBankBalance(0, checkersModuleAddress)
-	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   bob,
-		GameIndex: "2",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	playMoveResponse, err := suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   carol,
-		GameIndex: "2",
-		FromX:     0,
-		FromY:     5,
-		ToX:       1,
-		ToY:       4,
-	})
-	suite.Require().Nil(playMoveResponse)
-	suite.Require().Equal("red cannot pay the wager: 10000000stake is smaller than 10000001stake: insufficient funds", err.Error())
 }
 
 func (suite *IntegrationTestSuite) TestPlayMove3DidNotPay() {
@@ -323,12 +297,11 @@ func (suite *IntegrationTestSuite) TestPlayMoveToWinnerBankPaid() {
 func (suite *IntegrationTestSuite) TestPlayMoveToWinnerBankPaidDifferentTokens() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalanceWithDenom(0, "coin", alice)
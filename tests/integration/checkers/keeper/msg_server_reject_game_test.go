@@ -8,12 +8,11 @@ import (
 func (suite *IntegrationTestSuite) setupSuiteWithOneGameForRejectGame() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -95,9 +94,9 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 		GameIndex: "1",
 	})
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 11)
 
-	rejectEvent := events[2]
+	rejectEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -106,7 +105,7 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 		},
 	}, rejectEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[10]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
@@ -118,12 +117,10 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   0,
-		Denom:   "coin",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   bob,
@@ -147,12 +144,10 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZero() {
 func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+	suite.createLiveGame(goCtx, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   0,
-		Denom:   "coin",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   bob,
@@ -168,9 +163,9 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 11)
 
-	rejectEvent := events[2]
+	rejectEvent := events[6]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -179,7 +174,7 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 		},
 	}, rejectEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[10]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
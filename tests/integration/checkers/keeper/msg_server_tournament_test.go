@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *IntegrationTestSuite) setupSuiteWithOneStartedTournament() {
+	suite.setupSuiteWithBalances()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.CreateTournament(goCtx, &types.MsgCreateTournament{
+		Creator:    alice,
+		Format:     types.TournamentFormatElimination,
+		EntryFee:   sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		MaxPlayers: 2,
+	})
+	for _, player := range []string{bob, carol} {
+		suite.msgServer.JoinTournament(goCtx, &types.MsgJoinTournament{
+			Creator:         player,
+			TournamentIndex: "1",
+		})
+	}
+	suite.msgServer.StartTournament(goCtx, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+}
+
+func (suite *IntegrationTestSuite) TestJoinTournamentCollectsEntryFees() {
+	suite.setupSuiteWithOneStartedTournament()
+	suite.RequireBankBalance(balBob-10, bob)
+	suite.RequireBankBalance(balCarol-10, carol)
+	suite.RequireBankBalance(20, checkersModuleAddress)
+	msg, broken := keeper.AllInvariants(suite.app.CheckersKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
+
+func (suite *IntegrationTestSuite) TestTournamentPaysPrizeToWinner() {
+	suite.setupSuiteWithOneStartedTournament()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	checkersKeeper := suite.app.CheckersKeeper
+	game1, found := checkersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	checkersKeeper.InsertDeadlineQueue(suite.ctx, &game1)
+	checkersKeeper.SetStoredGame(suite.ctx, game1)
+	checkersKeeper.ForfeitExpiredGames(goCtx)
+	checkersKeeper.AdvanceTournaments(goCtx)
+
+	tournament, found := checkersKeeper.GetTournament(suite.ctx, "1")
+	suite.Require().True(found)
+	suite.Require().Equal(types.TournamentStatusFinished, tournament.Status)
+	suite.RequireBankBalance(balBob-10, bob)
+	suite.RequireBankBalance(balCarol+10, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+	msg, broken := keeper.AllInvariants(checkersKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
@@ -23,6 +23,23 @@ func CheckersKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 }
 
 func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper) (*keeper.Keeper, sdk.Context) {
+	return CheckersKeeperWithDistribution(t, bank, nil)
+}
+
+// CheckersKeeperWithDistribution also mocks the distribution keeper, which takes the fees for the community pool.
+func CheckersKeeperWithDistribution(t testing.TB, bank *testutil.MockBankEscrowKeeper,
+	distribution *testutil.MockDistributionKeeper) (*keeper.Keeper, sdk.Context) {
+	k, ctx, _ := checkersKeeper(t, bank, distribution)
+	return k, ctx
+}
+
+// CheckersKeeperWithStoreKey also returns the store key, to write raw values as a former version would have.
+func CheckersKeeperWithStoreKey(t testing.TB, bank *testutil.MockBankEscrowKeeper) (*keeper.Keeper, sdk.Context, sdk.StoreKey) {
+	return checkersKeeper(t, bank, nil)
+}
+
+func checkersKeeper(t testing.TB, bank *testutil.MockBankEscrowKeeper,
+	distribution *testutil.MockDistributionKeeper) (*keeper.Keeper, sdk.Context, sdk.StoreKey) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -43,6 +60,7 @@ func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper)
 	)
 	k := keeper.NewKeeper(
 		bank,
+		distribution,
 		cdc,
 		storeKey,
 		memStoreKey,
@@ -54,5 +72,5 @@ func CheckersKeeperWithMocks(t testing.TB, bank *testutil.MockBankEscrowKeeper)
 	// Initialize params
 	k.SetParams(ctx, types.DefaultParams())
 
-	return k, ctx
+	return k, ctx, storeKey
 }
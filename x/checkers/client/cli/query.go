@@ -33,6 +33,20 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPlayerInfo())
 	cmd.AddCommand(CmdShowPlayerInfo())
 	cmd.AddCommand(CmdShowLeaderboard())
+	cmd.AddCommand(CmdLegalMoves())
+	cmd.AddCommand(CmdGameMoves())
+	cmd.AddCommand(CmdGamePdn())
+	cmd.AddCommand(CmdOpenChallenges())
+	cmd.AddCommand(CmdShowPlayerRating())
+	cmd.AddCommand(CmdShowRatingLeaderboard())
+	cmd.AddCommand(CmdShowCollectedFees())
+	cmd.AddCommand(CmdShowTournament())
+	cmd.AddCommand(CmdShowTournamentStandings())
+	cmd.AddCommand(CmdShowGameBets())
+	cmd.AddCommand(CmdGamesByPlayer())
+	cmd.AddCommand(CmdShowArchivedGame())
+	cmd.AddCommand(CmdPlayerInvitations())
+	cmd.AddCommand(CmdWatch())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
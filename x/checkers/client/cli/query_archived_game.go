@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowArchivedGame() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-archived-game [game-index]",
+		Short: "shows a finished game from the archive",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argGameIndex := args[0]
+
+			params := &types.QueryGetArchivedGameRequest{
+				GameIndex: argGameIndex,
+			}
+
+			res, err := queryClient.ArchivedGame(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
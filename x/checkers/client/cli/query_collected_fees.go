@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowCollectedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-collected-fees",
+		Short: "shows the totals of the fees taken from winnings",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetCollectedFeesRequest{}
+
+			res, err := queryClient.CollectedFees(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowGameBets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-game-bets [game-index]",
+		Short: "shows the spectator bets on a game",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argGameIndex := args[0]
+
+			params := &types.QueryGetGameBetsRequest{
+				GameIndex: argGameIndex,
+			}
+
+			res, err := queryClient.GameBets(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
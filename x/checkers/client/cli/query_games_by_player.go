@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdGamesByPlayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "games-by-player [address] [pending|active|finished|all]",
+		Short: "list the games of a player, all of them by default",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqAddress := args[0]
+			reqStatus := types.PlayerGameStatusAll
+			if len(args) == 2 {
+				reqStatus = args[1]
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGamesByPlayerRequest{
+				Address:    reqAddress,
+				Status:     reqStatus,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GamesByPlayer(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowRatingLeaderboard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-rating-leaderboard",
+		Short: "shows the leaderboard ordered by rating",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRatingLeaderboardRequest{}
+
+			res, err := queryClient.RatingLeaderboard(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
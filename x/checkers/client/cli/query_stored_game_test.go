@@ -15,6 +15,7 @@ import (
 	"github.com/b9lab/checkers/testutil/network"
 	"github.com/b9lab/checkers/testutil/nullify"
 	"github.com/b9lab/checkers/x/checkers/client/cli"
+	"github.com/b9lab/checkers/x/checkers/rules"
 	"github.com/b9lab/checkers/x/checkers/types"
 )
 
@@ -28,15 +29,24 @@ func networkWithStoredGameObjects(t *testing.T, n int) (*network.Network, []type
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
 	for i := 0; i < n; i++ {
+		// Finished games, so that they need no escrow and stay out of the FIFO
 		storedGame := types.StoredGame{
-			Index: strconv.Itoa(i),
+			Index:       strconv.Itoa(i),
+			Winner:      rules.PieceStrings[rules.DRAW_PLAYER],
+			BeforeIndex: types.NoFifoIndex,
+			AfterIndex:  types.NoFifoIndex,
 		}
 		nullify.Fill(&storedGame)
+		storedGame.PackedBoard = rules.New().Pack()
 		state.StoredGameList = append(state.StoredGameList, storedGame)
 	}
 	buf, err := cfg.Codec.MarshalJSON(&state)
 	require.NoError(t, err)
 	cfg.GenesisState[types.ModuleName] = buf
+	// The queries spell out the board, which the genesis leaves packed
+	for i := range state.StoredGameList {
+		require.NoError(t, state.StoredGameList[i].DeriveBoard())
+	}
 	return network.New(t, cfg), state.StoredGameList
 }
 
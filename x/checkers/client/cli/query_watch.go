@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	FlagPlayer   = "player"
+	FlagGrpcAddr = "grpc-addr"
+)
+
+// The ANSI sequence that moves the cursor home and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+func CmdWatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [game-index]",
+		Short: "follow a live game, or with --player all the live games of a player, as the node sees them",
+		Long: `Follow a live game, or with --player all the live games of a player, as the node sees them.
+The updates are streamed from the gRPC server of the node, which is not the same address as --node.
+With --output json, it prints one update per line instead of rendering the board.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqGameIndex := ""
+			if len(args) > 0 {
+				reqGameIndex = args[0]
+			}
+			reqPlayer, err := cmd.Flags().GetString(FlagPlayer)
+			if err != nil {
+				return err
+			}
+			if (reqGameIndex == "") == (reqPlayer == "") {
+				return errors.New("watch either a game index or a player")
+			}
+			grpcAddr, err := cmd.Flags().GetString(FlagGrpcAddr)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// The client context only does unary calls, a stream needs its own connection
+			conn, err := grpc.Dial(grpcAddr, grpc.WithInsecure())
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			stream, err := types.NewWatchClient(conn).Watch(cmd.Context(), &types.QueryWatchRequest{
+				GameIndex: reqGameIndex,
+				Player:    reqPlayer,
+			})
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			clear := isTerminal(out)
+			for {
+				update, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if clientCtx.OutputFormat == "json" {
+					if err := clientCtx.PrintProto(update); err != nil {
+						return err
+					}
+					continue
+				}
+				if clear {
+					fmt.Fprint(out, clearScreen)
+				}
+				fmt.Fprint(out, RenderBoardUpdate(*update))
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagPlayer, "", "Watch all the games of this address instead of a single game")
+	cmd.Flags().String(FlagGrpcAddr, "localhost:9090", "The gRPC server of the node")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// isTerminal tells whether redrawing over the previous board makes sense.
+func isTerminal(out io.Writer) bool {
+	file, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// RenderBoardUpdate draws the board with its coordinates, followed by what happened and what comes next.
+func RenderBoardUpdate(update types.BoardUpdate) string {
+	var rendered strings.Builder
+	fmt.Fprintf(&rendered, "Game %s, %s at height %d\n", update.GameIndex, update.Kind, update.Height)
+	fmt.Fprintf(&rendered, "Black: %s\nRed:   %s\n\n", update.Black, update.Red)
+	rows := strings.Split(update.Board, rules.ROW_SEP)
+	rendered.WriteString("   ")
+	for x := range rows {
+		fmt.Fprintf(&rendered, "%2d", x)
+	}
+	rendered.WriteString("\n")
+	for y, row := range rows {
+		fmt.Fprintf(&rendered, "%2d ", y)
+		for _, square := range row {
+			if string(square) == rules.PieceStrings[rules.NO_PLAYER] {
+				square = '.'
+			}
+			fmt.Fprintf(&rendered, " %c", square)
+		}
+		rendered.WriteString("\n")
+	}
+	rendered.WriteString("\n")
+	if len(update.Positions) > 0 {
+		fmt.Fprintf(&rendered, "Moved: %s\n", types.FormatPositions(update.Positions))
+	}
+	if len(update.Captured) > 0 {
+		fmt.Fprintf(&rendered, "Captured: %s\n", types.FormatPositions(update.Captured))
+	}
+	switch {
+	case update.Kind == types.BoardUpdateKindForfeit && update.Winner == rules.PieceStrings[rules.NO_PLAYER]:
+		rendered.WriteString("Forfeited before it was played, no winner\n")
+	case update.Kind == types.BoardUpdateKindReject:
+		rendered.WriteString("Rejected, no winner\n")
+	case update.Kind == types.BoardUpdateKindResign:
+		fmt.Fprintf(&rendered, "Resigned, winner: %s\n", update.Winner)
+	case update.Winner == rules.PieceStrings[rules.NO_PLAYER]:
+		fmt.Fprintf(&rendered, "Turn: %s, until %s\n", update.Turn, update.Deadline)
+	case update.Winner == rules.PieceStrings[rules.DRAW_PLAYER]:
+		rendered.WriteString("Draw\n")
+	default:
+		fmt.Fprintf(&rendered, "Winner: %s\n", update.Winner)
+	}
+	return rendered.String()
+}
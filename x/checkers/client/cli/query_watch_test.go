@@ -0,0 +1,153 @@
+package cli_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/client/cli"
+	"github.com/b9lab/checkers/x/checkers/types"
+)
+
+// execWatch runs the watch command until the timeout, as it only stops by itself when the node goes away.
+func execWatch(clientCtx client.Context, args []string, timeout time.Duration) (string, error) {
+	cmd := cli.CmdWatch()
+	cmd.SetArgs(args)
+	_, out := testutil.ApplyMockIO(cmd)
+	clientCtx = clientCtx.WithOutput(out)
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), client.ClientContextKey, &clientCtx), timeout)
+	defer cancel()
+	err := cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestWatchStoredGame(t *testing.T) {
+	net, objs := networkWithStoredGameObjects(t, 2)
+	val := net.Validators[0]
+
+	out, err := execWatch(val.ClientCtx, []string{
+		objs[1].Index,
+		fmt.Sprintf("--%s=%s", cli.FlagGrpcAddr, val.AppConfig.GRPC.Address),
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}, 3*time.Second)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// Only the snapshot, as the game is finished, then the deadline
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Equal(t, "Error: rpc error: code = DeadlineExceeded desc = context deadline exceeded", lines[1])
+	var update types.BoardUpdate
+	require.NoError(t, net.Config.Codec.UnmarshalJSON([]byte(lines[0]), &update))
+	require.Less(t, int64(0), update.Height)
+	expected, err := types.NewBoardUpdateFromStoredGame(objs[1], update.Height)
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(&expected), nullify.Fill(&update))
+}
+
+func TestWatchUnknownStoredGame(t *testing.T) {
+	net, _ := networkWithStoredGameObjects(t, 2)
+	val := net.Validators[0]
+
+	_, err := execWatch(val.ClientCtx, []string{
+		"100000",
+		fmt.Sprintf("--%s=%s", cli.FlagGrpcAddr, val.AppConfig.GRPC.Address),
+	}, 3*time.Second)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWatchNeitherGameNorPlayer(t *testing.T) {
+	_, err := execWatch(client.Context{}, []string{}, time.Second)
+	require.EqualError(t, err, "watch either a game index or a player")
+}
+
+func TestRenderBoardUpdate(t *testing.T) {
+	require.Equal(t, `Game 1, move at height 5
+Black: cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3
+Red:   cosmos1xyxs3skf3f4jfqeuv89yyaqvjc6lffavxqhc8g
+
+    0 1 2 3 4 5 6 7
+ 0  . b . b . b . b
+ 1  b . b . b . b .
+ 2  . . . b . b . b
+ 3  . . b . . . . .
+ 4  . . . . . . . .
+ 5  r . r . r . r .
+ 6  . r . r . r . r
+ 7  r . r . r . r .
+
+Moved: 1,2|2,3
+Turn: r, until 2006-01-02 15:04:05.999999999 +0000 UTC
+`, cli.RenderBoardUpdate(types.BoardUpdate{
+		Kind:      types.BoardUpdateKindMove,
+		GameIndex: "1",
+		Black:     "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+		Red:       "cosmos1xyxs3skf3f4jfqeuv89yyaqvjc6lffavxqhc8g",
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:      "r",
+		Deadline:  types.DeadlineLayout,
+		Winner:    "*",
+		Height:    5,
+	}))
+}
+
+func TestRenderBoardUpdateWinner(t *testing.T) {
+	rendered := cli.RenderBoardUpdate(types.BoardUpdate{
+		Kind:      types.BoardUpdateKindMove,
+		GameIndex: "1",
+		Positions: []types.Position{{X: 1, Y: 6}, {X: 3, Y: 4}},
+		Captured:  []types.Position{{X: 2, Y: 5}},
+		Board:     "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********",
+		Winner:    "b",
+	})
+	require.True(t, strings.HasSuffix(rendered, "\nMoved: 1,6|3,4\nCaptured: 2,5\nWinner: b\n"))
+}
+
+func TestRenderBoardUpdateForfeitUnplayed(t *testing.T) {
+	rendered := cli.RenderBoardUpdate(types.BoardUpdate{
+		Kind:      types.BoardUpdateKindForfeit,
+		GameIndex: "1",
+		Board:     "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Winner:    "*",
+	})
+	require.True(t, strings.HasSuffix(rendered, "\nForfeited before it was played, no winner\n"))
+}
+
+func TestRenderBoardUpdateResign(t *testing.T) {
+	rendered := cli.RenderBoardUpdate(types.BoardUpdate{
+		Kind:      types.BoardUpdateKindResign,
+		GameIndex: "1",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		Winner:    "b",
+	})
+	require.True(t, strings.HasSuffix(rendered, "\nResigned, winner: b\n"))
+}
+
+func TestRenderBoardUpdateDraw(t *testing.T) {
+	rendered := cli.RenderBoardUpdate(types.BoardUpdate{
+		Kind:      types.BoardUpdateKindDraw,
+		GameIndex: "1",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		Winner:    "d",
+	})
+	require.True(t, strings.HasSuffix(rendered, "\nDraw\n"))
+}
+
+func TestRenderBoardUpdateReject(t *testing.T) {
+	rendered := cli.RenderBoardUpdate(types.BoardUpdate{
+		Kind:      types.BoardUpdateKindReject,
+		GameIndex: "1",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Winner:    "*",
+	})
+	require.True(t, strings.HasSuffix(rendered, "\nRejected, no winner\n"))
+}
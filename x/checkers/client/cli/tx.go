@@ -33,6 +33,20 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateGame())
 	cmd.AddCommand(CmdPlayMove())
 	cmd.AddCommand(CmdRejectGame())
+	cmd.AddCommand(CmdOfferDraw())
+	cmd.AddCommand(CmdAcceptDraw())
+	cmd.AddCommand(CmdDeclineDraw())
+	cmd.AddCommand(CmdResign())
+	cmd.AddCommand(CmdPlayMoves())
+	cmd.AddCommand(CmdOpenChallenge())
+	cmd.AddCommand(CmdAcceptChallenge())
+	cmd.AddCommand(CmdCreateTournament())
+	cmd.AddCommand(CmdJoinTournament())
+	cmd.AddCommand(CmdStartTournament())
+	cmd.AddCommand(CmdPlaceBet())
+	cmd.AddCommand(CmdProposeRematch())
+	cmd.AddCommand(CmdAcceptRematch())
+	cmd.AddCommand(CmdAcceptGame())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -7,24 +7,47 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagMoveTime  = "move-time"
+	FlagTotalTime = "total-time"
+	FlagIncrement = "increment"
+)
+
 func CmdCreateGame() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-game [black] [red] [wager] [denom]",
-		Short: "Broadcast message createGame",
-		Args:  cobra.ExactArgs(4),
+		Use:   "create-game [black] [red] [wager] [variant]",
+		Short: "Broadcast message createGame, the wager is a list of coins like 10stake,5ibc/27394F..., the variant is optional",
+		Args:  cobra.RangeArgs(3, 4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argBlack := args[0]
 			argRed := args[1]
-			argWager, err := strconv.ParseUint(args[2], 10, 64)
+			argWager, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			argVariant := ""
+			if len(args) > 3 {
+				argVariant = args[3]
+			}
+
+			moveTime, err := cmd.Flags().GetDuration(FlagMoveTime)
+			if err != nil {
+				return err
+			}
+			totalTime, err := cmd.Flags().GetDuration(FlagTotalTime)
+			if err != nil {
+				return err
+			}
+			increment, err := cmd.Flags().GetDuration(FlagIncrement)
 			if err != nil {
 				return err
 			}
-			argDenom := args[3]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -36,7 +59,12 @@ func CmdCreateGame() *cobra.Command {
 				argBlack,
 				argRed,
 				argWager,
-				argDenom,
+				argVariant,
+				types.TimeControl{
+					MoveTime:  uint64(moveTime.Seconds()),
+					TotalTime: uint64(totalTime.Seconds()),
+					Increment: uint64(increment.Seconds()),
+				},
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -45,6 +73,9 @@ func CmdCreateGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Duration(FlagMoveTime, 0, "Time limit for each move, e.g. 30s")
+	cmd.Flags().Duration(FlagTotalTime, 0, "Total time on the clock of each player, e.g. 5m")
+	cmd.Flags().Duration(FlagIncrement, 0, "Time added to the clock after each move, with a total time only")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCreateTournament() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-tournament [format] [entry-fee] [max-players] [rounds] [prize-shares-bps] [variant]",
+		Short: "Broadcast message createTournament, the format is swiss or elimination, the entry fee is a list of coins like 10stake, the prize shares are like 7000,3000 and the variant is optional",
+		Args:  cobra.RangeArgs(5, 6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argFormat := args[0]
+			argEntryFee, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			argMaxPlayers, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argRounds, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argPrizeSharesBps := []uint64{}
+			if args[4] != "" {
+				for _, share := range strings.Split(args[4], ",") {
+					shareBps, err := strconv.ParseUint(share, 10, 64)
+					if err != nil {
+						return err
+					}
+					argPrizeSharesBps = append(argPrizeSharesBps, shareBps)
+				}
+			}
+			argVariant := ""
+			if len(args) > 5 {
+				argVariant = args[5]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateTournament(
+				clientCtx.GetFromAddress().String(),
+				argFormat,
+				argEntryFee,
+				argMaxPlayers,
+				argRounds,
+				argVariant,
+				argPrizeSharesBps,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdOpenChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-challenge [wager] [color] [min-rating] [max-rating] [variant]",
+		Short: "Broadcast message openChallenge, the wager is a list of coins like 10stake, the variant is optional",
+		Args:  cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argWager, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+			argColor := args[1]
+			argMinRating, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argMaxRating, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argVariant := ""
+			if len(args) > 4 {
+				argVariant = args[4]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenChallenge(
+				clientCtx.GetFromAddress().String(),
+				argWager,
+				argColor,
+				argMinRating,
+				argMaxRating,
+				argVariant,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
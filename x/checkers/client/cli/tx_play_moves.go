@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdPlayMoves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "play-moves [game-index] [x,y] [x,y] ...",
+		Short: "Broadcast message playMoves",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			argPositions := make([]types.Position, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				coordinates := strings.Split(arg, listSeparator)
+				if len(coordinates) != 2 {
+					return fmt.Errorf("position must be formatted as x,y: %s", arg)
+				}
+				argX, err := cast.ToUint64E(coordinates[0])
+				if err != nil {
+					return err
+				}
+				argY, err := cast.ToUint64E(coordinates[1])
+				if err != nil {
+					return err
+				}
+				argPositions = append(argPositions, types.Position{
+					X: argX,
+					Y: argY,
+				})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlayMoves(
+				clientCtx.GetFromAddress().String(),
+				argGameIndex,
+				argPositions,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
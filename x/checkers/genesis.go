@@ -2,6 +2,7 @@ package checkers
 
 import (
 	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/rules"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,6 +15,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the storedGame
 	for _, elem := range genState.StoredGameList {
 		k.SetStoredGame(ctx, elem)
+		k.SetPlayerGames(ctx, &elem)
+		if elem.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+			continue
+		}
+		k.AddActiveGame(ctx, &elem)
+		if elem.IsPending() {
+			k.InsertInvitationDeadlineQueue(ctx, &elem)
+		} else {
+			k.InsertDeadlineQueue(ctx, &elem)
+		}
 	}
 	// Set all the playerInfo
 	for _, elem := range genState.PlayerInfoList {
@@ -21,6 +32,35 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 	// Set if defined
 	k.SetLeaderboard(ctx, genState.Leaderboard)
+	// Set all the gameMove
+	for _, elem := range genState.GameMoveList {
+		k.SetGameMove(ctx, elem)
+	}
+	// Set all the challenge
+	for _, elem := range genState.ChallengeList {
+		k.SetChallenge(ctx, elem)
+	}
+	// Set all the playerRating
+	for _, elem := range genState.PlayerRatingList {
+		k.SetPlayerRating(ctx, elem)
+	}
+	// Set if defined
+	k.SetRatingLeaderboard(ctx, genState.RatingLeaderboard)
+	// Set if defined
+	k.SetCollectedFees(ctx, genState.CollectedFees)
+	// Set all the tournament
+	for _, elem := range genState.TournamentList {
+		k.SetTournament(ctx, elem)
+	}
+	// Set all the gameBets
+	for _, elem := range genState.GameBetsList {
+		k.SetGameBets(ctx, elem)
+	}
+	// Set all the archivedGame
+	for _, elem := range genState.ArchivedGameList {
+		k.SetArchivedGame(ctx, elem)
+		k.SetArchivedPlayerGames(ctx, &elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -42,6 +82,22 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if found {
 		genesis.Leaderboard = leaderboard
 	}
+	genesis.GameMoveList = k.GetAllGameMove(ctx)
+	genesis.ChallengeList = k.GetAllChallenge(ctx)
+	genesis.PlayerRatingList = k.GetAllPlayerRating(ctx)
+	// Get all ratingLeaderboard
+	ratingLeaderboard, found := k.GetRatingLeaderboard(ctx)
+	if found {
+		genesis.RatingLeaderboard = ratingLeaderboard
+	}
+	// Get all collectedFees
+	collectedFees, found := k.GetCollectedFees(ctx)
+	if found {
+		genesis.CollectedFees = collectedFees
+	}
+	genesis.TournamentList = k.GetAllTournament(ctx)
+	genesis.GameBetsList = k.GetAllGameBets(ctx)
+	genesis.ArchivedGameList = k.GetAllArchivedGame(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
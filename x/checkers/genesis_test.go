@@ -7,6 +7,7 @@ import (
 	"github.com/b9lab/checkers/testutil/nullify"
 	"github.com/b9lab/checkers/x/checkers"
 	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,6 +44,75 @@ func TestGenesis(t *testing.T) {
 				},
 			},
 		},
+		GameMoveList: []types.GameMove{
+			{
+				GameIndex:  "0",
+				MoveNumber: 0,
+			},
+			{
+				GameIndex:  "1",
+				MoveNumber: 0,
+			},
+		},
+		ChallengeList: []types.Challenge{
+			{
+				Index: "0",
+			},
+			{
+				Index: "1",
+			},
+		},
+		PlayerRatingList: []types.PlayerRating{
+			{
+				Index:           "0",
+				Rating:          1500,
+				RatingDeviation: 350,
+			},
+			{
+				Index:           "1",
+				Rating:          1620,
+				RatingDeviation: 290,
+			},
+		},
+		RatingLeaderboard: types.RatingLeaderboard{
+			Players: []types.RatedPlayer{
+				{
+					PlayerAddress: "cosmos456",
+				},
+				{
+					PlayerAddress: "cosmos123",
+				},
+			},
+		},
+		CollectedFees: types.CollectedFees{
+			Total: sdk.NewCoins(sdk.NewInt64Coin("stake", 12)),
+		},
+		TournamentList: []types.Tournament{
+			{
+				Index: "1",
+			},
+			{
+				Index: "2",
+			},
+		},
+		GameBetsList: []types.GameBets{
+			{
+				GameIndex: "0",
+			},
+			{
+				GameIndex: "1",
+			},
+		},
+		ArchivedGameList: []types.ArchivedGame{
+			{
+				Index:     "2",
+				EndHeight: 3,
+			},
+			{
+				Index:     "3",
+				EndHeight: 3,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -58,5 +128,13 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.StoredGameList, got.StoredGameList)
 	require.ElementsMatch(t, genesisState.PlayerInfoList, got.PlayerInfoList)
 	require.Equal(t, genesisState.Leaderboard, got.Leaderboard)
+	require.ElementsMatch(t, genesisState.GameMoveList, got.GameMoveList)
+	require.ElementsMatch(t, genesisState.ChallengeList, got.ChallengeList)
+	require.ElementsMatch(t, genesisState.PlayerRatingList, got.PlayerRatingList)
+	require.Equal(t, genesisState.RatingLeaderboard, got.RatingLeaderboard)
+	require.Equal(t, genesisState.CollectedFees, got.CollectedFees)
+	require.ElementsMatch(t, genesisState.TournamentList, got.TournamentList)
+	require.ElementsMatch(t, genesisState.GameBetsList, got.GameBetsList)
+	require.ElementsMatch(t, genesisState.ArchivedGameList, got.ArchivedGameList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetActiveGameCount returns how many unfinished games the player takes part in, pending ones included
+func (k Keeper) GetActiveGameCount(ctx sdk.Context, player string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActiveGameCountKeyPrefix))
+	b := store.Get(types.ActiveGameCountKey(player))
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (k Keeper) setActiveGameCount(ctx sdk.Context, player string, count uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ActiveGameCountKeyPrefix))
+	if count == 0 {
+		store.Delete(types.ActiveGameCountKey(player))
+		return
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, count)
+	store.Set(types.ActiveGameCountKey(player), b)
+}
+
+// getDistinctPlayers counts a player once when they play both sides.
+func getDistinctPlayers(black string, red string) []string {
+	if black == red {
+		return []string{black}
+	}
+	return []string{black, red}
+}
+
+func (k Keeper) AddActiveGame(ctx sdk.Context, storedGame *types.StoredGame) {
+	for _, player := range getDistinctPlayers(storedGame.Black, storedGame.Red) {
+		k.setActiveGameCount(ctx, player, k.GetActiveGameCount(ctx, player)+1)
+	}
+}
+
+func (k Keeper) RemoveActiveGame(ctx sdk.Context, storedGame *types.StoredGame) {
+	for _, player := range getDistinctPlayers(storedGame.Black, storedGame.Red) {
+		count := k.GetActiveGameCount(ctx, player)
+		if count == 0 {
+			panic("Active game count is already 0 for " + player)
+		}
+		k.setActiveGameCount(ctx, player, count-1)
+	}
+}
+
+func (k Keeper) ValidateActiveGameCount(ctx sdk.Context, black string, red string) error {
+	maxGames := k.MaxGamesPerPlayer(ctx)
+	if maxGames == 0 {
+		return nil
+	}
+	for _, player := range getDistinctPlayers(black, red) {
+		if maxGames <= k.GetActiveGameCount(ctx, player) {
+			return sdkerrors.Wrapf(types.ErrTooManyActiveGames, "%s", player)
+		}
+	}
+	return nil
+}
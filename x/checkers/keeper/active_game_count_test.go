@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGameAddsActiveGames(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   carol,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.EqualValues(t, 0, keeper.GetActiveGameCount(ctx, alice))
+	require.EqualValues(t, 1, keeper.GetActiveGameCount(ctx, bob))
+	require.EqualValues(t, 2, keeper.GetActiveGameCount(ctx, carol))
+}
+
+func TestRejectGameRemovesActiveGames(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.EqualValues(t, 0, keeper.GetActiveGameCount(ctx, bob))
+	require.EqualValues(t, 0, keeper.GetActiveGameCount(ctx, carol))
+}
+
+func TestCreateGameTooManyActiveGames(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.MaxGamesPerPlayer = 1
+	keeper.SetParams(ctx, params)
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, carol+": player has too many active games: %s")
+}
+
+func TestInitGenesisCountsActiveGames(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	genesis := types.DefaultGenesis()
+	genesis.StoredGameList = []types.StoredGame{
+		{Index: "1", Black: bob, Red: carol, Winner: "*", Deadline: types.FormatDeadline(ctx.BlockTime())},
+		{Index: "2", Black: bob, Red: alice, Winner: "b"},
+		{Index: "3", Black: alice, Red: carol, Winner: "*", RedPending: true, Deadline: types.FormatDeadline(ctx.BlockTime())},
+	}
+	checkers.InitGenesis(ctx, *k, *genesis)
+	// The pending game counts too
+	require.EqualValues(t, 1, k.GetActiveGameCount(ctx, alice))
+	require.EqualValues(t, 1, k.GetActiveGameCount(ctx, bob))
+	require.EqualValues(t, 2, k.GetActiveGameCount(ctx, carol))
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetArchivedGame set a specific archivedGame in the store from its index, and orders it by its end height
+func (k Keeper) SetArchivedGame(ctx sdk.Context, archivedGame types.ArchivedGame) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedGameKeyPrefix))
+	b := k.cdc.MustMarshal(&archivedGame)
+	store.Set(types.ArchivedGameKey(
+		archivedGame.Index,
+	), b)
+	byHeightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedGameByHeightKeyPrefix))
+	byHeightStore.Set(types.ArchivedGameByHeightKey(
+		archivedGame.EndHeight,
+		archivedGame.Index,
+	), []byte(archivedGame.Index))
+}
+
+// GetArchivedGame returns a archivedGame from its index
+func (k Keeper) GetArchivedGame(
+	ctx sdk.Context,
+	index string,
+
+) (val types.ArchivedGame, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedGameKeyPrefix))
+
+	b := store.Get(types.ArchivedGameKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveArchivedGame removes a archivedGame from the store
+func (k Keeper) RemoveArchivedGame(
+	ctx sdk.Context,
+	index string,
+
+) {
+	archivedGame, found := k.GetArchivedGame(ctx, index)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedGameKeyPrefix))
+	store.Delete(types.ArchivedGameKey(
+		index,
+	))
+	byHeightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedGameByHeightKeyPrefix))
+	byHeightStore.Delete(types.ArchivedGameByHeightKey(
+		archivedGame.EndHeight,
+		index,
+	))
+}
+
+// GetAllArchivedGame returns all archivedGame
+func (k Keeper) GetAllArchivedGame(ctx sdk.Context) (list []types.ArchivedGame) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedGameKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ArchivedGame
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ArchiveGame replaces a game that is over with its result record. The caller has already taken the game out of the
+// FIFO.
+func (k Keeper) ArchiveGame(ctx sdk.Context, storedGame *types.StoredGame, reason string, board string) {
+	archivedGame := storedGame.Archive(reason, board, ctx.BlockHeight())
+	k.RemoveStoredGame(ctx, storedGame.Index)
+	k.SetArchivedGame(ctx, archivedGame)
+	k.SetArchivedPlayerGames(ctx, &archivedGame)
+}
+
+// GetGameNotFoundError tells a game that is over and archived apart from one that never existed.
+func (k Keeper) GetGameNotFoundError(ctx sdk.Context, gameIndex string) error {
+	if _, found := k.GetArchivedGame(ctx, gameIndex); found {
+		return types.ErrGameFinished
+	}
+	return sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+}
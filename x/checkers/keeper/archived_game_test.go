@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNArchivedGames(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.ArchivedGame {
+	items := make([]types.ArchivedGame, n)
+	for i := range items {
+		items[i].Index = strconv.Itoa(i)
+		items[i].EndHeight = int64(i)
+
+		keeper.SetArchivedGame(ctx, items[i])
+	}
+	return items
+}
+
+func TestArchivedGameGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNArchivedGames(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetArchivedGame(ctx,
+			item.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestArchivedGameRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNArchivedGames(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveArchivedGame(ctx,
+			item.Index,
+		)
+		_, found := keeper.GetArchivedGame(ctx,
+			item.Index,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestArchivedGameGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNArchivedGames(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllArchivedGame(ctx)),
+	)
+}
+
+func TestGameNotFoundErrorOnArchived(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	createNArchivedGames(keeper, ctx, 1)
+	require.EqualError(t, keeper.GetGameNotFoundError(ctx, "0"), "game is already finished")
+	require.EqualError(t, keeper.GetGameNotFoundError(ctx, "1"), "1: game by id not found")
+}
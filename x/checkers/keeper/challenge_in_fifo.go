@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) RemoveChallengeFromFifo(ctx sdk.Context, challenge *types.Challenge, info *types.SystemInfo) {
+	k.challengeFifo(ctx, info).remove(challenge.Index, &challenge.BeforeIndex, &challenge.AfterIndex)
+}
+
+func (k Keeper) SendChallengeToFifoTail(ctx sdk.Context, challenge *types.Challenge, info *types.SystemInfo) {
+	k.challengeFifo(ctx, info).sendToTail(challenge.Index, &challenge.BeforeIndex, &challenge.AfterIndex)
+}
+
+// challengeFifo is the FIFO of open challenges, sorted by deadline.
+func (k Keeper) challengeFifo(ctx sdk.Context, info *types.SystemInfo) fifo {
+	return fifo{
+		head: &info.ChallengeFifoHeadIndex,
+		tail: &info.ChallengeFifoTailIndex,
+		link: func(index string, update func(beforeIndex *string, afterIndex *string)) bool {
+			challenge, found := k.GetChallenge(ctx, index)
+			if !found {
+				return false
+			}
+			update(&challenge.BeforeIndex, &challenge.AfterIndex)
+			k.SetChallenge(ctx, challenge)
+			return true
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNChallenge(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Challenge {
+	items := make([]types.Challenge, n)
+	for i := range items {
+		items[i].Index = strconv.Itoa(i)
+
+		keeper.SetChallenge(ctx, items[i])
+	}
+	return items
+}
+
+func TestChallengeGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNChallenge(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetChallenge(ctx,
+			item.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestChallengeRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNChallenge(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveChallenge(ctx,
+			item.Index,
+		)
+		_, found := keeper.GetChallenge(ctx,
+			item.Index,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestChallengeGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNChallenge(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllChallenge(ctx)),
+	)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetCollectedFees set collectedFees in the store
+func (k Keeper) SetCollectedFees(ctx sdk.Context, collectedFees types.CollectedFees) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CollectedFeesKey))
+	b := k.cdc.MustMarshal(&collectedFees)
+	store.Set([]byte{0}, b)
+}
+
+// GetCollectedFees returns collectedFees
+func (k Keeper) GetCollectedFees(ctx sdk.Context) (val types.CollectedFees, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CollectedFeesKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveCollectedFees removes collectedFees from the store
+func (k Keeper) RemoveCollectedFees(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CollectedFeesKey))
+	store.Delete([]byte{0})
+}
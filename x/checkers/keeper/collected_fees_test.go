@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+)
+
+func createTestCollectedFees(keeper *keeper.Keeper, ctx sdk.Context) types.CollectedFees {
+	item := types.CollectedFees{}
+	keeper.SetCollectedFees(ctx, item)
+	return item
+}
+
+func TestCollectedFeesGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	item := createTestCollectedFees(keeper, ctx)
+	rst, found := keeper.GetCollectedFees(ctx)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&item),
+		nullify.Fill(&rst),
+	)
+}
+
+func TestCollectedFeesRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	createTestCollectedFees(keeper, ctx)
+	keeper.RemoveCollectedFees(ctx)
+	_, found := keeper.GetCollectedFees(ctx)
+	require.False(t, found)
+}
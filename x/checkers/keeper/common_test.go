@@ -1,9 +1,44 @@
 package keeper_test
 
-import "github.com/b9lab/checkers/x/checkers/testutil"
+import (
+	"context"
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
 
 const (
 	alice = testutil.Alice
 	bob   = testutil.Bob
 	carol = testutil.Carol
 )
+
+// createLiveGame creates the game and has the players other than its creator accept it, so that it goes live.
+func createLiveGame(msgServer types.MsgServer, context context.Context, msg *types.MsgCreateGame) (*types.MsgCreateGameResponse, error) {
+	createResponse, err := msgServer.CreateGame(context, msg)
+	if err != nil {
+		return nil, err
+	}
+	for i, player := range []string{msg.Black, msg.Red} {
+		if player == msg.Creator || (i == 1 && msg.Red == msg.Black) {
+			continue
+		}
+		_, err = msgServer.AcceptGame(context, &types.MsgAcceptGame{
+			Creator:   player,
+			GameIndex: createResponse.GameIndex,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return createResponse, nil
+}
+
+// storedBoard spells out the board that the game keeps packed.
+func storedBoard(t testing.TB, storedGame types.StoredGame) string {
+	board, err := storedGame.FormatBoard()
+	require.Nil(t, err)
+	return board
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneArchivedGames removes, with their moves, the archived games that ended at least the retention period ago, the
+// oldest first and no more than MaxArchivedGamesPrunedPerBlock at a time. It keeps the tournament games of a round
+// that is still pending, so that their results can be recorded, and looks at them again only after another retention
+// period.
+func (k Keeper) PruneArchivedGames(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	retention := k.ArchiveRetentionBlocks(ctx)
+	if retention == 0 {
+		return
+	}
+	lastPrunable := ctx.BlockHeight() - int64(retention)
+	if lastPrunable < 0 {
+		return
+	}
+
+	byHeightStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ArchivedGameByHeightKeyPrefix))
+	iterator := byHeightStore.Iterator(nil, types.ArchivedGameByHeightEndKey(lastPrunable))
+	keys := [][]byte{}
+	gameIndices := []string{}
+	for ; iterator.Valid() && len(keys) < types.MaxArchivedGamesPrunedPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+		gameIndices = append(gameIndices, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for i, gameIndex := range gameIndices {
+		archivedGame, found := k.GetArchivedGame(ctx, gameIndex)
+		if !found {
+			panic("Archived game not found " + gameIndex)
+		}
+		byHeightStore.Delete(keys[i])
+		if k.isInPendingTournamentRound(ctx, &archivedGame) {
+			byHeightStore.Set(types.ArchivedGameByHeightKey(ctx.BlockHeight(), gameIndex), []byte(gameIndex))
+			continue
+		}
+		k.RemoveArchivedGame(ctx, gameIndex)
+		k.RemoveGameMoves(ctx, gameIndex)
+		k.RemovePlayerGames(ctx, &archivedGame)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.ArchivedGamePrunedEventType,
+				sdk.NewAttribute(types.ArchivedGamePrunedEventGameIndex, gameIndex),
+			),
+		)
+	}
+}
+
+func (k Keeper) isInPendingTournamentRound(ctx sdk.Context, archivedGame *types.ArchivedGame) bool {
+	if archivedGame.TournamentIndex == "" {
+		return false
+	}
+	tournament, found := k.GetTournament(ctx, archivedGame.TournamentIndex)
+	if !found || tournament.Status != types.TournamentStatusRunning {
+		return false
+	}
+	for _, gameIndex := range tournament.RoundGameIndices {
+		if gameIndex == archivedGame.Index {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupKeeperWithArchiveRetention(t testing.TB, retention uint64) (*keeper.Keeper, sdk.Context) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	params := types.DefaultParams()
+	params.ArchiveRetentionBlocks = retention
+	k.SetParams(ctx, params)
+	return k, ctx
+}
+
+func archiveGameAt(k *keeper.Keeper, ctx sdk.Context, index string, endHeight int64) {
+	archivedGame := types.ArchivedGame{
+		Index:     index,
+		Black:     bob,
+		Red:       carol,
+		Winner:    "b",
+		Reason:    types.ArchiveReasonResign,
+		EndHeight: endHeight,
+	}
+	k.SetArchivedGame(ctx, archivedGame)
+	k.SetArchivedPlayerGames(ctx, &archivedGame)
+	k.SetGameMove(ctx, types.GameMove{GameIndex: index, MoveNumber: 1, Player: bob})
+}
+
+func TestPruneArchivedGamesAfterRetention(t *testing.T) {
+	k, ctx := setupKeeperWithArchiveRetention(t, 10)
+	archiveGameAt(k, ctx, "1", 5)
+	archiveGameAt(k, ctx, "2", 6)
+	archiveGameAt(k, ctx, "3", 7)
+	ctx = ctx.WithBlockHeight(16)
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx))
+
+	_, found := k.GetArchivedGame(ctx, "1")
+	require.False(t, found)
+	_, found = k.GetArchivedGame(ctx, "2")
+	require.False(t, found)
+	_, found = k.GetArchivedGame(ctx, "3")
+	require.True(t, found)
+	require.Empty(t, k.GetGameMoves(ctx, "1"))
+	require.Len(t, k.GetGameMoves(ctx, "3"), 1)
+	status, found := k.GetPlayerGameStatus(ctx, bob, "2")
+	requirePlayerGameStatus(t, "", status, found)
+	status, found = k.GetPlayerGameStatus(ctx, carol, "3")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "archived-game-pruned",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "game-index", Value: "2"},
+		},
+	}, events[0])
+}
+
+func TestPruneArchivedGamesOrdersByHeightNotIndex(t *testing.T) {
+	k, ctx := setupKeeperWithArchiveRetention(t, 10)
+	archiveGameAt(k, ctx, "10", 300)
+	archiveGameAt(k, ctx, "9", 2)
+	ctx = ctx.WithBlockHeight(12)
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx))
+
+	_, found := k.GetArchivedGame(ctx, "9")
+	require.False(t, found)
+	_, found = k.GetArchivedGame(ctx, "10")
+	require.True(t, found)
+}
+
+func TestPruneArchivedGamesKeptWithZeroRetention(t *testing.T) {
+	k, ctx := setupKeeperWithArchiveRetention(t, 0)
+	archiveGameAt(k, ctx, "1", 5)
+	ctx = ctx.WithBlockHeight(1_000_000)
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx))
+
+	_, found := k.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+}
+
+func TestPruneArchivedGamesKeepsPendingTournamentRound(t *testing.T) {
+	k, ctx := setupKeeperWithArchiveRetention(t, 10)
+	k.SetTournament(ctx, types.Tournament{
+		Index:            "1",
+		Status:           types.TournamentStatusRunning,
+		RoundGameIndices: []string{"1"},
+	})
+	archivedGame := types.ArchivedGame{
+		Index:           "1",
+		Black:           bob,
+		Red:             carol,
+		Winner:          "b",
+		Reason:          types.ArchiveReasonResign,
+		EndHeight:       5,
+		TournamentIndex: "1",
+	}
+	k.SetArchivedGame(ctx, archivedGame)
+	archivedGame.Index = "2"
+	k.SetArchivedGame(ctx, archivedGame)
+	ctx = ctx.WithBlockHeight(100)
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx))
+
+	_, found := k.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	_, found = k.GetArchivedGame(ctx, "2")
+	require.False(t, found)
+}
+
+func TestPruneArchivedGamesLooksAgainAtTournamentRoundAfterRetention(t *testing.T) {
+	k, ctx := setupKeeperWithArchiveRetention(t, 10)
+	tournament := types.Tournament{
+		Index:            "1",
+		Status:           types.TournamentStatusRunning,
+		RoundGameIndices: []string{"1"},
+	}
+	k.SetTournament(ctx, tournament)
+	k.SetArchivedGame(ctx, types.ArchivedGame{
+		Index:           "1",
+		Black:           bob,
+		Red:             carol,
+		Winner:          "b",
+		Reason:          types.ArchiveReasonResign,
+		EndHeight:       5,
+		TournamentIndex: "1",
+	})
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx.WithBlockHeight(100)))
+	tournament.Status = types.TournamentStatusFinished
+	k.SetTournament(ctx, tournament)
+
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx.WithBlockHeight(109)))
+	_, found := k.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx.WithBlockHeight(110)))
+	_, found = k.GetArchivedGame(ctx, "1")
+	require.False(t, found)
+}
+
+func TestPruneArchivedGamesAtMostPerBlock(t *testing.T) {
+	k, ctx := setupKeeperWithArchiveRetention(t, 10)
+	for i := 0; i < types.MaxArchivedGamesPrunedPerBlock+5; i++ {
+		archiveGameAt(k, ctx, strconv.Itoa(i), int64(i%3))
+	}
+	ctx = ctx.WithBlockHeight(100)
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx))
+	require.Len(t, k.GetAllArchivedGame(ctx), 5)
+	k.PruneArchivedGames(sdk.WrapSDKContext(ctx))
+	require.Len(t, k.GetAllArchivedGame(ctx), 0)
+}
+
+func TestPlayMoveOnArchivedGameIsFinished(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     4,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.EqualError(t, err, "game is already finished")
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) ExpireChallenges(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get FIFO information
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	challengeIndex := systemInfo.ChallengeFifoHeadIndex
+	var challenge types.Challenge
+	for {
+		// Finished moving along
+		if challengeIndex == types.NoFifoIndex {
+			break
+		}
+		challenge, found = k.GetChallenge(ctx, challengeIndex)
+		if !found {
+			panic("Fifo head challenge not found " + systemInfo.ChallengeFifoHeadIndex)
+		}
+		deadline, err := challenge.GetDeadlineAsTime()
+		if err != nil {
+			panic(err)
+		}
+		if deadline.Before(ctx.BlockTime()) {
+			// Nobody took it in time
+			k.RemoveChallengeFromFifo(ctx, &challenge, &systemInfo)
+			k.RemoveChallenge(ctx, challengeIndex)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.ChallengeExpiredEventType,
+					sdk.NewAttribute(types.ChallengeExpiredEventChallengeIndex, challengeIndex),
+				),
+			)
+			// Move along FIFO
+			challengeIndex = systemInfo.ChallengeFifoHeadIndex
+		} else {
+			// All other challenges after are still open anyway
+			break
+		}
+	}
+
+	k.SetSystemInfo(ctx, systemInfo)
+}
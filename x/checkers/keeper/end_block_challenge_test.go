@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpireChallengeNotYet(t *testing.T) {
+	_, keeper, context := setupMsgServerWithOneChallenge(t, "*")
+	ctx := sdk.UnwrapSDKContext(context)
+	keeper.ExpireChallenges(context)
+
+	_, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+}
+
+func TestExpireChallenge(t *testing.T) {
+	_, keeper, context := setupMsgServerWithOneChallenge(t, "*")
+	ctx := sdk.UnwrapSDKContext(context)
+	challenge1, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+	challenge1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetChallenge(ctx, challenge1)
+	keeper.ExpireChallenges(context)
+
+	_, found = keeper.GetChallenge(ctx, "1")
+	require.False(t, found)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 1,
+		NextChallengeId:        2,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
+	}, systemInfo)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 2)
+	event := events[0]
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "challenge-expired",
+		Attributes: []sdk.Attribute{
+			{Key: "challenge-index", Value: "1"},
+		},
+	}, event)
+}
+
+func TestExpireOlderChallenge(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneChallenge(t, "*")
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: bob,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		Color:   "*",
+	})
+	challenge1, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+	challenge1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetChallenge(ctx, challenge1)
+	keeper.ExpireChallenges(context)
+
+	_, found = keeper.GetChallenge(ctx, "1")
+	require.False(t, found)
+	challenge2, found := keeper.GetChallenge(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "-1", challenge2.BeforeIndex)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.Equal(t, "2", systemInfo.ChallengeFifoHeadIndex)
+	require.Equal(t, "2", systemInfo.ChallengeFifoTailIndex)
+}
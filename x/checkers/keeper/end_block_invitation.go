@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) ExpireInvitations(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The expired invitations are listed first, as they are removed from the queue on the way
+	for _, gameIndex := range k.GetExpiredInvitationIndices(ctx) {
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			panic("Expired invitation game not found " + gameIndex)
+		}
+		// Not accepted in time
+		k.DropPendingGame(ctx, &storedGame)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.InvitationExpiredEventType,
+				sdk.NewAttribute(types.InvitationExpiredEventGameIndex, gameIndex),
+			),
+		)
+	}
+}
+
+// DropPendingGame deletes a game that never went live, without archiving it as it was never played.
+func (k Keeper) DropPendingGame(ctx sdk.Context, storedGame *types.StoredGame) {
+	k.RemoveFromInvitationDeadlineQueue(ctx, storedGame)
+	k.RemoveActiveGame(ctx, storedGame)
+	k.RemovePendingPlayerGames(ctx, storedGame)
+	k.RemoveStoredGame(ctx, storedGame.Index)
+}
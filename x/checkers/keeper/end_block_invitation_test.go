@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpireInvitationNotYet(t *testing.T) {
+	_, keeper, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	keeper.ExpireInvitations(context)
+
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+}
+
+func TestExpireInvitation(t *testing.T) {
+	_, keeper, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout) + 1))
+	keeper.ExpireInvitations(sdk.WrapSDKContext(ctx))
+
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	_, found = keeper.GetPlayerGameStatus(ctx, carol, "1")
+	require.False(t, found)
+	require.EqualValues(t, 0, keeper.GetActiveGameCount(ctx, bob))
+	require.Empty(t, keeper.GetExpiredInvitationIndices(ctx))
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
+	}, systemInfo)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	event := events[1]
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "invitation-expired",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+		},
+	}, event)
+}
+
+// createInvitationWithTimeout has carol invite alice under another InvitationTimeout than the pending game.
+func createInvitationWithTimeout(t *testing.T, msgServer types.MsgServer, keeper keeper.Keeper, context context.Context,
+	invitationTimeout uint64) {
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.InvitationTimeout = invitationTimeout
+	keeper.SetParams(ctx, params)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: carol,
+		Black:   carol,
+		Red:     alice,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 46)),
+	})
+	require.Nil(t, err)
+}
+
+func TestExpireOlderInvitation(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	createInvitationWithTimeout(t, msgServer, keeper, context, 2*types.DefaultInvitationTimeout)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout) + 1))
+	keeper.ExpireInvitations(sdk.WrapSDKContext(ctx))
+
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	_, found = keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+}
+
+func TestExpireNewerInvitationAfterTimeoutLowered(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	createInvitationWithTimeout(t, msgServer, keeper, context, types.DefaultInvitationTimeout/2)
+	// Past the deadline of the newer invitation only
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout/2) + 1))
+	keeper.ExpireInvitations(sdk.WrapSDKContext(ctx))
+
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	_, found = keeper.GetStoredGame(ctx, "2")
+	require.False(t, found)
+	require.EqualValues(t, 1, keeper.GetActiveGameCount(ctx, carol))
+	require.EqualValues(t, 0, keeper.GetActiveGameCount(ctx, alice))
+}
@@ -17,62 +17,64 @@ func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
 		rules.PieceStrings[rules.RED_PLAYER]:   rules.PieceStrings[rules.BLACK_PLAYER],
 	}
 
-	// Get FIFO information
-	systemInfo, found := k.GetSystemInfo(ctx)
-	if !found {
-		panic("SystemInfo not found")
-	}
-
-	gameIndex := systemInfo.FifoHeadIndex
-	var storedGame types.StoredGame
-	for {
-		// Finished moving along
-		if gameIndex == types.NoFifoIndex {
-			break
-		}
-		storedGame, found = k.GetStoredGame(ctx, gameIndex)
+	// The expired games are listed first, as they are removed from the queue on the way
+	for _, gameIndex := range k.GetExpiredGameIndices(ctx) {
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
 		if !found {
-			panic("Fifo head game not found " + systemInfo.FifoHeadIndex)
+			panic("Expired game not found " + gameIndex)
 		}
-		deadline, err := storedGame.GetDeadlineAsTime()
+		k.RemoveFromDeadlineQueue(ctx, &storedGame)
+		k.RemoveActiveGame(ctx, &storedGame)
+		lastBoard, err := storedGame.FormatBoard()
 		if err != nil {
-			panic(err)
+			panic(err.Error())
 		}
-		if deadline.Before(ctx.BlockTime()) {
-			// Game is past deadline
-			k.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-			lastBoard := storedGame.Board
+		payout, fee, refund := sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
+		if storedGame.MoveCount <= 1 && storedGame.TournamentIndex == "" {
+			// A game that was never really played is archived without a winner
+			if storedGame.MoveCount == 1 {
+				refund = k.MustRefundWager(ctx, &storedGame)
+			}
+			k.MustSettleBets(ctx, gameIndex, rules.PieceStrings[rules.NO_PLAYER])
+			k.ArchiveGame(ctx, &storedGame, types.ArchiveReasonForfeit, lastBoard)
+		} else {
+			storedGame.Winner, found = opponents[storedGame.Turn]
+			if !found {
+				panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
+			}
+			if 0 < storedGame.MoveCount {
+				// A tournament game can be forfeited before any move
+				payout, fee = k.MustPayWinnings(ctx, &storedGame)
+			}
 			if storedGame.MoveCount <= 1 {
-				// No point in keeping a game that was never really played
-				k.RemoveStoredGame(ctx, gameIndex)
-				if storedGame.MoveCount == 1 {
-					k.MustRefundWager(ctx, &storedGame)
-				}
+				// Spectators are refunded as on any game that was never really played
+				k.MustSettleBets(ctx, gameIndex, rules.PieceStrings[rules.NO_PLAYER])
 			} else {
-				storedGame.Winner, found = opponents[storedGame.Turn]
-				if !found {
-					panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
-				}
-				k.MustPayWinnings(ctx, &storedGame)
-				winnerInfo, _ := k.MustRegisterPlayerForfeit(ctx, &storedGame)
-				k.MustAddToLeaderboard(ctx, winnerInfo)
-				storedGame.Board = ""
-				k.SetStoredGame(ctx, storedGame)
+				k.MustSettleBets(ctx, gameIndex, storedGame.Winner)
 			}
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(types.GameForfeitedEventType,
-					sdk.NewAttribute(types.GameForfeitedEventGameIndex, gameIndex),
-					sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
-					sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
-				),
-			)
-			// Move along FIFO
-			gameIndex = systemInfo.FifoHeadIndex
-		} else {
-			// All other games after are active anyway
-			break
+			winnerInfo, _ := k.MustRegisterPlayerForfeit(ctx, &storedGame)
+			k.MustAddToLeaderboard(ctx, winnerInfo)
+			k.ArchiveGame(ctx, &storedGame, types.ArchiveReasonForfeit, lastBoard)
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.GameForfeitedEventType,
+				sdk.NewAttribute(types.GameForfeitedEventGameIndex, gameIndex),
+				sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
+				sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
+			),
+		)
+		err = ctx.EventManager().EmitTypedEvent(&types.EventGameForfeited{
+			GameIndex: gameIndex,
+			Winner:    storedGame.Winner,
+			Board:     lastBoard,
+			Payout:    payout,
+			Fee:       fee,
+			Refund:    refund,
+			Black:     storedGame.Black,
+			Red:       storedGame.Red,
+		})
+		if err != nil {
+			panic(err.Error())
 		}
 	}
-
-	k.SetSystemInfo(ctx, systemInfo)
 }
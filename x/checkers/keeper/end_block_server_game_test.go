@@ -16,22 +16,30 @@ func TestForfeitUnplayed(t *testing.T) {
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
+	archived1, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "*", archived1.Winner)
+	require.Equal(t, "forfeit", archived1.Reason)
+	require.EqualValues(t, 0, archived1.MoveCount)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -46,16 +54,16 @@ func TestForfeitOlderUnplayed(t *testing.T) {
 	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
@@ -65,13 +73,15 @@ func TestForfeitOlderUnplayed(t *testing.T) {
 	nextGame, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId:                 3,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, nextGame)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -86,27 +96,27 @@ func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
 	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
-		Denom:   "gold",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	game2.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game2)
 	keeper.SetStoredGame(ctx, game2)
 	keeper.ForfeitExpiredGames(context)
 
@@ -118,13 +128,15 @@ func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId:                 4,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -155,6 +167,7 @@ func TestForfeitPlayedOnce(t *testing.T) {
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
@@ -164,13 +177,15 @@ func TestForfeitPlayedOnce(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 7)
+	event := events[4]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -195,16 +210,16 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 		ToX:       2,
 		ToY:       3,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Red:     carol,
 		Black:   alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
@@ -214,13 +229,15 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId:                 3,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 7)
+	event := events[4]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -247,12 +264,11 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		ToX:       2,
 		ToY:       3,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   carol,
@@ -262,20 +278,21 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		ToX:       2,
 		ToY:       3,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
-		Denom:   "gold",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	game2.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game2)
 	keeper.SetStoredGame(ctx, game2)
 	keeper.ForfeitExpiredGames(context)
 
@@ -287,13 +304,15 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId:                 4,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 7)
+	event := events[4]
 	require.EqualValues(t,
 		sdk.StringEvent{
 			Type: "game-forfeited",
@@ -335,36 +354,38 @@ func TestForfeitPlayedTwice(t *testing.T) {
 	require.True(t, found)
 	oldDeadline := types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
-	game1, found = keeper.GetStoredGame(ctx, "1")
-	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(2),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    oldDeadline,
-		Winner:      "r",
-		Wager:       45,
-		Denom:       "stake",
-	}, game1)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archived1, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.ArchivedGame{
+		Index:     "1",
+		Black:     bob,
+		Red:       carol,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Winner:    "r",
+		Reason:    "forfeit",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		MoveCount: 2,
+		EndHeight: ctx.BlockHeight(),
+	}, archived1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 8)
+	event := events[4]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -398,47 +419,48 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 		ToX:       1,
 		ToY:       4,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	oldDeadline := types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
-	game1, found = keeper.GetStoredGame(ctx, "1")
-	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(2),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    oldDeadline,
-		Winner:      "r",
-		Wager:       45,
-		Denom:       "stake",
-	}, game1)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archived1, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.ArchivedGame{
+		Index:     "1",
+		Black:     bob,
+		Red:       carol,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Winner:    "r",
+		Reason:    "forfeit",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		MoveCount: 2,
+		EndHeight: ctx.BlockHeight(),
+	}, archived1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId:                 3,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 8)
+	event := events[4]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -475,12 +497,11 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		ToX:       1,
 		ToY:       4,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   carol,
@@ -498,69 +519,70 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		ToX:       1,
 		ToY:       4,
 	})
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
-		Denom:   "gold",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	oldDeadline := types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	game2.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(ctx, &game2)
 	keeper.SetStoredGame(ctx, game2)
 	keeper.ForfeitExpiredGames(context)
 
-	game1, found = keeper.GetStoredGame(ctx, "1")
-	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(2),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    oldDeadline,
-		Winner:      "r",
-		Wager:       45,
-		Denom:       "stake",
-	}, game1)
-
-	game2, found = keeper.GetStoredGame(ctx, "2")
-	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   uint64(2),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    oldDeadline,
-		Winner:      "r",
-		Wager:       46,
-		Denom:       "coin",
-	}, game2)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archived1, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.ArchivedGame{
+		Index:     "1",
+		Black:     bob,
+		Red:       carol,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Winner:    "r",
+		Reason:    "forfeit",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		MoveCount: 2,
+		EndHeight: ctx.BlockHeight(),
+	}, archived1)
+
+	_, found = keeper.GetStoredGame(ctx, "2")
+	require.False(t, found)
+	archived2, found := keeper.GetArchivedGame(ctx, "2")
+	require.True(t, found)
+	require.EqualValues(t, types.ArchivedGame{
+		Index:     "2",
+		Black:     carol,
+		Red:       alice,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		Winner:    "r",
+		Reason:    "forfeit",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		MoveCount: 2,
+		EndHeight: ctx.BlockHeight(),
+	}, archived2)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId:                 4,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 8)
+	event := events[4]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -600,6 +622,7 @@ func TestForfeitGameAddPlayerInfo(t *testing.T) {
 	require.True(t, found)
 	oldDeadline := types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
@@ -660,6 +683,7 @@ func TestForfeiGameUpdatePlayerInfo(t *testing.T) {
 	require.True(t, found)
 	oldDeadline := types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
@@ -679,6 +703,20 @@ func TestForfeiGameUpdatePlayerInfo(t *testing.T) {
 		LostCount:      5,
 		ForfeitedCount: 6,
 	}, carolInfo)
+	bobRating, found := keeper.GetPlayerRating(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerRating{
+		Index:           bob,
+		Rating:          1338,
+		RatingDeviation: 290,
+	}, bobRating)
+	carolRating, found := keeper.GetPlayerRating(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerRating{
+		Index:           carol,
+		Rating:          1662,
+		RatingDeviation: 290,
+	}, carolRating)
 }
 
 func TestForfeitGameLeaderboardAddWinner(t *testing.T) {
@@ -707,6 +745,7 @@ func TestForfeitGameLeaderboardAddWinner(t *testing.T) {
 	require.True(t, found)
 	oldDeadline := types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
@@ -774,6 +813,7 @@ func TestForfeiGameLeaderboardUpdateWinner(t *testing.T) {
 	require.True(t, found)
 	oldDeadline := types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
 	game1.Deadline = oldDeadline
+	keeper.InsertDeadlineQueue(ctx, &game1)
 	keeper.SetStoredGame(ctx, game1)
 	keeper.ForfeitExpiredGames(context)
 
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StartNextTournamentRound pairs the players for the next round and creates their games. The caller saves the
+// tournament.
+func (k Keeper) StartNextTournamentRound(ctx sdk.Context, tournament *types.Tournament) (gameIndices []string) {
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	turnDuration := k.GetParams(ctx).TurnDuration()
+
+	tournament.CurrentRound++
+	gameIndices = []string{}
+	for _, pairing := range tournament.PairRound() {
+		storedGame, err := types.NewStoredGame(strconv.FormatUint(systemInfo.NextId, 10), pairing.Black, pairing.Red,
+			nil, tournament.Variant, types.TimeControl{})
+		if err != nil {
+			panic(err.Error())
+		}
+		storedGame.TournamentIndex = tournament.Index
+		storedGame.StartClocks(ctx, turnDuration)
+		err = storedGame.Validate()
+		if err != nil {
+			panic(err.Error())
+		}
+		k.AddNewGame(ctx, tournament.Creator, &storedGame, &systemInfo)
+		gameIndices = append(gameIndices, storedGame.Index)
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+	tournament.RoundGameIndices = gameIndices
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentRoundStartedEventType,
+			sdk.NewAttribute(types.TournamentRoundStartedEventTournamentIndex, tournament.Index),
+			sdk.NewAttribute(types.TournamentRoundStartedEventRound, strconv.FormatUint(tournament.CurrentRound, 10)),
+			sdk.NewAttribute(types.TournamentRoundStartedEventGameIndices, strings.Join(gameIndices, ",")),
+		),
+	)
+	return gameIndices
+}
+
+// AdvanceTournaments moves each running tournament whose round games are all finished, including by forfeit, on to
+// its next round, or pays its prizes when that was the last round.
+func (k Keeper) AdvanceTournaments(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, tournamentIndex := range k.GetAllRunningTournamentIndex(ctx) {
+		tournament, found := k.GetTournament(ctx, tournamentIndex)
+		if !found {
+			panic("Running tournament not found " + tournamentIndex)
+		}
+		roundGames := make([]types.ArchivedGame, 0, len(tournament.RoundGameIndices))
+		for _, gameIndex := range tournament.RoundGameIndices {
+			archivedGame, found := k.GetArchivedGame(ctx, gameIndex)
+			if !found {
+				// Still being played
+				break
+			}
+			roundGames = append(roundGames, archivedGame)
+		}
+		if len(roundGames) < len(tournament.RoundGameIndices) {
+			// Round still in progress
+			continue
+		}
+		for _, archivedGame := range roundGames {
+			tournament.RecordResult(archivedGame)
+		}
+		if tournament.IsOver() {
+			tournament.DistributePrizePool()
+			k.MustPayPrizes(ctx, &tournament)
+			tournament.Status = types.TournamentStatusFinished
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.TournamentFinishedEventType,
+					sdk.NewAttribute(types.TournamentFinishedEventTournamentIndex, tournamentIndex),
+					sdk.NewAttribute(types.TournamentFinishedEventWinner, tournament.GetStandings()[0].Address),
+				),
+			)
+		} else {
+			k.StartNextTournamentRound(ctx, &tournament)
+		}
+		k.SetTournament(ctx, tournament)
+	}
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneStartedTournament(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneFullTournament(t)
+	msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+	return msgServer, keeper, context, ctrl, escrow
+}
+
+func forfeitGame(keeper keeper.Keeper, context context.Context, gameIndex string) {
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, found := keeper.GetStoredGame(ctx, gameIndex)
+	if !found {
+		panic("game not found " + gameIndex)
+	}
+	storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &storedGame)
+	keeper.SetStoredGame(ctx, storedGame)
+	keeper.ForfeitExpiredGames(context)
+}
+
+func TestAdvanceTournamentRoundInProgress(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneStartedTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	keeper.AdvanceTournaments(context)
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 1, tournament.CurrentRound)
+	require.EqualValues(t, []string{"1"}, tournament.RoundGameIndices)
+	_, found = keeper.GetStoredGame(ctx, "2")
+	require.False(t, found)
+}
+
+func TestForfeitUnplayedTournamentGameHasWinner(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneStartedTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	forfeitGame(keeper, context, "1")
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archived1, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", archived1.Winner)
+	require.Equal(t, types.ArchiveReasonForfeit, archived1.Reason)
+	require.Equal(t, "1", archived1.TournamentIndex)
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.WonCount)
+}
+
+func TestAdvanceTournamentToNextRound(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneStartedTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	forfeitGame(keeper, context, "1")
+	keeper.AdvanceTournaments(context)
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.TournamentStatusRunning, tournament.Status)
+	require.EqualValues(t, 2, tournament.CurrentRound)
+	require.EqualValues(t, []string{"2"}, tournament.RoundGameIndices)
+	require.EqualValues(t, []types.TournamentPlayer{
+		{Address: alice, Score: 2, Opponents: []string{bob}, HadBye: true},
+		{Address: bob, Score: 2, Opponents: []string{alice, carol}},
+		{Address: carol, Score: 2, Opponents: []string{bob}, HadBye: true},
+	}, tournament.Players)
+	game2, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, carol, game2.Black)
+	require.Equal(t, bob, game2.Red)
+	require.Equal(t, "1", game2.TournamentIndex)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Contains(t, events, sdk.StringEvent{
+		Type: "tournament-round-started",
+		Attributes: []sdk.Attribute{
+			{Key: "tournament-index", Value: "1"},
+			{Key: "round", Value: "1"},
+			{Key: "game-indices", Value: "1"},
+			{Key: "tournament-index", Value: "1"},
+			{Key: "round", Value: "2"},
+			{Key: "game-indices", Value: "2"},
+		},
+	})
+}
+
+func TestAdvanceTournamentLastRoundPaysPrizes(t *testing.T) {
+	_, keeper, context, ctrl, escrow := setupMsgServerWithOneStartedTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	forfeitGame(keeper, context, "1")
+	keeper.AdvanceTournaments(context)
+	forfeitGame(keeper, context, "2")
+	escrow.ExpectRefund(context, bob, 30).Times(1)
+	keeper.AdvanceTournaments(context)
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.TournamentStatusFinished, tournament.Status)
+	require.EqualValues(t, 2, tournament.CurrentRound)
+	require.True(t, tournament.PrizePool.Empty())
+	require.Empty(t, keeper.GetAllRunningTournamentIndex(ctx))
+	require.EqualValues(t, []types.TournamentStanding{
+		{Rank: 1, Address: bob, Score: 4, Tiebreak: 4, Prize: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+		{Rank: 2, Address: alice, Score: 2, Tiebreak: 4},
+		{Rank: 3, Address: carol, Score: 2, Tiebreak: 4},
+	}, tournament.GetStandings())
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Contains(t, events, sdk.StringEvent{
+		Type: "tournament-finished",
+		Attributes: []sdk.Attribute{
+			{Key: "tournament-index", Value: "1"},
+			{Key: "winner", Value: bob},
+		},
+	})
+
+	// Nothing more happens
+	keeper.AdvanceTournaments(context)
+	_, found = keeper.GetStoredGame(ctx, "3")
+	require.False(t, found)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+)
+
+// fifo is a doubly linked list kept in the store, from head to tail, whose elements know the indices of the elements
+// before and after them.
+type fifo struct {
+	head *string
+	tail *string
+	// link loads the stored element at index, lets update change its before and after indices, and saves it back.
+	link func(index string, update func(beforeIndex *string, afterIndex *string)) (found bool)
+}
+
+// remove takes the element out of the FIFO. The element itself is not saved, only its former neighbours are.
+func (list fifo) remove(index string, beforeIndex *string, afterIndex *string) {
+	// Does it have a predecessor?
+	if *beforeIndex != types.NoFifoIndex {
+		found := list.link(*beforeIndex, func(_ *string, beforeAfterIndex *string) {
+			*beforeAfterIndex = *afterIndex
+		})
+		if !found {
+			panic("Element before in Fifo was not found")
+		}
+		if *afterIndex == types.NoFifoIndex {
+			*list.tail = *beforeIndex
+		}
+		// Is it at the FIFO head?
+	} else if *list.head == index {
+		*list.head = *afterIndex
+	}
+	// Does it have a successor?
+	if *afterIndex != types.NoFifoIndex {
+		found := list.link(*afterIndex, func(afterBeforeIndex *string, _ *string) {
+			*afterBeforeIndex = *beforeIndex
+		})
+		if !found {
+			panic("Element after in Fifo was not found")
+		}
+		if *beforeIndex == types.NoFifoIndex {
+			*list.head = *afterIndex
+		}
+		// Is it at the FIFO tail?
+	} else if *list.tail == index {
+		*list.tail = *beforeIndex
+	}
+	*beforeIndex = types.NoFifoIndex
+	*afterIndex = types.NoFifoIndex
+}
+
+// sendToTail places the element last in the FIFO, whether it was already in it or not. The element itself is not
+// saved, only its new neighbour is.
+func (list fifo) sendToTail(index string, beforeIndex *string, afterIndex *string) {
+	if *list.head == types.NoFifoIndex && *list.tail == types.NoFifoIndex {
+		*beforeIndex = types.NoFifoIndex
+		*afterIndex = types.NoFifoIndex
+		*list.head = index
+		*list.tail = index
+	} else if *list.head == types.NoFifoIndex || *list.tail == types.NoFifoIndex {
+		panic("Fifo should have both head and tail or none")
+	} else if *list.tail == index {
+		// Nothing to do, already at tail
+	} else {
+		// Snip element out
+		list.remove(index, beforeIndex, afterIndex)
+
+		// Now add to tail
+		currentTail := *list.tail
+		found := list.link(currentTail, func(_ *string, tailAfterIndex *string) {
+			*tailAfterIndex = index
+		})
+		if !found {
+			panic("Current Fifo tail was not found")
+		}
+
+		*beforeIndex = currentTail
+		*list.tail = index
+	}
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetGameBets set a specific gameBets in the store from its index
+func (k Keeper) SetGameBets(ctx sdk.Context, gameBets types.GameBets) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameBetsKeyPrefix))
+	b := k.cdc.MustMarshal(&gameBets)
+	store.Set(types.GameBetsKey(
+		gameBets.GameIndex,
+	), b)
+}
+
+// GetGameBets returns a gameBets from its index
+func (k Keeper) GetGameBets(
+	ctx sdk.Context,
+	gameIndex string,
+
+) (val types.GameBets, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameBetsKeyPrefix))
+
+	b := store.Get(types.GameBetsKey(
+		gameIndex,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveGameBets removes a gameBets from the store
+func (k Keeper) RemoveGameBets(
+	ctx sdk.Context,
+	gameIndex string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameBetsKeyPrefix))
+	store.Delete(types.GameBetsKey(
+		gameIndex,
+	))
+}
+
+// GetAllGameBets returns all gameBets
+func (k Keeper) GetAllGameBets(ctx sdk.Context) (list []types.GameBets) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameBetsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GameBets
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNGameBets(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.GameBets {
+	items := make([]types.GameBets, n)
+	for i := range items {
+		items[i].GameIndex = strconv.Itoa(i)
+
+		keeper.SetGameBets(ctx, items[i])
+	}
+	return items
+}
+
+func TestGameBetsGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNGameBets(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetGameBets(ctx,
+			item.GameIndex,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestGameBetsRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNGameBets(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveGameBets(ctx,
+			item.GameIndex,
+		)
+		_, found := keeper.GetGameBets(ctx,
+			item.GameIndex,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestGameBetsGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNGameBets(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllGameBets(ctx)),
+	)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InsertDeadlineQueue queues the active game under its deadline, in place of the deadline it is saved with, so that
+// ForfeitExpiredGames finds the expired games without reading the others.
+func (k Keeper) InsertDeadlineQueue(ctx sdk.Context, game *types.StoredGame) {
+	k.insertDeadlineQueue(ctx, types.GameDeadlineKeyPrefix, game)
+}
+
+// RemoveFromDeadlineQueue takes the game out of the queue, under the deadline it is saved with. A game that is not
+// saved yet, or not queued, is left as is.
+func (k Keeper) RemoveFromDeadlineQueue(ctx sdk.Context, game *types.StoredGame) {
+	k.removeFromDeadlineQueue(ctx, types.GameDeadlineKeyPrefix, game)
+}
+
+// GetExpiredGameIndices lists the queued games whose deadline is before the block time, the earliest first.
+func (k Keeper) GetExpiredGameIndices(ctx sdk.Context) (gameIndices []string) {
+	return k.getExpiredIndices(ctx, types.GameDeadlineKeyPrefix)
+}
+
+// IterateDeadlineQueue calls do with each queued game, the earliest deadline first, until it returns true.
+func (k Keeper) IterateDeadlineQueue(ctx sdk.Context, do func(key []byte, gameIndex string) (stop bool)) {
+	k.iterateDeadlineQueue(ctx, types.GameDeadlineKeyPrefix, do)
+}
+
+// InsertInvitationDeadlineQueue queues the pending game under its invitation deadline, so that ExpireInvitations
+// finds the expired invitations without reading the others, whatever InvitationTimeout each was created with.
+func (k Keeper) InsertInvitationDeadlineQueue(ctx sdk.Context, game *types.StoredGame) {
+	k.insertDeadlineQueue(ctx, types.InvitationDeadlineKeyPrefix, game)
+}
+
+// RemoveFromInvitationDeadlineQueue takes the pending game out of the queue, under the deadline it is saved with.
+func (k Keeper) RemoveFromInvitationDeadlineQueue(ctx sdk.Context, game *types.StoredGame) {
+	k.removeFromDeadlineQueue(ctx, types.InvitationDeadlineKeyPrefix, game)
+}
+
+// GetExpiredInvitationIndices lists the pending games whose invitation deadline is before the block time, the
+// earliest first.
+func (k Keeper) GetExpiredInvitationIndices(ctx sdk.Context) (gameIndices []string) {
+	return k.getExpiredIndices(ctx, types.InvitationDeadlineKeyPrefix)
+}
+
+// IterateInvitationDeadlineQueue calls do with each pending game, the earliest deadline first, until it returns true.
+func (k Keeper) IterateInvitationDeadlineQueue(ctx sdk.Context, do func(key []byte, gameIndex string) (stop bool)) {
+	k.iterateDeadlineQueue(ctx, types.InvitationDeadlineKeyPrefix, do)
+}
+
+func (k Keeper) insertDeadlineQueue(ctx sdk.Context, keyPrefix string, game *types.StoredGame) {
+	deadline, err := game.GetDeadlineAsTime()
+	if err != nil {
+		panic(err)
+	}
+	k.removeFromDeadlineQueue(ctx, keyPrefix, game)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	store.Set(types.GameDeadlineKey(deadline, game.Index), []byte(game.Index))
+}
+
+func (k Keeper) removeFromDeadlineQueue(ctx sdk.Context, keyPrefix string, game *types.StoredGame) {
+	saved, found := k.GetStoredGame(ctx, game.Index)
+	if !found {
+		return
+	}
+	deadline, err := saved.GetDeadlineAsTime()
+	if err != nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	store.Delete(types.GameDeadlineKey(deadline, game.Index))
+}
+
+func (k Keeper) getExpiredIndices(ctx sdk.Context, keyPrefix string) (gameIndices []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := store.Iterator(nil, types.GameDeadlinesKey(ctx.BlockTime()))
+
+	defer iterator.Close()
+
+	gameIndices = []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		gameIndices = append(gameIndices, string(iterator.Value()))
+	}
+	return gameIndices
+}
+
+func (k Keeper) iterateDeadlineQueue(ctx sdk.Context, keyPrefix string,
+	do func(key []byte, gameIndex string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if do(iterator.Key(), string(iterator.Value())) {
+			break
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// queuedGames lists the game indices in the deadline queue, the earliest deadline first.
+func queuedGames(k keeper.Keeper, ctx sdk.Context) []string {
+	gameIndices := []string{}
+	k.IterateDeadlineQueue(ctx, func(key []byte, gameIndex string) bool {
+		gameIndices = append(gameIndices, gameIndex)
+		return false
+	})
+	return gameIndices
+}
+
+func queueGame(k *keeper.Keeper, ctx sdk.Context, gameIndex string, deadline time.Time) {
+	storedGame := types.StoredGame{Index: gameIndex, Winner: "*", Deadline: types.FormatDeadline(deadline)}
+	k.InsertDeadlineQueue(ctx, &storedGame)
+	k.SetStoredGame(ctx, storedGame)
+}
+
+func TestDeadlineQueueSortedByDeadline(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	queueGame(k, ctx, "1", ctx.BlockTime().Add(3*time.Second))
+	queueGame(k, ctx, "2", ctx.BlockTime().Add(time.Second))
+	queueGame(k, ctx, "3", ctx.BlockTime().Add(2*time.Second))
+	require.Equal(t, []string{"2", "3", "1"}, queuedGames(*k, ctx))
+}
+
+func TestDeadlineQueueMovesGameToItsNewDeadline(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	queueGame(k, ctx, "1", ctx.BlockTime().Add(time.Second))
+	queueGame(k, ctx, "2", ctx.BlockTime().Add(2*time.Second))
+	queueGame(k, ctx, "1", ctx.BlockTime().Add(3*time.Second))
+	require.Equal(t, []string{"2", "1"}, queuedGames(*k, ctx))
+}
+
+func TestDeadlineQueueRemove(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	queueGame(k, ctx, "1", ctx.BlockTime().Add(time.Second))
+	queueGame(k, ctx, "2", ctx.BlockTime().Add(2*time.Second))
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	k.RemoveFromDeadlineQueue(ctx, &storedGame)
+	require.Equal(t, []string{"2"}, queuedGames(*k, ctx))
+}
+
+func TestGetExpiredGameIndices(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	queueGame(k, ctx, "1", ctx.BlockTime().Add(-time.Second))
+	queueGame(k, ctx, "2", ctx.BlockTime())
+	queueGame(k, ctx, "3", ctx.BlockTime().Add(-2*time.Second))
+	queueGame(k, ctx, "4", ctx.BlockTime().Add(time.Second))
+	require.Equal(t, []string{"3", "1"}, k.GetExpiredGameIndices(ctx))
+}
+
+func TestInitGenesisQueuesActiveGames(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	genesis := types.DefaultGenesis()
+	genesis.StoredGameList = []types.StoredGame{
+		{Index: "1", Black: bob, Red: carol, Winner: "*", Deadline: types.FormatDeadline(ctx.BlockTime().Add(time.Second))},
+		{Index: "2", Black: bob, Red: alice, Winner: "b", Deadline: types.FormatDeadline(ctx.BlockTime())},
+		{Index: "3", Black: alice, Red: carol, Winner: "*", RedPending: true, Deadline: types.FormatDeadline(ctx.BlockTime())},
+		{Index: "4", Black: alice, Red: carol, Winner: "*", Deadline: types.FormatDeadline(ctx.BlockTime())},
+	}
+	checkers.InitGenesis(ctx, *k, *genesis)
+	require.Equal(t, []string{"4", "1"}, queuedGames(*k, ctx))
+	require.Equal(t, []string{"3"}, k.GetExpiredInvitationIndices(ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))))
+	require.EqualValues(t, 2, k.GetActiveGameCount(ctx, alice))
+	require.EqualValues(t, 3, k.GetActiveGameCount(ctx, carol))
+}
+
+func TestGetExpiredInvitationIndices(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	for i, deadline := range []time.Time{
+		ctx.BlockTime().Add(-time.Second), ctx.BlockTime().Add(time.Second), ctx.BlockTime().Add(-2 * time.Second),
+	} {
+		storedGame := types.StoredGame{Index: strconv.Itoa(i + 1), Winner: "*", RedPending: true,
+			Deadline: types.FormatDeadline(deadline)}
+		k.InsertInvitationDeadlineQueue(ctx, &storedGame)
+		k.SetStoredGame(ctx, storedGame)
+	}
+	require.Equal(t, []string{"3", "1"}, k.GetExpiredInvitationIndices(ctx))
+	require.Empty(t, k.GetExpiredGameIndices(ctx))
+}
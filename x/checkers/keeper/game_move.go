@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetGameMove set a specific gameMove in the store from its index
+func (k Keeper) SetGameMove(ctx sdk.Context, gameMove types.GameMove) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameMoveKeyPrefix))
+	b := k.cdc.MustMarshal(&gameMove)
+	store.Set(types.GameMoveKey(
+		gameMove.GameIndex,
+		gameMove.MoveNumber,
+	), b)
+}
+
+// GetGameMove returns a gameMove from its index
+func (k Keeper) GetGameMove(
+	ctx sdk.Context,
+	gameIndex string,
+	moveNumber uint64,
+
+) (val types.GameMove, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameMoveKeyPrefix))
+
+	b := store.Get(types.GameMoveKey(
+		gameIndex,
+		moveNumber,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetGameMoves returns all gameMove of a game, in the order they were played
+func (k Keeper) GetGameMoves(ctx sdk.Context, gameIndex string) (list []types.GameMove) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameMoveKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.GameMovesKey(gameIndex))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GameMove
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllGameMove returns all gameMove
+func (k Keeper) GetAllGameMove(ctx sdk.Context) (list []types.GameMove) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameMoveKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GameMove
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveGameMoves removes all gameMove of a game
+func (k Keeper) RemoveGameMoves(ctx sdk.Context, gameIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GameMoveKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.GameMovesKey(gameIndex))
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNGameMove(keeper *keeper.Keeper, ctx sdk.Context, games int, n int) []types.GameMove {
+	items := make([]types.GameMove, games*n)
+	for i := range items {
+		items[i].GameIndex = strconv.Itoa(i / n)
+		items[i].MoveNumber = uint64(i % n)
+
+		keeper.SetGameMove(ctx, items[i])
+	}
+	return items
+}
+
+func TestGameMoveGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNGameMove(keeper, ctx, 2, 5)
+	for _, item := range items {
+		rst, found := keeper.GetGameMove(ctx,
+			item.GameIndex,
+			item.MoveNumber,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestGameMovesOfGameInOrder(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNGameMove(keeper, ctx, 12, 3)
+	require.Equal(t,
+		nullify.Fill(items[3:6]),
+		nullify.Fill(keeper.GetGameMoves(ctx, "1")),
+	)
+	require.Equal(t,
+		nullify.Fill(items[30:33]),
+		nullify.Fill(keeper.GetGameMoves(ctx, "10")),
+	)
+}
+
+func TestGameMoveGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNGameMove(keeper, ctx, 2, 5)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllGameMove(ctx)),
+	)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ArchivedGame(c context.Context, req *types.QueryGetArchivedGameRequest) (*types.QueryGetArchivedGameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetArchivedGame(
+		ctx,
+		req.GameIndex,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetArchivedGameResponse{ArchivedGame: val}, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestArchivedGameQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNArchivedGames(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetArchivedGameRequest
+		response *types.QueryGetArchivedGameResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetArchivedGameRequest{
+				GameIndex: msgs[0].Index,
+			},
+			response: &types.QueryGetArchivedGameResponse{ArchivedGame: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetArchivedGameRequest{
+				GameIndex: msgs[1].Index,
+			},
+			response: &types.QueryGetArchivedGameResponse{ArchivedGame: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetArchivedGameRequest{
+				GameIndex: strconv.Itoa(100000),
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.ArchivedGame(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
@@ -20,15 +20,22 @@ func (k Keeper) CanPlayMove(goCtx context.Context, req *types.QueryCanPlayMoveRe
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	storedGame, found := k.GetStoredGame(ctx, req.GameIndex)
-	if !found {
+	_, archived := k.GetArchivedGame(ctx, req.GameIndex)
+	if !found && !archived {
 		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
 	}
-	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+	if archived || storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
 		return &types.QueryCanPlayMoveResponse{
 			Possible: false,
 			Reason:   types.ErrGameFinished.Error(),
 		}, nil
 	}
+	if storedGame.IsPending() {
+		return &types.QueryCanPlayMoveResponse{
+			Possible: false,
+			Reason:   types.ErrGamePending.Error(),
+		}, nil
+	}
 	isBlack := rules.PieceStrings[rules.BLACK_PLAYER] == req.Player
 	isRed := rules.PieceStrings[rules.RED_PLAYER] == req.Player
 	var player rules.Player
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -26,10 +27,10 @@ var (
 		{
 			desc: "First move by black",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -45,10 +46,10 @@ var (
 		{
 			desc: "Nil request, wrong",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request:  nil,
 			response: nil,
@@ -57,10 +58,10 @@ var (
 		{
 			desc: "Unknown game, wrong",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "2",
@@ -76,10 +77,10 @@ var (
 		{
 			desc: "Game finished, wrong",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********",
-				Turn:   "r",
-				Winner: "b",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********"),
+				Turn:        "r",
+				Winner:      "b",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -98,10 +99,10 @@ var (
 		{
 			desc: "Game not parseable, wrong",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*")[:8],
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -112,15 +113,15 @@ var (
 				ToY:       3,
 			},
 			response: nil,
-			err:      "game cannot be parsed: invalid board string: *b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r",
+			err:      "game cannot be parsed: invalid packed board length: 8",
 		},
 		{
 			desc: "First move by unknown, wrong",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -139,10 +140,10 @@ var (
 		{
 			desc: "First move by red, wrong",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -161,10 +162,10 @@ var (
 		{
 			desc: "Black can win",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b****|**b*b***|*****b**|********|********|**r*****|*B***b**|********",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b****|**b*b***|*****b**|********|********|**r*****|*B***b**|********"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -180,10 +181,10 @@ var (
 		{
 			desc: "Black must capture, see next for right move",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -202,10 +203,10 @@ var (
 		{
 			desc: "Black can capture, same board as previous",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
@@ -221,10 +222,10 @@ var (
 		{
 			desc: "Black king can capture backwards",
 			game: types.StoredGame{
-				Index:  "1",
-				Board:  "*b*b***b|**b*b***|***b***r|********|***r****|********|***r****|r*B*r*r*",
-				Turn:   "b",
-				Winner: "*",
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b***b|**b*b***|***b***r|********|***r****|********|***r****|r*B*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
 			},
 			request: &types.QueryCanPlayMoveRequest{
 				GameIndex: "1",
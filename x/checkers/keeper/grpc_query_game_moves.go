@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GameMoves(c context.Context, req *types.QueryGameMovesRequest) (*types.QueryGameMovesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var gameMoves []types.GameMove
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	gameMoveStore := prefix.NewStore(store, append(types.KeyPrefix(types.GameMoveKeyPrefix), types.GameMovesKey(req.GameIndex)...))
+
+	pageRes, err := query.Paginate(gameMoveStore, req.Pagination, func(key []byte, value []byte) error {
+		var gameMove types.GameMove
+		if err := k.cdc.Unmarshal(value, &gameMove); err != nil {
+			return err
+		}
+
+		gameMoves = append(gameMoves, gameMove)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGameMovesResponse{GameMoves: gameMoves, Pagination: pageRes}, nil
+}
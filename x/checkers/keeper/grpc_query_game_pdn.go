@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GamePdn(goCtx context.Context, req *types.QueryGamePdnRequest) (*types.QueryGamePdnResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pdnGame types.PdnGame
+	if storedGame, found := k.GetStoredGame(ctx, req.GameIndex); found {
+		pdnGame = &storedGame
+	} else if archivedGame, found := k.GetArchivedGame(ctx, req.GameIndex); found {
+		pdnGame = &archivedGame
+	} else {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
+	}
+	pdn, err := types.FormatPdn(pdnGame, k.GetGameMoves(ctx, req.GameIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGamePdnResponse{
+		Pdn: pdn,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameMovesSavedWhenPlaying(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	gameMove, found := keeper.GetGameMove(ctx, "1", 0)
+	require.True(t, found)
+	require.EqualValues(t, types.GameMove{
+		GameIndex:  "1",
+		MoveNumber: 0,
+		Player:     "b",
+		Positions:  []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+		Board:      "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+	}, gameMove)
+}
+
+func TestGamePdnAfterWinner(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
+	response, err := keeper.GamePdn(context, &types.QueryGamePdnRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.Equal(t, "[Event \"Game 1\"]\n"+
+		"[Black \""+bob+"\"]\n"+
+		"[White \""+carol+"\"]\n"+
+		"[Result \"1-0\"]\n"+
+		"[GameType \"21\"]\n\n"+
+		"1. 9-14 21-17 2. 14x21 23-18 3. 10-14 18x9 4. 5x14 22-18 5. 14x23 27x18 6. 11-15 18x11 "+
+		"7. 8x15 24-19 8. 15x24 28x19 9. 12-16 19x12 10. 7-10 26-23 11. 3-7 30-26 12. 21x30 23-18 "+
+		"13. 30x23x14 32-27 14. 14-18 29-25 15. 10-15 12-8 16. 4x11 25-22 17. 18x25 31-26 "+
+		"18. 15-18 27-23 19. 18x27 26-22 20. 25x18 1-0",
+		response.Pdn)
+}
+
+func TestGamePdnNotFound(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	response, err := keeper.GamePdn(context, &types.QueryGamePdnRequest{GameIndex: "2"})
+	require.Nil(t, response)
+	require.EqualError(t, err, "2: game by id not found")
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GamesByPlayer(c context.Context, req *types.QueryGamesByPlayerRequest) (*types.QueryGamesByPlayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	wanted := req.Status
+	switch wanted {
+	case "":
+		wanted = types.PlayerGameStatusAll
+	case types.PlayerGameStatusPending, types.PlayerGameStatusActive, types.PlayerGameStatusFinished, types.PlayerGameStatusAll:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown status %s", req.Status)
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var storedGames []types.StoredGame
+	var archivedGames []types.ArchivedGame
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	playerGameStore := prefix.NewStore(store, append(types.KeyPrefix(types.PlayerGameKeyPrefix), types.PlayerGamesKey(req.Address)...))
+
+	pageRes, err := query.FilteredPaginate(playerGameStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if wanted != types.PlayerGameStatusAll && wanted != string(value) {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+		// The key is the game index followed by the separator
+		gameIndex := string(key[:len(key)-1])
+		if string(value) == types.PlayerGameStatusFinished {
+			archivedGame, found := k.GetArchivedGame(ctx, gameIndex)
+			if found {
+				archivedGames = append(archivedGames, archivedGame)
+				return true, nil
+			}
+			// A finished game from before the archive may still be in the stored games
+		}
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			return false, status.Errorf(codes.Internal, "game %s not found", gameIndex)
+		}
+		if err := storedGame.DeriveBoard(); err != nil {
+			return false, err
+		}
+		storedGames = append(storedGames, storedGame)
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGamesByPlayerResponse{
+		StoredGames:   storedGames,
+		ArchivedGames: archivedGames,
+		Pagination:    pageRes,
+	}, nil
+}
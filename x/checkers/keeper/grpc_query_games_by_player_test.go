@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+)
+
+func TestGamesByPlayerQuery(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	genesis := types.DefaultGenesis()
+	genesis.StoredGameList = []types.StoredGame{
+		{Index: "1", Black: bob, Red: carol, Winner: "*", Deadline: types.FormatDeadline(ctx.BlockTime()), PackedBoard: rules.New().Pack()},
+		{Index: "2", Black: bob, Red: alice, Winner: "b", PackedBoard: rules.New().Pack()},
+	}
+	genesis.ArchivedGameList = []types.ArchivedGame{
+		{Index: "3", Black: carol, Red: bob, Winner: "d", Reason: types.ArchiveReasonDraw},
+	}
+	checkers.InitGenesis(ctx, *keeper, *genesis)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGamesByPlayerRequest
+		response []string
+		err      error
+	}{
+		{
+			desc:     "All",
+			request:  &types.QueryGamesByPlayerRequest{Address: bob, Status: types.PlayerGameStatusAll},
+			response: []string{"1", "2", "3"},
+		},
+		{
+			desc:     "AllByDefault",
+			request:  &types.QueryGamesByPlayerRequest{Address: carol},
+			response: []string{"1", "3"},
+		},
+		{
+			desc:     "Active",
+			request:  &types.QueryGamesByPlayerRequest{Address: bob, Status: types.PlayerGameStatusActive},
+			response: []string{"1"},
+		},
+		{
+			desc:     "Finished",
+			request:  &types.QueryGamesByPlayerRequest{Address: bob, Status: types.PlayerGameStatusFinished},
+			response: []string{"2", "3"},
+		},
+		{
+			desc:     "NoGames",
+			request:  &types.QueryGamesByPlayerRequest{Address: alice, Status: types.PlayerGameStatusActive},
+			response: []string{},
+		},
+		{
+			desc:    "UnknownStatus",
+			request: &types.QueryGamesByPlayerRequest{Address: bob, Status: "won"},
+			err:     status.Error(codes.InvalidArgument, "unknown status won"),
+		},
+		{
+			desc:    "InvalidAddress",
+			request: &types.QueryGamesByPlayerRequest{Address: "cosmos123", Status: types.PlayerGameStatusAll},
+			err:     status.Error(codes.InvalidArgument, "decoding bech32 failed: invalid separator index 6"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.GamesByPlayer(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				gameIndices := []string{}
+				for _, storedGame := range response.StoredGames {
+					gameIndices = append(gameIndices, storedGame.Index)
+				}
+				for _, archivedGame := range response.ArchivedGames {
+					gameIndices = append(gameIndices, archivedGame.Index)
+				}
+				require.Equal(t, tc.response, gameIndices)
+			}
+		})
+	}
+}
+
+func TestGamesByPlayerQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	genesis := types.DefaultGenesis()
+	genesis.StoredGameList = []types.StoredGame{
+		{Index: "1", Black: bob, Red: carol, Winner: "*", Deadline: types.FormatDeadline(ctx.BlockTime()), PackedBoard: rules.New().Pack()},
+		{Index: "2", Black: bob, Red: alice, Winner: "b", PackedBoard: rules.New().Pack()},
+		{Index: "3", Black: carol, Red: bob, Winner: "*", Deadline: types.FormatDeadline(ctx.BlockTime()), PackedBoard: rules.New().Pack()},
+		{Index: "4", Black: bob, Red: carol, Winner: "*", Deadline: types.FormatDeadline(ctx.BlockTime()), PackedBoard: rules.New().Pack()},
+	}
+	checkers.InitGenesis(ctx, *keeper, *genesis)
+	request := &types.QueryGamesByPlayerRequest{
+		Address:    bob,
+		Status:     types.PlayerGameStatusActive,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	}
+	response, err := keeper.GamesByPlayer(wctx, request)
+	require.NoError(t, err)
+	require.Len(t, response.StoredGames, 2)
+	require.Equal(t, "1", response.StoredGames[0].Index)
+	require.Equal(t, "3", response.StoredGames[1].Index)
+	require.EqualValues(t, 3, response.Pagination.Total)
+
+	request.Pagination = &query.PageRequest{Limit: 2, Key: response.Pagination.NextKey}
+	response, err = keeper.GamesByPlayer(wctx, request)
+	require.NoError(t, err)
+	require.Len(t, response.StoredGames, 1)
+	require.Equal(t, "4", response.StoredGames[0].Index)
+	require.Nil(t, response.Pagination.NextKey)
+}
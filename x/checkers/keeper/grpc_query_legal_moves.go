@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	rules "github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) LegalMoves(goCtx context.Context, req *types.QueryLegalMovesRequest) (*types.QueryLegalMovesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.GetStoredGame(ctx, req.GameIndex)
+	if !found {
+		return nil, k.GetGameNotFoundError(ctx, req.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return nil, err
+	}
+
+	legalMoves := game.LegalMoves()
+	moves := make([]types.LegalMove, 0, len(legalMoves))
+	for _, legalMove := range legalMoves {
+		moves = append(moves, types.LegalMove{
+			Positions: types.NewPositions(legalMove.Positions),
+			Captured:  types.NewPositions(legalMove.Captured),
+		})
+	}
+
+	return &types.QueryLegalMovesResponse{
+		Turn:  storedGame.Turn,
+		Moves: moves,
+	}, nil
+}
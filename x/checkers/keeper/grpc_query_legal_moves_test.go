@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type legalMovesCase struct {
+	desc     string
+	game     types.StoredGame
+	request  *types.QueryLegalMovesRequest
+	response *types.QueryLegalMovesResponse
+	err      string
+}
+
+func step(fromX, fromY, toX, toY uint64) types.LegalMove {
+	return types.LegalMove{
+		Positions: []types.Position{{X: fromX, Y: fromY}, {X: toX, Y: toY}},
+		Captured:  []types.Position{},
+	}
+}
+
+var (
+	legalMovesTestRange = []legalMovesCase{
+		{
+			desc: "First moves by black",
+			game: types.StoredGame{
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
+			},
+			request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+			response: &types.QueryLegalMovesResponse{
+				Turn: "b",
+				Moves: []types.LegalMove{
+					step(1, 2, 0, 3),
+					step(1, 2, 2, 3),
+					step(3, 2, 2, 3),
+					step(3, 2, 4, 3),
+					step(5, 2, 4, 3),
+					step(5, 2, 6, 3),
+					step(7, 2, 6, 3),
+				},
+			},
+			err: "nil",
+		},
+		{
+			desc: "Black must play the whole capture chain",
+			game: types.StoredGame{
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("********|********|*b******|**r*****|********|****r***|********|r*******"),
+				Turn:        "b",
+				Winner:      "*",
+			},
+			request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+			response: &types.QueryLegalMovesResponse{
+				Turn: "b",
+				Moves: []types.LegalMove{
+					{
+						Positions: []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+						Captured:  []types.Position{{X: 2, Y: 3}, {X: 4, Y: 5}},
+					},
+				},
+			},
+			err: "nil",
+		},
+		{
+			desc: "Red must capture",
+			game: types.StoredGame{
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("********|********|*b******|**r*****|********|****r***|********|r*******"),
+				Turn:        "r",
+				Winner:      "*",
+			},
+			request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+			response: &types.QueryLegalMovesResponse{
+				Turn: "r",
+				Moves: []types.LegalMove{
+					{
+						Positions: []types.Position{{X: 2, Y: 3}, {X: 0, Y: 1}},
+						Captured:  []types.Position{{X: 1, Y: 2}},
+					},
+				},
+			},
+			err: "nil",
+		},
+		{
+			desc: "Black continues capturing with the same piece",
+			game: types.StoredGame{
+				Index:          "1",
+				PackedBoard:    testutil.PackBoard("********|********|*******b|******r*|***b****|****r***|********|r*******"),
+				Turn:           "b",
+				Winner:         "*",
+				CapturingPiece: "3,4",
+			},
+			request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+			response: &types.QueryLegalMovesResponse{
+				Turn: "b",
+				Moves: []types.LegalMove{
+					{
+						Positions: []types.Position{{X: 3, Y: 4}, {X: 5, Y: 6}},
+						Captured:  []types.Position{{X: 4, Y: 5}},
+					},
+				},
+			},
+			err: "nil",
+		},
+		{
+			desc: "Nil request, wrong",
+			game: types.StoredGame{
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
+			},
+			request:  nil,
+			response: nil,
+			err:      "rpc error: code = InvalidArgument desc = invalid request",
+		},
+		{
+			desc: "Unknown game, wrong",
+			game: types.StoredGame{
+				Index:       "1",
+				PackedBoard: testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+				Turn:        "b",
+				Winner:      "*",
+			},
+			request:  &types.QueryLegalMovesRequest{GameIndex: "2"},
+			response: nil,
+			err:      "2: game by id not found",
+		},
+		{
+			desc: "Game finished, wrong",
+			game: types.StoredGame{
+				Index:  "1",
+				Turn:   "b",
+				Winner: "b",
+			},
+			request:  &types.QueryLegalMovesRequest{GameIndex: "1"},
+			response: nil,
+			err:      "game is already finished",
+		},
+	}
+)
+
+func TestLegalMovesCasesAsExpected(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	for _, testCase := range legalMovesTestRange {
+		t.Run(testCase.desc, func(t *testing.T) {
+			keeper.SetStoredGame(ctx, testCase.game)
+			response, err := keeper.LegalMoves(goCtx, testCase.request)
+			if testCase.response == nil {
+				require.Nil(t, response)
+			} else {
+				require.EqualValues(t, testCase.response, response)
+			}
+			if testCase.err == "nil" {
+				require.Nil(t, err)
+			} else {
+				require.EqualError(t, err, testCase.err)
+			}
+			keeper.RemoveStoredGame(ctx, testCase.game.Index)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) OpenChallenges(c context.Context, req *types.QueryOpenChallengesRequest) (*types.QueryOpenChallengesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var challenges []types.Challenge
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	challengeStore := prefix.NewStore(store, types.KeyPrefix(types.ChallengeKeyPrefix))
+
+	pageRes, err := query.Paginate(challengeStore, req.Pagination, func(key []byte, value []byte) error {
+		var challenge types.Challenge
+		if err := k.cdc.Unmarshal(value, &challenge); err != nil {
+			return err
+		}
+
+		challenges = append(challenges, challenge)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOpenChallengesResponse{Challenge: challenges, Pagination: pageRes}, nil
+}
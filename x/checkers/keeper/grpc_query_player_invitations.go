@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PlayerInvitations(c context.Context, req *types.QueryPlayerInvitationsRequest) (*types.QueryPlayerInvitationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var storedGames []types.StoredGame
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	playerGameStore := prefix.NewStore(store, append(types.KeyPrefix(types.PlayerGameKeyPrefix), types.PlayerGamesKey(req.Address)...))
+
+	pageRes, err := query.FilteredPaginate(playerGameStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if string(value) != types.PlayerGameStatusPending {
+			return false, nil
+		}
+		// The key is the game index followed by the separator
+		gameIndex := string(key[:len(key)-1])
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			return false, status.Errorf(codes.Internal, "game %s not found", gameIndex)
+		}
+		// Leave out the games that only wait for the other player
+		if !storedGame.IsPendingFor(req.Address) {
+			return false, nil
+		}
+		if accumulate {
+			if err := storedGame.DeriveBoard(); err != nil {
+				return false, err
+			}
+			storedGames = append(storedGames, storedGame)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPlayerInvitationsResponse{
+		StoredGames: storedGames,
+		Pagination:  pageRes,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+)
+
+func TestPlayerInvitationsQuery(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	genesis := types.DefaultGenesis()
+	genesis.StoredGameList = []types.StoredGame{
+		{Index: "1", Black: bob, Red: carol, Winner: "*", PackedBoard: rules.New().Pack(), Deadline: types.FormatDeadline(ctx.BlockTime()), BlackPending: true, RedPending: true, BeforeIndex: "-1", AfterIndex: "-1"},
+		{Index: "2", Black: bob, Red: alice, Winner: "*", PackedBoard: rules.New().Pack(), Deadline: types.FormatDeadline(ctx.BlockTime()), RedPending: true, BeforeIndex: "-1", AfterIndex: "-1"},
+		{Index: "3", Black: carol, Red: bob, Winner: "*", PackedBoard: rules.New().Pack(), Deadline: types.FormatDeadline(ctx.BlockTime()), BeforeIndex: "-1", AfterIndex: "-1"},
+	}
+	checkers.InitGenesis(ctx, *keeper, *genesis)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryPlayerInvitationsRequest
+		response []string
+		err      error
+	}{
+		{
+			desc:     "Invited",
+			request:  &types.QueryPlayerInvitationsRequest{Address: carol},
+			response: []string{"1"},
+		},
+		{
+			desc:     "AlreadyAccepted",
+			request:  &types.QueryPlayerInvitationsRequest{Address: bob},
+			response: []string{"1"},
+		},
+		{
+			desc:     "OnlyPending",
+			request:  &types.QueryPlayerInvitationsRequest{Address: alice},
+			response: []string{"2"},
+		},
+		{
+			desc:    "InvalidAddress",
+			request: &types.QueryPlayerInvitationsRequest{Address: "cosmos123"},
+			err:     status.Error(codes.InvalidArgument, "decoding bech32 failed: invalid separator index 6"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.PlayerInvitations(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				gameIndices := []string{}
+				for _, storedGame := range response.StoredGames {
+					gameIndices = append(gameIndices, storedGame.Index)
+				}
+				require.Equal(t, tc.response, gameIndices)
+			}
+		})
+	}
+}
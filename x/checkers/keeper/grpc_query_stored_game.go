@@ -4,9 +4,7 @@ import (
 	"context"
 
 	"github.com/b9lab/checkers/x/checkers/types"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -16,25 +14,17 @@ func (k Keeper) StoredGameAll(c context.Context, req *types.QueryAllStoredGameRe
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var storedGames []types.StoredGame
 	ctx := sdk.UnwrapSDKContext(c)
 
-	store := ctx.KVStore(k.storeKey)
-	storedGameStore := prefix.NewStore(store, types.KeyPrefix(types.StoredGameKeyPrefix))
-
-	pageRes, err := query.Paginate(storedGameStore, req.Pagination, func(key []byte, value []byte) error {
-		var storedGame types.StoredGame
-		if err := k.cdc.Unmarshal(value, &storedGame); err != nil {
-			return err
-		}
-
-		storedGames = append(storedGames, storedGame)
-		return nil
-	})
-
+	storedGames, pageRes, err := k.GetStoredGamePage(ctx, req.Pagination)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	for i := range storedGames {
+		if err := storedGames[i].DeriveBoard(); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
 
 	return &types.QueryAllStoredGameResponse{StoredGame: storedGames, Pagination: pageRes}, nil
 }
@@ -52,6 +42,9 @@ func (k Keeper) StoredGame(c context.Context, req *types.QueryGetStoredGameReque
 	if !found {
 		return nil, status.Error(codes.NotFound, "not found")
 	}
+	if err := val.DeriveBoard(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryGetStoredGameResponse{StoredGame: val}, nil
 }
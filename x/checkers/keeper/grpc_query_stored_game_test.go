@@ -22,6 +22,9 @@ func TestStoredGameQuerySingle(t *testing.T) {
 	keeper, ctx := keepertest.CheckersKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
 	msgs := createNStoredGame(keeper, ctx, 2)
+	for i := range msgs {
+		require.Nil(t, msgs[i].DeriveBoard())
+	}
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetStoredGameRequest
@@ -73,6 +76,9 @@ func TestStoredGameQueryPaginated(t *testing.T) {
 	keeper, ctx := keepertest.CheckersKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
 	msgs := createNStoredGame(keeper, ctx, 5)
+	for i := range msgs {
+		require.Nil(t, msgs[i].DeriveBoard())
+	}
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllStoredGameRequest {
 		return &types.QueryAllStoredGameRequest{
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/types"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func TestTournamentQuerySingle(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	msgs := createNTournament(keeper, ctx, 2)
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetTournamentRequest
+		response *types.QueryGetTournamentResponse
+		err      error
+	}{
+		{
+			desc: "First",
+			request: &types.QueryGetTournamentRequest{
+				Index: msgs[0].Index,
+			},
+			response: &types.QueryGetTournamentResponse{Tournament: msgs[0]},
+		},
+		{
+			desc: "Second",
+			request: &types.QueryGetTournamentRequest{
+				Index: msgs[1].Index,
+			},
+			response: &types.QueryGetTournamentResponse{Tournament: msgs[1]},
+		},
+		{
+			desc: "KeyNotFound",
+			request: &types.QueryGetTournamentRequest{
+				Index: strconv.Itoa(100000),
+			},
+			err: status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.Tournament(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
+
+func TestTournamentStandingsQuery(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetTournament(ctx, types.Tournament{
+		Index:  "1",
+		Format: types.TournamentFormatSwiss,
+		Players: []types.TournamentPlayer{
+			{Address: alice, Score: 1, Opponents: []string{bob}},
+			{Address: bob, Score: 3, Opponents: []string{alice}, HadBye: true},
+		},
+	})
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryTournamentStandingsRequest
+		response *types.QueryTournamentStandingsResponse
+		err      error
+	}{
+		{
+			desc:    "Found",
+			request: &types.QueryTournamentStandingsRequest{Index: "1"},
+			response: &types.QueryTournamentStandingsResponse{Standings: []types.TournamentStanding{
+				{Rank: 1, Address: bob, Score: 3, Tiebreak: 1},
+				{Rank: 2, Address: alice, Score: 1, Tiebreak: 3},
+			}},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryTournamentStandingsRequest{Index: "2"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.TournamentStandings(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t,
+					nullify.Fill(tc.response),
+					nullify.Fill(response),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	rules "github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	escrowSolvencyInvariant    = "escrow-solvency"
+	betEscrowSolvencyInvariant = "bet-escrow-solvency"
+	gameFifoInvariant          = "game-fifo"
+	leaderboardInvariant       = "leaderboard"
+)
+
+// RegisterInvariants registers all checkers invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, escrowSolvencyInvariant, EscrowSolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, betEscrowSolvencyInvariant, BetEscrowSolvencyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, gameFifoInvariant, GameFifoInvariant(k))
+	ir.RegisterRoute(types.ModuleName, leaderboardInvariant, LeaderboardInvariant(k))
+}
+
+// AllInvariants runs all invariants of the checkers module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			EscrowSolvencyInvariant(k),
+			BetEscrowSolvencyInvariant(k),
+			GameFifoInvariant(k),
+			LeaderboardInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// GetEscrowedWager returns what CollectWager has taken so far for an unfinished game: nothing before black's first
+// move, black's wager after it, and both wagers once red has moved too.
+func GetEscrowedWager(storedGame types.StoredGame) sdk.Coins {
+	switch {
+	case storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] || storedGame.MoveCount == 0:
+		return sdk.NewCoins()
+	case storedGame.MoveCount == 1:
+		return storedGame.Wager
+	default:
+		return storedGame.Wager.Add(storedGame.Wager...)
+	}
+}
+
+// EscrowSolvencyInvariant checks that the module account holds, per denom, exactly the wagers collected by the
+// unfinished games and the prize pools of the tournaments. The module account is blocked from receiving funds by
+// other means.
+func EscrowSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, storedGame := range k.GetAllStoredGame(ctx) {
+			expected = expected.Add(GetEscrowedWager(storedGame)...)
+		}
+		for _, tournament := range k.GetAllTournament(ctx) {
+			expected = expected.Add(tournament.PrizePool...)
+		}
+		balance := k.bank.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		// Coins.IsEqual panics on different denoms
+		broken := !balance.IsAllGTE(expected) || !expected.IsAllGTE(balance)
+		return sdk.FormatInvariant(types.ModuleName, escrowSolvencyInvariant, fmt.Sprintf(
+			"\tmodule account balance: %s\n\tsum of escrowed wagers and prize pools: %s\n", balance, expected)), broken
+	}
+}
+
+// BetEscrowSolvencyInvariant checks that the bet escrow account holds, per denom, exactly the pools of the bets not
+// yet settled.
+func BetEscrowSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, gameBets := range k.GetAllGameBets(ctx) {
+			expected = expected.Add(gameBets.GetPool()...)
+		}
+		balance := k.bank.GetAllBalances(ctx, authtypes.NewModuleAddress(types.BetEscrowName))
+		broken := !balance.IsAllGTE(expected) || !expected.IsAllGTE(balance)
+		return sdk.FormatInvariant(types.ModuleName, betEscrowSolvencyInvariant, fmt.Sprintf(
+			"\tbet escrow balance: %s\n\tsum of bet pools: %s\n", balance, expected)), broken
+	}
+}
+
+// GameFifoInvariant checks that the deadline queue holds exactly the unfinished games that went live, and that the
+// invitation deadline queue holds exactly the pending games, each under its deadline.
+func GameFifoInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := checkGameFifo(ctx, k)
+		return sdk.FormatInvariant(types.ModuleName, gameFifoInvariant, msg), broken
+	}
+}
+
+func checkGameFifo(ctx sdk.Context, k Keeper) (string, bool) {
+	unfinished := make(map[string]bool)
+	pending := make(map[string]bool)
+	for _, storedGame := range k.GetAllStoredGame(ctx) {
+		if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+			if storedGame.BeforeIndex != types.NoFifoIndex || storedGame.AfterIndex != types.NoFifoIndex {
+				return fmt.Sprintf("\tfinished game %s is still linked\n", storedGame.Index), true
+			}
+		} else if storedGame.IsPending() {
+			pending[storedGame.Index] = true
+		} else {
+			unfinished[storedGame.Index] = true
+		}
+	}
+	if msg, broken := checkDeadlineQueue(ctx, k, k.IterateDeadlineQueue, unfinished, "unfinished",
+		"deadline queue"); broken {
+		return msg, broken
+	}
+	return checkDeadlineQueue(ctx, k, k.IterateInvitationDeadlineQueue, pending, "pending",
+		"invitation deadline queue")
+}
+
+// checkDeadlineQueue goes through the deadline queue, expecting to find exactly the members.
+func checkDeadlineQueue(ctx sdk.Context, k Keeper, iterate func(ctx sdk.Context, do func([]byte, string) bool),
+	members map[string]bool, membersName string, queueName string) (msg string, broken bool) {
+	queued := 0
+	iterate(ctx, func(key []byte, gameIndex string) bool {
+		queued++
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
+		if !found {
+			msg, broken = fmt.Sprintf("\tgame %s not found\n", gameIndex), true
+		} else if !members[gameIndex] {
+			msg, broken = fmt.Sprintf("\tgame %s does not belong in the %s\n", gameIndex, queueName), true
+		} else if deadline, err := storedGame.GetDeadlineAsTime(); err != nil ||
+			!bytes.Equal(key, types.GameDeadlineKey(deadline, gameIndex)) {
+			msg, broken = fmt.Sprintf("\tgame %s is queued under another deadline than %s\n", gameIndex,
+				storedGame.Deadline), true
+		}
+		return broken
+	})
+	if !broken && queued != len(members) {
+		msg, broken = fmt.Sprintf("\t%d %s games but %d in %s\n", len(members), membersName, queued, queueName), true
+	}
+	return msg, broken
+}
+
+// LeaderboardInvariant checks that each leaderboard entry carries the won count of its player info, and that each
+// rating leaderboard entry carries the rating of its player rating.
+func LeaderboardInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := checkLeaderboards(ctx, k)
+		return sdk.FormatInvariant(types.ModuleName, leaderboardInvariant, msg), broken
+	}
+}
+
+func checkLeaderboards(ctx sdk.Context, k Keeper) (string, bool) {
+	leaderboard, _ := k.GetLeaderboard(ctx)
+	for _, winner := range leaderboard.Winners {
+		playerInfo, found := k.GetPlayerInfo(ctx, winner.PlayerAddress)
+		if !found {
+			return fmt.Sprintf("\tplayer info of %s not found\n", winner.PlayerAddress), true
+		}
+		if playerInfo.WonCount != winner.WonCount {
+			return fmt.Sprintf("\t%s has won %d but leaderboard says %d\n",
+				winner.PlayerAddress, playerInfo.WonCount, winner.WonCount), true
+		}
+	}
+	ratingLeaderboard, _ := k.GetRatingLeaderboard(ctx)
+	for _, ratedPlayer := range ratingLeaderboard.Players {
+		playerRating, found := k.GetPlayerRating(ctx, ratedPlayer.PlayerAddress)
+		if !found {
+			return fmt.Sprintf("\tplayer rating of %s not found\n", ratedPlayer.PlayerAddress), true
+		}
+		if playerRating.Rating != ratedPlayer.Rating {
+			return fmt.Sprintf("\t%s is rated %d but rating leaderboard says %d\n",
+				ratedPlayer.PlayerAddress, playerRating.Rating, ratedPlayer.Rating), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEscrowedWager(t *testing.T) {
+	wager := sdk.NewCoins(sdk.NewInt64Coin("stake", 45))
+	require.Equal(t, "", keeper.GetEscrowedWager(types.StoredGame{Winner: "*", MoveCount: 0, Wager: wager}).String())
+	require.Equal(t, "45stake", keeper.GetEscrowedWager(types.StoredGame{Winner: "*", MoveCount: 1, Wager: wager}).String())
+	require.Equal(t, "90stake", keeper.GetEscrowedWager(types.StoredGame{Winner: "*", MoveCount: 2, Wager: wager}).String())
+	require.Equal(t, "", keeper.GetEscrowedWager(types.StoredGame{Winner: "b", MoveCount: 2, Wager: wager}).String())
+}
+
+func TestEscrowSolvencyInvariantOtherDenom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	k.SetStoredGame(ctx, types.StoredGame{
+		Index:     "1",
+		Winner:    "*",
+		MoveCount: 1,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	bankMock.EXPECT().GetAllBalances(ctx, gomock.Any()).Return(sdk.NewCoins(sdk.NewInt64Coin("coin", 45)))
+	msg, broken := keeper.EscrowSolvencyInvariant(*k)(ctx)
+	require.True(t, broken)
+	require.Equal(t, "checkers: escrow-solvency invariant\n"+
+		"\tmodule account balance: 45coin\n"+
+		"\tsum of escrowed wagers and prize pools: 45stake\n\n", msg)
+}
+
+const (
+	gameFifoDeadline1 = "2006-01-02 15:05:06.999999999 +0000 UTC"
+	gameFifoDeadline2 = "2006-01-02 15:05:07.999999999 +0000 UTC"
+)
+
+func setupGameFifo(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	for _, storedGame := range []types.StoredGame{
+		{Index: "1", Winner: "*", Deadline: gameFifoDeadline2, BeforeIndex: "-1", AfterIndex: "-1"},
+		{Index: "2", Winner: "*", Deadline: gameFifoDeadline1, BeforeIndex: "-1", AfterIndex: "-1"},
+	} {
+		k.InsertDeadlineQueue(ctx, &storedGame)
+		k.SetStoredGame(ctx, storedGame)
+	}
+	k.SetStoredGame(ctx, types.StoredGame{Index: "3", Winner: "b", BeforeIndex: "-1", AfterIndex: "-1"})
+	for _, storedGame := range []types.StoredGame{
+		{Index: "4", Winner: "*", Deadline: gameFifoDeadline2, RedPending: true, BeforeIndex: "-1", AfterIndex: "-1"},
+		{Index: "5", Winner: "*", Deadline: gameFifoDeadline1, RedPending: true, BeforeIndex: "-1", AfterIndex: "-1"},
+	} {
+		k.InsertInvitationDeadlineQueue(ctx, &storedGame)
+		k.SetStoredGame(ctx, storedGame)
+	}
+	k.SetSystemInfo(ctx, types.SystemInfo{
+		NextId:                 6,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
+	})
+	return *k, ctx
+}
+
+func TestGameFifoInvariant(t *testing.T) {
+	k, ctx := setupGameFifo(t)
+	msg, broken := keeper.GameFifoInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestGameFifoInvariantEmpty(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msg, broken := keeper.GameFifoInvariant(*k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestGameFifoInvariantBroken(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(k keeper.Keeper, ctx sdk.Context)
+		msg    string
+	}{
+		{
+			desc: "unfinished game left out",
+			modify: func(k keeper.Keeper, ctx sdk.Context) {
+				k.SetStoredGame(ctx, types.StoredGame{Index: "3", Winner: "*", Deadline: gameFifoDeadline1, BeforeIndex: "-1", AfterIndex: "-1"})
+			},
+			msg: "\t3 unfinished games but 2 in deadline queue\n",
+		},
+		{
+			desc: "finished game still queued",
+			modify: func(k keeper.Keeper, ctx sdk.Context) {
+				k.SetStoredGame(ctx, types.StoredGame{Index: "2", Winner: "r", Deadline: gameFifoDeadline1, BeforeIndex: "-1", AfterIndex: "-1"})
+			},
+			msg: "\tgame 2 does not belong in the deadline queue\n",
+		},
+		{
+			desc: "queued under another deadline",
+			modify: func(k keeper.Keeper, ctx sdk.Context) {
+				k.SetStoredGame(ctx, types.StoredGame{Index: "2", Winner: "*", Deadline: gameFifoDeadline2, BeforeIndex: "-1", AfterIndex: "-1"})
+			},
+			msg: "\tgame 2 is queued under another deadline than " + gameFifoDeadline2 + "\n",
+		},
+		{
+			desc: "pending game queued",
+			modify: func(k keeper.Keeper, ctx sdk.Context) {
+				k.SetStoredGame(ctx, types.StoredGame{Index: "2", Winner: "*", Deadline: gameFifoDeadline1, BlackPending: true, BeforeIndex: "-1", AfterIndex: "-1"})
+			},
+			msg: "\tgame 2 does not belong in the deadline queue\n",
+		},
+		{
+			desc: "finished game still linked",
+			modify: func(k keeper.Keeper, ctx sdk.Context) {
+				k.SetStoredGame(ctx, types.StoredGame{Index: "3", Winner: "b", BeforeIndex: "5", AfterIndex: "-1"})
+			},
+			msg: "\tfinished game 3 is still linked\n",
+		},
+		{
+			desc: "pending game left out of invitation deadline queue",
+			modify: func(k keeper.Keeper, ctx sdk.Context) {
+				k.SetStoredGame(ctx, types.StoredGame{Index: "6", Winner: "*", Deadline: gameFifoDeadline1, BlackPending: true, BeforeIndex: "-1", AfterIndex: "-1"})
+			},
+			msg: "\t3 pending games but 2 in invitation deadline queue\n",
+		},
+		{
+			desc: "finished game still in invitation deadline queue",
+			modify: func(k keeper.Keeper, ctx sdk.Context) {
+				k.SetStoredGame(ctx, types.StoredGame{Index: "5", Winner: "r", Deadline: gameFifoDeadline1, BeforeIndex: "-1", AfterIndex: "-1"})
+			},
+			msg: "\tgame 5 does not belong in the invitation deadline queue\n",
+		},
+		{
+			desc: "invitation queued under another deadline",
+			modify: func(k keeper.Keeper, ctx sdk.Context) {
+				k.SetStoredGame(ctx, types.StoredGame{Index: "5", Winner: "*", Deadline: gameFifoDeadline2, RedPending: true, BeforeIndex: "-1", AfterIndex: "-1"})
+			},
+			msg: "\tgame 5 is queued under another deadline than " + gameFifoDeadline2 + "\n",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			k, ctx := setupGameFifo(t)
+			tc.modify(k, ctx)
+			msg, broken := keeper.GameFifoInvariant(k)(ctx)
+			require.True(t, broken)
+			require.Equal(t, "checkers: game-fifo invariant\n"+tc.msg+"\n", msg)
+		})
+	}
+}
+
+func TestLeaderboardInvariant(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 2})
+	k.SetPlayerRating(ctx, types.PlayerRating{Index: alice, Rating: 1662, RatingDeviation: 290})
+	k.SetLeaderboard(ctx, types.Leaderboard{Winners: []types.WinningPlayer{
+		{PlayerAddress: alice, WonCount: 2, DateAdded: "2006-01-02 15:05:06.999999999 +0000 UTC"},
+	}})
+	k.SetRatingLeaderboard(ctx, types.RatingLeaderboard{Players: []types.RatedPlayer{
+		{PlayerAddress: alice, Rating: 1662, DateAdded: "2006-01-02 15:05:06.999999999 +0000 UTC"},
+	}})
+	msg, broken := keeper.LeaderboardInvariant(*k)(ctx)
+	require.False(t, broken, msg)
+
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 3})
+	msg, broken = keeper.LeaderboardInvariant(*k)(ctx)
+	require.True(t, broken)
+	require.Equal(t, "checkers: leaderboard invariant\n\t"+alice+" has won 3 but leaderboard says 2\n\n", msg)
+
+	k.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, WonCount: 2})
+	k.RemovePlayerRating(ctx, alice)
+	msg, broken = keeper.LeaderboardInvariant(*k)(ctx)
+	require.True(t, broken)
+	require.Equal(t, "checkers: leaderboard invariant\n\tplayer rating of "+alice+" not found\n\n", msg)
+}
@@ -13,16 +13,18 @@ import (
 
 type (
 	Keeper struct {
-		bank       types.BankEscrowKeeper
-		cdc        codec.BinaryCodec
-		storeKey   sdk.StoreKey
-		memKey     sdk.StoreKey
-		paramstore paramtypes.Subspace
+		bank         types.BankEscrowKeeper
+		distribution types.DistributionKeeper
+		cdc          codec.BinaryCodec
+		storeKey     sdk.StoreKey
+		memKey       sdk.StoreKey
+		paramstore   paramtypes.Subspace
 	}
 )
 
 func NewKeeper(
 	bank types.BankEscrowKeeper,
+	distribution types.DistributionKeeper,
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey sdk.StoreKey,
@@ -35,11 +37,12 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		bank:       bank,
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		paramstore: ps,
+		bank:         bank,
+		distribution: distribution,
+		cdc:          cdc,
+		storeKey:     storeKey,
+		memKey:       memKey,
+		paramstore:   ps,
 	}
 }
 
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptChallenge(goCtx context.Context, msg *types.MsgAcceptChallenge) (*types.MsgAcceptChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	challenge, found := k.Keeper.GetChallenge(ctx, msg.ChallengeIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChallengeNotFound, "%s", msg.ChallengeIndex)
+	}
+
+	if challenge.Creator == msg.Creator {
+		return nil, types.ErrCannotAcceptOwnChallenge
+	}
+
+	accepterRating := k.Keeper.GetPlayerRatingOrDefault(ctx, msg.Creator)
+	if !accepterRating.IsInBand(challenge.MinRating, challenge.MaxRating) {
+		return nil, sdkerrors.Wrapf(types.ErrRatingOutOfBand, "%d not in [%d, %d]",
+			accepterRating.Rating, challenge.MinRating, challenge.MaxRating)
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	k.Keeper.RemoveChallengeFromFifo(ctx, &challenge, &systemInfo)
+	k.Keeper.RemoveChallenge(ctx, msg.ChallengeIndex)
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	black, red := challenge.GetPlayers(msg.Creator)
+	gameIndex, err := k.createGame(ctx, msg.Creator, black, red, challenge.Wager, challenge.Variant,
+		types.TimeControl{}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ChallengeAcceptedEventType,
+			sdk.NewAttribute(types.ChallengeAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.ChallengeAcceptedEventChallengeIndex, msg.ChallengeIndex),
+			sdk.NewAttribute(types.ChallengeAcceptedEventGameIndex, gameIndex),
+		),
+	)
+
+	return &types.MsgAcceptChallengeResponse{
+		GameIndex: gameIndex,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneChallenge(t testing.TB, color string) (types.MsgServer, keeper.Keeper, context.Context) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: alice,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Color:   color,
+		Variant: "pool",
+	})
+	return msgServer, keeper, context
+}
+
+func TestAcceptChallenge(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneChallenge(t, "*")
+	acceptResponse, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptChallengeResponse{
+		GameIndex: "1",
+	}, *acceptResponse)
+}
+
+func TestAcceptChallengeNotFound(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneChallenge(t, "*")
+	acceptResponse, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "2",
+	})
+	require.Nil(t, acceptResponse)
+	require.EqualError(t, err, "2: challenge by id not found")
+}
+
+func TestAcceptOwnChallengeRejected(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneChallenge(t, "*")
+	ctx := sdk.UnwrapSDKContext(context)
+	acceptResponse, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        alice,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.EqualError(t, err, "player cannot accept their own challenge")
+	_, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+}
+
+func TestAcceptChallengeCreatedGame(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneChallenge(t, "*")
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "1",
+	})
+	_, found := keeper.GetChallenge(ctx, "1")
+	require.False(t, found)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 2,
+		NextChallengeId:        2,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
+	}, systemInfo)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.StoredGame{
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           alice,
+		Red:             bob,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Variant:         "pool",
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
+	}, game1)
+}
+
+func TestAcceptChallengeCreatorPlaysRed(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneChallenge(t, "r")
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "1",
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, bob, game1.Black)
+	require.Equal(t, alice, game1.Red)
+}
+
+func TestAcceptMiddleChallengeHasSavedFifo(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOneChallenge(t, "*")
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: bob,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		Color:   "*",
+	})
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
+		Color:   "*",
+	})
+	msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        alice,
+		ChallengeIndex: "2",
+	})
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.Equal(t, "1", systemInfo.ChallengeFifoHeadIndex)
+	require.Equal(t, "3", systemInfo.ChallengeFifoTailIndex)
+	challenge1, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "3", challenge1.AfterIndex)
+	challenge3, found := keeper.GetChallenge(ctx, "3")
+	require.True(t, found)
+	require.Equal(t, "1", challenge3.BeforeIndex)
+}
+
+func TestAcceptChallengeEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOneChallenge(t, "*")
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 4)
+	event := events[1]
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "challenge-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "challenge-index", Value: "1"},
+			{Key: "game-index", Value: "1"},
+		},
+	}, event)
+}
+
+func TestAcceptChallengeBelowRatingBandRejected(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator:   alice,
+		Color:     "*",
+		MinRating: 1600,
+		Variant:   "pool",
+	})
+	acceptResponse, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.EqualError(t, err, "1500 not in [1600, 0]: player rating is outside of the challenge band")
+	_, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+}
+
+func TestAcceptChallengeAboveRatingBandRejected(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator:   alice,
+		Color:     "*",
+		MaxRating: 1600,
+		Variant:   "pool",
+	})
+	keeper.SetPlayerRating(ctx, types.PlayerRating{
+		Index:           bob,
+		Rating:          1601,
+		RatingDeviation: 100,
+	})
+	acceptResponse, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.EqualError(t, err, "1601 not in [0, 1600]: player rating is outside of the challenge band")
+}
+
+func TestAcceptChallengeInRatingBand(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator:   alice,
+		Color:     "*",
+		MinRating: 1600,
+		MaxRating: 1700,
+		Variant:   "pool",
+	})
+	keeper.SetPlayerRating(ctx, types.PlayerRating{
+		Index:           bob,
+		Rating:          1700,
+		RatingDeviation: 100,
+	})
+	acceptResponse, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptChallengeResponse{
+		GameIndex: "1",
+	}, *acceptResponse)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptDraw(goCtx context.Context, msg *types.MsgAcceptDraw) (*types.MsgAcceptDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, k.Keeper.GetGameNotFoundError(ctx, msg.GameIndex)
+	}
+
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+
+	if storedGame.IsPending() {
+		return nil, types.ErrGamePending
+	}
+
+	color, found := storedGame.GetPlayerColor(msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	if storedGame.DrawOfferer == "" {
+		return nil, types.ErrNoDrawOffered
+	}
+
+	if storedGame.DrawOfferer == color {
+		return nil, types.ErrCannotAnswerOwnDraw
+	}
+
+	lastBoard, err := storedGame.FormatBoard()
+	if err != nil {
+		panic(err.Error())
+	}
+	k.Keeper.RemoveFromDeadlineQueue(ctx, &storedGame)
+	k.Keeper.RemoveActiveGame(ctx, &storedGame)
+	storedGame.Winner = rules.PieceStrings[rules.DRAW_PLAYER]
+	refund := k.Keeper.MustRefundWager(ctx, &storedGame)
+	k.Keeper.MustSettleBets(ctx, msg.GameIndex, storedGame.Winner)
+	k.Keeper.MustRegisterPlayerDraw(ctx, &storedGame)
+	k.Keeper.ArchiveGame(ctx, &storedGame, types.ArchiveReasonDraw, lastBoard)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameDrawnEventType,
+			sdk.NewAttribute(types.GameDrawnEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameDrawnEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameDrawnEventBoard, lastBoard),
+		),
+	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGameDrawn{
+		Creator:   msg.Creator,
+		GameIndex: msg.GameIndex,
+		Winner:    storedGame.Winner,
+		Board:     lastBoard,
+		Refund:    refund,
+		Black:     storedGame.Black,
+		Red:       storedGame.Red,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptDrawResponse{}, nil
+}
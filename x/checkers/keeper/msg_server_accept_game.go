@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptGame(goCtx context.Context, msg *types.MsgAcceptGame) (*types.MsgAcceptGameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, k.Keeper.GetGameNotFoundError(ctx, msg.GameIndex)
+	}
+
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+
+	if storedGame.Black != msg.Creator && storedGame.Red != msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	if !storedGame.IsPendingFor(msg.Creator) {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotPending, "%s", msg.Creator)
+	}
+
+	storedGame.Accept(msg.Creator)
+	live := !storedGame.IsPending()
+	if live {
+		// Counted as active since it was created
+		err := k.Keeper.ValidatePlayersCanPay(ctx, &storedGame)
+		if err != nil {
+			return nil, err
+		}
+
+		k.Keeper.RemoveFromInvitationDeadlineQueue(ctx, &storedGame)
+		storedGame.StartClocks(ctx, k.Keeper.GetParams(ctx).TurnDuration())
+		k.Keeper.InsertDeadlineQueue(ctx, &storedGame)
+	}
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.SetPlayerGames(ctx, &storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameAcceptedEventType,
+			sdk.NewAttribute(types.GameAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameAcceptedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameAcceptedEventLive, strconv.FormatBool(live)),
+		),
+	)
+
+	return &types.MsgAcceptGameResponse{
+		Live: live,
+	}, nil
+}
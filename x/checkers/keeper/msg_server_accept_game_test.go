@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOnePendingGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, err)
+	return msgServer, keeper, context
+}
+
+func TestAcceptGameGameNotFound(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOnePendingGame(t)
+	acceptResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
+
+func TestAcceptGameByNonPlayer(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOnePendingGame(t)
+	acceptResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestAcceptGameTwice(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOnePendingGame(t)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	acceptResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, bob+": game has already been accepted by: %s", err.Error())
+}
+
+func TestAcceptGameFirstPlayerStillPending(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	acceptResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptGameResponse{
+		Live: false,
+	}, *acceptResponse)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.False(t, game1.BlackPending)
+	require.True(t, game1.RedPending)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))), game1.Deadline)
+	require.Empty(t, queuedGames(keeper, ctx))
+	require.Equal(t, []string{"1"}, keeper.GetExpiredInvitationIndices(ctx.WithBlockTime(ctx.BlockTime().Add(
+		types.SecondsToDuration(types.DefaultInvitationTimeout)+time.Second))))
+	// Counted since it was created
+	require.EqualValues(t, 1, keeper.GetActiveGameCount(ctx, bob))
+}
+
+func TestAcceptGameBothPlayersGoesLive(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	acceptResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptGameResponse{
+		Live: true,
+	}, *acceptResponse)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
+	}, systemInfo)
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.StoredGame{
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
+	}, game1)
+	require.EqualValues(t, 1, keeper.GetActiveGameCount(ctx, bob))
+	require.EqualValues(t, 1, keeper.GetActiveGameCount(ctx, carol))
+	require.Empty(t, keeper.GetExpiredInvitationIndices(ctx.WithBlockTime(ctx.BlockTime().Add(
+		types.SecondsToDuration(types.DefaultInvitationTimeout)+time.Second))))
+}
+
+func TestAcceptGameEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	event := events[1]
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "live", Value: "false"},
+		},
+	}, event)
+}
+
+func TestCreateGameTooManyPendingGames(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.MaxGamesPerPlayer = 1
+	keeper.SetParams(ctx, params)
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   alice,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, carol+": player has too many active games: %s")
+	_, found := keeper.GetStoredGame(ctx, "2")
+	require.False(t, found)
+}
+
+func TestPlayMovePendingGame(t *testing.T) {
+	msgServer, _, context := setupMsgServerWithOnePendingGame(t)
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, playMoveResponse)
+	require.Equal(t, "game is waiting for its players to accept it", err.Error())
+}
+
+func TestRejectPendingGameDropsIt(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerWithOnePendingGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	rejectResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgRejectGameResponse{}, *rejectResponse)
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	_, found = keeper.GetArchivedGame(ctx, "1")
+	require.False(t, found)
+	_, found = keeper.GetPlayerGameStatus(ctx, bob, "1")
+	require.False(t, found)
+	require.Empty(t, keeper.GetExpiredInvitationIndices(ctx.WithBlockTime(ctx.BlockTime().Add(
+		types.SecondsToDuration(types.DefaultInvitationTimeout)+time.Second))))
+	require.EqualValues(t, 0, keeper.GetActiveGameCount(ctx, bob))
+	require.EqualValues(t, 0, keeper.GetActiveGameCount(ctx, carol))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptRematch(goCtx context.Context, msg *types.MsgAcceptRematch) (*types.MsgAcceptRematchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	archivedGame, color, err := k.getRematchableGame(ctx, msg.Creator, msg.GameIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if archivedGame.RematchProposer == "" {
+		return nil, types.ErrNoRematchProposed
+	}
+
+	if archivedGame.RematchProposer == color {
+		return nil, types.ErrCannotAcceptOwnRematch
+	}
+
+	// The colours are swapped
+	newIndex, err := k.createGame(ctx, msg.Creator, archivedGame.Red, archivedGame.Black, archivedGame.Wager,
+		archivedGame.Variant, archivedGame.TimeControl, false)
+	if err != nil {
+		return nil, err
+	}
+	newGame, found := k.Keeper.GetStoredGame(ctx, newIndex)
+	if !found {
+		panic("rematch game not found " + newIndex)
+	}
+	newGame.PreviousIndex = archivedGame.Index
+	k.Keeper.SetStoredGame(ctx, newGame)
+
+	archivedGame.RematchIndex = newIndex
+	k.Keeper.SetArchivedGame(ctx, archivedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.RematchAcceptedEventType,
+			sdk.NewAttribute(types.RematchAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.RematchAcceptedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.RematchAcceptedEventNewGameIndex, newIndex),
+		),
+	)
+
+	return &types.MsgAcceptRematchResponse{
+		GameIndex: newIndex,
+	}, nil
+}
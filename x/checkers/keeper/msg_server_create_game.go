@@ -4,7 +4,6 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/b9lab/checkers/x/checkers/rules"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,52 +11,99 @@ import (
 func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (*types.MsgCreateGameResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	newIndex, err := k.createGame(ctx, msg.Creator, msg.Black, msg.Red, msg.Wager, msg.Variant, msg.TimeControl, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGameResponse{
+		GameIndex: newIndex,
+	}, nil
+}
+
+// createGame sets up a new game, which waits for the players other than the creator to accept it when invite is set.
+func (k msgServer) createGame(ctx sdk.Context, creator string, black string, red string, wager sdk.Coins,
+	variantName string, timeControl types.TimeControl, invite bool) (gameIndex string, err error) {
 	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
 	if !found {
 		panic("SystemInfo not found")
 	}
 	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
 
-	newGame := rules.New()
-	storedGame := types.StoredGame{
-		Index:       newIndex,
-		Board:       newGame.String(),
-		Turn:        rules.PieceStrings[newGame.Turn],
-		Black:       msg.Black,
-		Red:         msg.Red,
-		MoveCount:   0,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(types.GetNextDeadline(ctx)),
-		Winner:      rules.PieceStrings[rules.NO_PLAYER],
-		Wager:       msg.Wager,
-		Denom:       msg.Denom,
+	storedGame, err := types.NewStoredGame(newIndex, black, red, wager, variantName, timeControl)
+	if err != nil {
+		return "", err
+	}
+	params := k.Keeper.GetParams(ctx)
+	err = timeControl.ValidateWithParams(params)
+	if err != nil {
+		return "", err
+	}
+	err = params.ValidateWager(wager)
+	if err != nil {
+		return "", err
+	}
+	err = k.Keeper.ValidateActiveGameCount(ctx, black, red)
+	if err != nil {
+		return "", err
+	}
+	if invite {
+		storedGame.Invite(creator)
+	}
+	if storedGame.IsPending() {
+		storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(params.InvitationDuration()))
+	} else {
+		storedGame.StartClocks(ctx, params.TurnDuration())
 	}
 
-	err := storedGame.Validate()
+	err = storedGame.Validate()
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	err = k.Keeper.ValidatePlayersCanPay(ctx, &storedGame)
+	if err != nil {
+		return "", err
 	}
 
-	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
-	k.Keeper.SetStoredGame(ctx, storedGame)
-	systemInfo.NextId++
+	k.Keeper.AddNewGame(ctx, creator, &storedGame, &systemInfo)
 	k.Keeper.SetSystemInfo(ctx, systemInfo)
 
-	ctx.GasMeter().ConsumeGas(types.CreateGameGas, "Create game")
+	ctx.GasMeter().ConsumeGas(params.CreateGameGas, "Create game")
+
+	return newIndex, nil
+}
+
+// AddNewGame saves a validated new game under the next game index and queues it by deadline, or by invitation
+// deadline when it is pending. A pending game counts as active for its players already, so that invitations cannot
+// pile up beyond MaxGamesPerPlayer. The caller saves the system info.
+func (k Keeper) AddNewGame(ctx sdk.Context, creator string, storedGame *types.StoredGame, systemInfo *types.SystemInfo) {
+	if storedGame.IsPending() {
+		k.InsertInvitationDeadlineQueue(ctx, storedGame)
+	} else {
+		k.InsertDeadlineQueue(ctx, storedGame)
+	}
+	k.AddActiveGame(ctx, storedGame)
+	k.SetStoredGame(ctx, *storedGame)
+	k.SetPlayerGames(ctx, storedGame)
+	systemInfo.NextId++
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.GameCreatedEventType,
-			sdk.NewAttribute(types.GameCreatedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
-			sdk.NewAttribute(types.GameCreatedEventBlack, msg.Black),
-			sdk.NewAttribute(types.GameCreatedEventRed, msg.Red),
-			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
-			sdk.NewAttribute(types.GameCreatedEventDenom, msg.Denom),
+			sdk.NewAttribute(types.GameCreatedEventCreator, creator),
+			sdk.NewAttribute(types.GameCreatedEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.GameCreatedEventBlack, storedGame.Black),
+			sdk.NewAttribute(types.GameCreatedEventRed, storedGame.Red),
+			sdk.NewAttribute(types.GameCreatedEventWager, storedGame.Wager.String()),
 		),
 	)
-
-	return &types.MsgCreateGameResponse{
-		GameIndex: newIndex,
-	}, nil
+	err := ctx.EventManager().EmitTypedEvent(&types.EventGameCreated{
+		Creator:   creator,
+		GameIndex: storedGame.Index,
+		Black:     storedGame.Black,
+		Red:       storedGame.Red,
+		Wager:     storedGame.Wager,
+	})
+	if err != nil {
+		panic(err.Error())
+	}
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/b9lab/checkers/x/checkers/testutil"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -11,123 +12,131 @@ import (
 func TestCreate3GamesHasSavedFifo(t *testing.T) {
 	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
-	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgSrvr, context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 
 	// Second game
-	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgSrvr, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	systemInfo2, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "2",
+		NextId:                 3,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo2)
+	require.Equal(t, []string{"1", "2"}, queuedGames(keeper, ctx))
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(0),
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       uint64(0),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   uint64(0),
-		BeforeIndex: "1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       46,
-		Denom:       "coin",
+		Index:           "2",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           carol,
+		Red:             alice,
+		MoveCount:       uint64(0),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game2)
 
 	// Third game
-	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgSrvr, context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
-		Denom:   "gold",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	})
 	systemInfo3, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId:                 4,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo3)
+	require.Equal(t, []string{"1", "2", "3"}, queuedGames(keeper, ctx))
 	game1, found = keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(0),
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       uint64(0),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game1)
 	game2, found = keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   uint64(0),
-		BeforeIndex: "1",
-		AfterIndex:  "3",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       46,
-		Denom:       "coin",
+		Index:           "2",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           carol,
+		Red:             alice,
+		MoveCount:       uint64(0),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game2)
 	game3, found := keeper.GetStoredGame(ctx, "3")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "3",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       alice,
-		Red:         bob,
-		MoveCount:   uint64(0),
-		BeforeIndex: "2",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       47,
-		Denom:       "gold",
+		Index:           "3",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           alice,
+		Red:             bob,
+		MoveCount:       uint64(0),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game3)
 }
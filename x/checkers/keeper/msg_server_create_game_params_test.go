@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGameWagerOutOfBounds(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.MinWager = sdk.NewInt(50)
+	keeper.SetParams(ctx, params)
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "45stake is not within 50 and 0: wager is outside of the allowed bounds: %s")
+}
+
+func TestCreateGameDenomNotAllowed(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.AllowedDenoms = []string{"stake"}
+	keeper.SetParams(ctx, params)
+	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 45)),
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "coin: denom is not allowed: %s")
+}
+
+func TestOpenChallengeDenomNotAllowed(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.AllowedDenoms = []string{"stake"}
+	keeper.SetParams(ctx, params)
+	openResponse, err := msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: alice,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 45)),
+		Color:   "*",
+	})
+	require.Nil(t, openResponse)
+	require.EqualError(t, err, "coin: denom is not allowed: %s")
+}
+
+func TestCreateGameUsesTurnDurationParam(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.MaxTurnDuration = 3_600
+	keeper.SetParams(ctx, params)
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(time.Hour)), game1.Deadline)
+}
+
+func TestCreateGameKeepsTurnDurationWithinMoveTimes(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.MaxMoveTime = 3_600
+	params.MaxTurnDuration = 7_200
+	require.Error(t, params.Validate())
+	keeper.SetParams(ctx, params)
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(time.Hour)), game1.Deadline)
+}
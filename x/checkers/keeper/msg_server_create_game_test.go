@@ -7,15 +7,21 @@ import (
 	keepertest "github.com/b9lab/checkers/testutil/keeper"
 	"github.com/b9lab/checkers/x/checkers"
 	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 func setupMsgServerCreateGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context) {
-	k, ctx := keepertest.CheckersKeeper(t)
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
-	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectSpendableAny(context)
+	return keeper.NewMsgServerImpl(*k), *k, context
 }
 
 func TestCreateGame(t *testing.T) {
@@ -24,8 +30,7 @@ func TestCreateGame(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, err)
 	require.EqualValues(t, types.MsgCreateGameResponse{
@@ -40,31 +45,35 @@ func TestCreate1GameHasSaved(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found1)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game1)
 }
 
@@ -75,24 +84,26 @@ func TestCreate1GameGetAll(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	games := keeper.GetAllStoredGame(ctx)
 	require.Len(t, games, 1)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, games[0])
 }
 
@@ -103,13 +114,12 @@ func TestCreate1GameEmitted(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 1)
-	event := events[0]
+	require.Len(t, events, 2)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "new-game-created",
 		Attributes: []sdk.Attribute{
@@ -117,8 +127,7 @@ func TestCreate1GameEmitted(t *testing.T) {
 			{Key: "game-index", Value: "1"},
 			{Key: "black", Value: bob},
 			{Key: "red", Value: carol},
-			{Key: "wager", Value: "45"},
-			{Key: "denom", Value: "stake"},
+			{Key: "wager", Value: "45stake"},
 		},
 	}, event)
 }
@@ -131,8 +140,7 @@ func TestCreate1GameConsumedGas(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	after := ctx.GasMeter().GasConsumed()
 	require.GreaterOrEqual(t, after, before+25_000)
@@ -144,8 +152,7 @@ func TestCreateGameRedAddressBad(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     "notanaddress",
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, createResponse)
 	require.Equal(t,
@@ -159,8 +166,7 @@ func TestCreateGameEmptyRedAddress(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     "",
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, createResponse)
 	require.Equal(t,
@@ -174,15 +180,13 @@ func TestCreate3Games(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	createResponse2, err2 := msgSrvr.CreateGame(context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	require.Nil(t, err2)
 	require.EqualValues(t, types.MsgCreateGameResponse{
@@ -192,8 +196,7 @@ func TestCreate3Games(t *testing.T) {
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
-		Denom:   "gold",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	})
 	require.Nil(t, err3)
 	require.EqualValues(t, types.MsgCreateGameResponse{
@@ -208,77 +211,85 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
-		Denom:   "gold",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	})
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId:                 4,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found1)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game1)
 	game2, found2 := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found2)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   0,
-		BeforeIndex: "1",
-		AfterIndex:  "3",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       46,
-		Denom:       "coin",
+		Index:           "2",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           carol,
+		Red:             alice,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game2)
 	game3, found3 := keeper.GetStoredGame(ctx, "3")
 	require.True(t, found3)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "3",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       alice,
-		Red:         bob,
-		MoveCount:   0,
-		BeforeIndex: "2",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       47,
-		Denom:       "gold",
+		Index:           "3",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           alice,
+		Red:             bob,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game3)
 }
 
@@ -289,66 +300,72 @@ func TestCreate3GamesGetAll(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
 		Creator: carol,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
-		Denom:   "gold",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
 	})
 	games := keeper.GetAllStoredGame(ctx)
 	require.Len(t, games, 3)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, games[0])
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   0,
-		BeforeIndex: "1",
-		AfterIndex:  "3",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       46,
-		Denom:       "coin",
+		Index:           "2",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           carol,
+		Red:             alice,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, games[1])
 	require.EqualValues(t, types.StoredGame{
-		Index:       "3",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       alice,
-		Red:         bob,
-		MoveCount:   0,
-		BeforeIndex: "2",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       47,
-		Denom:       "gold",
+		Index:           "3",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           alice,
+		Red:             bob,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, games[2])
 }
 
@@ -362,8 +379,7 @@ func TestCreateGameFarFuture(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, err)
 	require.EqualValues(t, types.MsgCreateGameResponse{
@@ -372,25 +388,30 @@ func TestCreateGameFarFuture(t *testing.T) {
 	systemInfo, found = keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        1025,
-		FifoHeadIndex: "1024",
-		FifoTailIndex: "1024",
+		NextId:                 1025,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(ctx, "1024")
 	require.True(t, found1)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1024",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1024",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       0,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultInvitationTimeout))),
+		Winner:          "*",
+		BlackPending:    true,
+		RedPending:      true,
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game1)
 }
 
@@ -401,8 +422,7 @@ func TestSavedCreatedDeadlineIsParseable(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	game, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -417,8 +437,7 @@ func TestCreatePlayerInfoNotAdded(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	aliceInfo, found := keeper.GetPlayerInfo(ctx, alice)
 	require.False(t, found)
@@ -462,8 +481,7 @@ func TestCreatePlayerInfoNotUpdated(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	aliceInfo, found := keeper.GetPlayerInfo(ctx, alice)
 	require.True(t, found)
@@ -498,8 +516,7 @@ func TestCreateLeaderboardNotAdded(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	leaderboard, found := keeper.GetLeaderboard(ctx)
 	require.True(t, found)
@@ -532,8 +549,7 @@ func TestCreateLeaderboardNotUpdated(t *testing.T) {
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	leaderboard, found := keeper.GetLeaderboard(ctx)
 	require.True(t, found)
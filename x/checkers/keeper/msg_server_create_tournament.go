@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateTournament(goCtx context.Context, msg *types.MsgCreateTournament) (*types.MsgCreateTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.Keeper.GetParams(ctx)
+	for _, coin := range msg.EntryFee {
+		if !params.IsDenomAllowed(coin.Denom) {
+			return nil, sdkerrors.Wrapf(types.ErrDenomNotAllowed, "%s", coin.Denom)
+		}
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextTournamentId, 10)
+	tournament := types.Tournament{
+		Index:          newIndex,
+		Creator:        msg.Creator,
+		Format:         msg.Format,
+		EntryFee:       msg.EntryFee,
+		MaxPlayers:     msg.MaxPlayers,
+		Rounds:         msg.Rounds,
+		Variant:        msg.Variant,
+		PrizeSharesBps: msg.PrizeSharesBps,
+		Status:         types.TournamentStatusRegistering,
+		CurrentRound:   0,
+	}
+	k.Keeper.SetTournament(ctx, tournament)
+	systemInfo.NextTournamentId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentCreatedEventType,
+			sdk.NewAttribute(types.TournamentCreatedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.TournamentCreatedEventTournamentIndex, newIndex),
+			sdk.NewAttribute(types.TournamentCreatedEventFormat, msg.Format),
+			sdk.NewAttribute(types.TournamentCreatedEventEntryFee, msg.EntryFee.String()),
+		),
+	)
+
+	return &types.MsgCreateTournamentResponse{
+		TournamentIndex: newIndex,
+	}, nil
+}
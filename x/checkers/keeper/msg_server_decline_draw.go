@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) DeclineDraw(goCtx context.Context, msg *types.MsgDeclineDraw) (*types.MsgDeclineDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, k.Keeper.GetGameNotFoundError(ctx, msg.GameIndex)
+	}
+
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+
+	if storedGame.IsPending() {
+		return nil, types.ErrGamePending
+	}
+
+	color, found := storedGame.GetPlayerColor(msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	if storedGame.DrawOfferer == "" {
+		return nil, types.ErrNoDrawOffered
+	}
+
+	if storedGame.DrawOfferer == color {
+		return nil, types.ErrCannotAnswerOwnDraw
+	}
+
+	storedGame.DrawOfferer = ""
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DrawDeclinedEventType,
+			sdk.NewAttribute(types.DrawDeclinedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.DrawDeclinedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgDeclineDrawResponse{}, nil
+}
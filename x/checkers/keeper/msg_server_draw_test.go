@@ -0,0 +1,312 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForDraw(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectSpendableAny(context)
+	createLiveGame(server, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	return server, *k, context, ctrl, bankMock
+}
+
+func playTwoMovesForDraw(t testing.TB, msgServer types.MsgServer, context context.Context) {
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+}
+
+func TestOfferDrawBeforeWagersCollected(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	offerDrawResponse, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerDrawResponse)
+	require.Equal(t, "game has not started, wagers are not collected yet", err.Error())
+}
+
+func TestOfferDrawWrongByCreator(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	offerDrawResponse, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerDrawResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestOfferDrawByRedSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	offerDrawResponse, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgOfferDrawResponse{}, *offerDrawResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", game.DrawOfferer)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "draw-offered",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+		},
+	}, events[2])
+}
+
+func TestOfferDrawTwice(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	offerDrawResponse, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerDrawResponse)
+	require.Equal(t, "r: a draw has already been offered", err.Error())
+}
+
+func TestAcceptDrawNotOffered(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	acceptDrawResponse, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptDrawResponse)
+	require.Equal(t, "no draw has been offered", err.Error())
+}
+
+func TestAcceptDrawOwnOffer(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	acceptDrawResponse, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptDrawResponse)
+	require.Equal(t, "player cannot answer their own draw offer", err.Error())
+}
+
+func TestAcceptDrawSettled(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
+	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
+	refundBob := escrow.ExpectRefund(context, bob, 45).Times(1).After(payCarol)
+	escrow.ExpectRefund(context, carol, 45).Times(1).After(refundBob)
+	playTwoMovesForDraw(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	acceptDrawResponse, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptDrawResponse{}, *acceptDrawResponse)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
+	}, systemInfo)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archivedGame, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "d", archivedGame.Winner)
+	require.Equal(t, types.ArchiveReasonDraw, archivedGame.Reason)
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{
+		Index:      bob,
+		DrawnCount: 1,
+	}, bobInfo)
+	carolInfo, found := keeper.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{
+		Index:      carol,
+		DrawnCount: 1,
+	}, carolInfo)
+	bobRating, found := keeper.GetPlayerRating(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerRating{
+		Index:           bob,
+		Rating:          1500,
+		RatingDeviation: 290,
+	}, bobRating)
+	carolRating, found := keeper.GetPlayerRating(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerRating{
+		Index:           carol,
+		Rating:          1500,
+		RatingDeviation: 290,
+	}, carolRating)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	event := events[5]
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-drawn",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}, event)
+}
+
+func TestAcceptDrawThenPlayMoveFinished(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     2,
+		FromY:     3,
+		ToX:       0,
+		ToY:       5,
+	})
+	require.Nil(t, playMoveResponse)
+	require.Equal(t, "game is already finished", err.Error())
+}
+
+func TestDeclineDrawCleared(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	declineDrawResponse, err := msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgDeclineDrawResponse{}, *declineDrawResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "", game.DrawOfferer)
+	require.Equal(t, "*", game.Winner)
+}
+
+func TestDeclineDrawOwnOffer(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	declineDrawResponse, err := msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, declineDrawResponse)
+	require.Equal(t, "player cannot answer their own draw offer", err.Error())
+}
+
+func TestPlayMoveDeclinesOpponentDrawOffer(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     2,
+		FromY:     3,
+		ToX:       0,
+		ToY:       5,
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "", game.DrawOfferer)
+}
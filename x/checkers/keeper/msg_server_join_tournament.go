@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) JoinTournament(goCtx context.Context, msg *types.MsgJoinTournament) (*types.MsgJoinTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tournament, found := k.Keeper.GetTournament(ctx, msg.TournamentIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotFound, "%s", msg.TournamentIndex)
+	}
+	if tournament.Status != types.TournamentStatusRegistering {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotOpen, "%s", tournament.Status)
+	}
+	if _, found := tournament.GetPlayerIndex(msg.Creator); found {
+		return nil, sdkerrors.Wrapf(types.ErrAlreadyInTournament, "%s", msg.Creator)
+	}
+	if tournament.MaxPlayers <= uint64(len(tournament.Players)) {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentFull, "%d players", len(tournament.Players))
+	}
+
+	player, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.CollectEntryFee(ctx, &tournament, player)
+	if err != nil {
+		return nil, err
+	}
+	tournament.Players = append(tournament.Players, types.TournamentPlayer{
+		Address: msg.Creator,
+	})
+	k.Keeper.SetTournament(ctx, tournament)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentJoinedEventType,
+			sdk.NewAttribute(types.TournamentJoinedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.TournamentJoinedEventTournamentIndex, msg.TournamentIndex),
+		),
+	)
+
+	return &types.MsgJoinTournamentResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) OfferDraw(goCtx context.Context, msg *types.MsgOfferDraw) (*types.MsgOfferDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, k.Keeper.GetGameNotFoundError(ctx, msg.GameIndex)
+	}
+
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+
+	if storedGame.IsPending() {
+		return nil, types.ErrGamePending
+	}
+
+	color, found := storedGame.GetPlayerColor(msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	if storedGame.MoveCount < 2 {
+		return nil, types.ErrGameNotStarted
+	}
+
+	if storedGame.DrawOfferer != "" {
+		return nil, sdkerrors.Wrapf(types.ErrDrawAlreadyOffered, "%s", storedGame.DrawOfferer)
+	}
+
+	storedGame.DrawOfferer = color
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DrawOfferedEventType,
+			sdk.NewAttribute(types.DrawOfferedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.DrawOfferedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgOfferDrawResponse{}, nil
+}
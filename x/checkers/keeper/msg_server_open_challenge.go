@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) OpenChallenge(goCtx context.Context, msg *types.MsgOpenChallenge) (*types.MsgOpenChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.Keeper.GetParams(ctx).ValidateWager(msg.Wager)
+	if err != nil {
+		return nil, err
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+	newIndex := strconv.FormatUint(systemInfo.NextChallengeId, 10)
+
+	challenge := types.Challenge{
+		Index:       newIndex,
+		Creator:     msg.Creator,
+		Wager:       msg.Wager,
+		Color:       msg.Color,
+		MinRating:   msg.MinRating,
+		MaxRating:   msg.MaxRating,
+		Variant:     msg.Variant,
+		Deadline:    types.FormatDeadline(types.GetNextChallengeDeadline(ctx)),
+		BeforeIndex: types.NoFifoIndex,
+		AfterIndex:  types.NoFifoIndex,
+	}
+
+	k.Keeper.SendChallengeToFifoTail(ctx, &challenge, &systemInfo)
+	k.Keeper.SetChallenge(ctx, challenge)
+	systemInfo.NextChallengeId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ChallengeOpenedEventType,
+			sdk.NewAttribute(types.ChallengeOpenedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.ChallengeOpenedEventChallengeIndex, newIndex),
+			sdk.NewAttribute(types.ChallengeOpenedEventWager, msg.Wager.String()),
+			sdk.NewAttribute(types.ChallengeOpenedEventColor, msg.Color),
+			sdk.NewAttribute(types.ChallengeOpenedEventVariant, msg.Variant),
+		),
+	)
+
+	return &types.MsgOpenChallengeResponse{
+		ChallengeIndex: newIndex,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenChallenge(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	openResponse, err := msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: alice,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Color:   "*",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgOpenChallengeResponse{
+		ChallengeIndex: "1",
+	}, *openResponse)
+}
+
+func TestOpenChallengeHasSaved(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator:   alice,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Color:     "r",
+		MinRating: 1000,
+		MaxRating: 1500,
+		Variant:   "pool",
+	})
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 1,
+		NextChallengeId:        2,
+		ChallengeFifoHeadIndex: "1",
+		ChallengeFifoTailIndex: "1",
+		NextTournamentId:       1,
+	}, systemInfo)
+	challenge1, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.Challenge{
+		Index:       "1",
+		Creator:     alice,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Color:       "r",
+		MinRating:   1000,
+		MaxRating:   1500,
+		Variant:     "pool",
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxChallengeDuration)),
+		BeforeIndex: "-1",
+		AfterIndex:  "-1",
+	}, challenge1)
+}
+
+func TestOpen2ChallengesHasSavedFifo(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: alice,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Color:   "*",
+	})
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: bob,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		Color:   "b",
+	})
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 1,
+		NextChallengeId:        3,
+		ChallengeFifoHeadIndex: "1",
+		ChallengeFifoTailIndex: "2",
+		NextTournamentId:       1,
+	}, systemInfo)
+	challenge1, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "-1", challenge1.BeforeIndex)
+	require.Equal(t, "2", challenge1.AfterIndex)
+	challenge2, found := keeper.GetChallenge(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "1", challenge2.BeforeIndex)
+	require.Equal(t, "-1", challenge2.AfterIndex)
+}
+
+func TestOpenChallengeEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	msgServer.OpenChallenge(context, &types.MsgOpenChallenge{
+		Creator: alice,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Color:   "*",
+		Variant: "russian",
+	})
+	ctx := sdk.UnwrapSDKContext(context)
+	require.NotNil(t, ctx)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	event := events[0]
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "challenge-opened",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: alice},
+			{Key: "challenge-index", Value: "1"},
+			{Key: "wager", Value: "45stake"},
+			{Key: "color", Value: "*"},
+			{Key: "variant", Value: "russian"},
+		},
+	}, event)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	rules "github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) PlaceBet(goCtx context.Context, msg *types.MsgPlaceBet) (*types.MsgPlaceBetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, k.Keeper.GetGameNotFoundError(ctx, msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	if storedGame.IsPending() {
+		return nil, types.ErrGamePending
+	}
+	if storedGame.Black == msg.Creator || storedGame.Red == msg.Creator {
+		return nil, types.ErrPlayerCannotBet
+	}
+	params := k.Keeper.GetParams(ctx)
+	if params.MaxBetMoveCount <= storedGame.MoveCount {
+		return nil, sdkerrors.Wrapf(types.ErrBettingClosed, "move count %d, bets taken until %d",
+			storedGame.MoveCount, params.MaxBetMoveCount)
+	}
+	for _, coin := range msg.Amount {
+		if !params.IsDenomAllowed(coin.Denom) {
+			return nil, sdkerrors.Wrapf(types.ErrDenomNotAllowed, "%s", coin.Denom)
+		}
+		if coin.Amount.LT(params.MinBet) {
+			return nil, sdkerrors.Wrapf(types.ErrBetTooSmall, "%s is below %s", coin, params.MinBet)
+		}
+	}
+	gameBets, found := k.Keeper.GetGameBets(ctx, msg.GameIndex)
+	if !found {
+		gameBets = types.GameBets{
+			GameIndex: msg.GameIndex,
+			BlackPool: sdk.NewCoins(),
+			RedPool:   sdk.NewCoins(),
+		}
+	}
+	// Keeps the payouts settled at the end of the game, in EndBlock on a forfeit, within bounds
+	if params.MaxBetsPerGame <= uint64(len(gameBets.Bets)) {
+		return nil, sdkerrors.Wrapf(types.ErrTooManyBets, "%d bets", len(gameBets.Bets))
+	}
+
+	bettor, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.CollectBet(ctx, bettor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	gameBets.AddBet(types.Bet{
+		Bettor: msg.Creator,
+		Color:  msg.Color,
+		Amount: msg.Amount,
+	})
+	k.Keeper.SetGameBets(ctx, gameBets)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.BetPlacedEventType,
+			sdk.NewAttribute(types.BetPlacedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.BetPlacedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.BetPlacedEventColor, msg.Color),
+			sdk.NewAttribute(types.BetPlacedEventAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgPlaceBetResponse{}, nil
+}
@@ -0,0 +1,353 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/sample"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForPlaceBet(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectSpendableAny(context)
+	createLiveGame(server, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	return server, *k, context, ctrl, bankMock
+}
+
+func placeTwoBets(t testing.TB, msgServer types.MsgServer, context context.Context,
+	escrow *testutil.MockBankEscrowKeeper, other string) {
+	escrow.ExpectBet(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Times(1)
+	_, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.Nil(t, err)
+	escrow.ExpectBet(context, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))).Times(1)
+	_, err = msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   other,
+		GameIndex: "1",
+		Color:     "r",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 30)),
+	})
+	require.Nil(t, err)
+}
+
+func TestPlaceBet(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectBet(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Times(1)
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlaceBetResponse{}, *placeBetResponse)
+	gameBets, found := keeper.GetGameBets(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, []types.Bet{
+		{Bettor: alice, Color: "b", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+	}, gameBets.Bets)
+	require.Equal(t, "10stake", gameBets.BlackPool.String())
+	require.True(t, gameBets.RedPool.Empty())
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Contains(t, events, sdk.StringEvent{
+		Type: "bet-placed",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: alice},
+			{Key: "game-index", Value: "1"},
+			{Key: "color", Value: "b"},
+			{Key: "amount", Value: "10stake"},
+		},
+	})
+}
+
+func TestPlaceBetsAddUp(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	placeTwoBets(t, msgServer, context, escrow, sample.AccAddress())
+	escrow.ExpectBet(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))).Times(1)
+	_, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+	})
+	require.Nil(t, err)
+	gameBets, found := keeper.GetGameBets(ctx, "1")
+	require.True(t, found)
+	require.Len(t, gameBets.Bets, 3)
+	require.Equal(t, "15stake", gameBets.BlackPool.String())
+	require.Equal(t, "30stake", gameBets.RedPool.String())
+}
+
+func TestPlaceBetGameNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlaceBet(t)
+	defer ctrl.Finish()
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "2",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.Nil(t, placeBetResponse)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
+
+func TestPlaceBetByPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlaceBet(t)
+	defer ctrl.Finish()
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   bob,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.Nil(t, placeBetResponse)
+	require.Equal(t, "a player cannot bet on their own game", err.Error())
+}
+
+func TestPlaceBetOnFinishedGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.Winner = "r"
+	keeper.SetStoredGame(ctx, storedGame)
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.Nil(t, placeBetResponse)
+	require.Equal(t, "game is already finished", err.Error())
+}
+
+func TestPlaceBetClosedAfterMaxMoveCount(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.MaxBetMoveCount = 2
+	keeper.SetParams(ctx, params)
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.MoveCount = 2
+	keeper.SetStoredGame(ctx, storedGame)
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.Nil(t, placeBetResponse)
+	require.Equal(t, "move count 2, bets taken until 2: betting is closed on this game", err.Error())
+}
+
+func TestPlaceBetBelowMinBet(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.MinBet = sdk.NewInt(10)
+	keeper.SetParams(ctx, params)
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("gold", 9)),
+	})
+	require.Nil(t, placeBetResponse)
+	require.EqualError(t, err, "9gold is below 10: bet is below the min bet")
+}
+
+func TestPlaceBetTooMany(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.MaxBetsPerGame = 2
+	keeper.SetParams(ctx, params)
+	escrow.ExpectBet(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+			Creator:   alice,
+			GameIndex: "1",
+			Color:     "b",
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		})
+		require.Nil(t, err)
+	}
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "r",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.Nil(t, placeBetResponse)
+	require.EqualError(t, err, "2 bets: game has taken as many bets as allowed")
+}
+
+func TestPlaceBetDenomNotAllowed(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.AllowedDenoms = []string{"stake"}
+	keeper.SetParams(ctx, params)
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("coin", 10)),
+	})
+	require.Nil(t, placeBetResponse)
+	require.EqualError(t, err, "coin: denom is not allowed: %s")
+}
+
+func TestPlaceBetCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectBet(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).
+		Return(errors.New("Oops"))
+	placeBetResponse, err := msgServer.PlaceBet(context, &types.MsgPlaceBet{
+		Creator:   alice,
+		GameIndex: "1",
+		Color:     "b",
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+	require.Nil(t, placeBetResponse)
+	require.Equal(t, "cannot pay the bet: Oops", err.Error())
+	_, found := keeper.GetGameBets(ctx, "1")
+	require.False(t, found)
+}
+
+func TestResignSettlesBets(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	other := sample.AccAddress()
+	placeTwoBets(t, msgServer, context, escrow, other)
+	escrow.ExpectBetPayout(context, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))).Times(1)
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	_, found := keeper.GetGameBets(ctx, "1")
+	require.False(t, found)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Contains(t, events, sdk.StringEvent{
+		Type: "bets-settled",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "r"},
+			{Key: "pool", Value: "40stake"},
+		},
+	})
+}
+
+func TestRejectRefundsBets(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	other := sample.AccAddress()
+	placeTwoBets(t, msgServer, context, escrow, other)
+	escrow.ExpectBetPayout(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Times(1)
+	escrow.ExpectBetPayout(context, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))).Times(1)
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	_, found := keeper.GetGameBets(ctx, "1")
+	require.False(t, found)
+}
+
+func TestAcceptDrawRefundsBets(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	other := sample.AccAddress()
+	placeTwoBets(t, msgServer, context, escrow, other)
+	escrow.ExpectBetPayout(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Times(1)
+	escrow.ExpectBetPayout(context, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))).Times(1)
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	_, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	_, err = msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	_, found := keeper.GetGameBets(ctx, "1")
+	require.False(t, found)
+}
+
+func TestForfeitUnplayedRefundsBets(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	other := sample.AccAddress()
+	placeTwoBets(t, msgServer, context, escrow, other)
+	escrow.ExpectBetPayout(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Times(1)
+	escrow.ExpectBetPayout(context, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 30))).Times(1)
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &storedGame)
+	keeper.SetStoredGame(ctx, storedGame)
+	keeper.ForfeitExpiredGames(context)
+	_, found := keeper.GetGameBets(ctx, "1")
+	require.False(t, found)
+}
+
+func TestForfeitPlayedTwicePaysBets(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlaceBet(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	other := sample.AccAddress()
+	placeTwoBets(t, msgServer, context, escrow, other)
+	escrow.ExpectBetPayout(context, other, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))).Times(1)
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &storedGame)
+	keeper.SetStoredGame(ctx, storedGame)
+	keeper.ForfeitExpiredGames(context)
+	_, found := keeper.GetGameBets(ctx, "1")
+	require.False(t, found)
+}
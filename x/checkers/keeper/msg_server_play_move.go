@@ -13,20 +13,62 @@ import (
 func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*types.MsgPlayMoveResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	game, captured, err := k.playMoves(ctx, msg.Creator, msg.GameIndex, []rules.Pos{
+		{
+			X: int(msg.FromX),
+			Y: int(msg.FromY),
+		},
+		{
+			X: int(msg.ToX),
+			Y: int(msg.ToY),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	capturedPos := rules.NO_POS
+	if len(captured) > 0 {
+		capturedPos = captured[0]
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.MovePlayedEventType,
+			sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.MovePlayedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(capturedPos.X), 10)),
+			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(capturedPos.Y), 10)),
+			sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
+			sdk.NewAttribute(types.MovePlayedEventBoard, game.String()),
+			sdk.NewAttribute(types.MovePlayedEventPositionHash, strconv.FormatUint(game.Hash(), 10)),
+		),
+	)
+
+	return &types.MsgPlayMoveResponse{
+		CapturedX: int32(capturedPos.X),
+		CapturedY: int32(capturedPos.Y),
+		Winner:    rules.PieceStrings[game.Winner()],
+	}, nil
+}
+
+func (k msgServer) playMoves(ctx sdk.Context, creator string, gameIndex string, positions []rules.Pos) (game *rules.Game, captured []rules.Pos, err error) {
+	storedGame, found := k.Keeper.GetStoredGame(ctx, gameIndex)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+		return nil, nil, k.Keeper.GetGameNotFoundError(ctx, gameIndex)
 	}
 
 	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
-		return nil, types.ErrGameFinished
+		return nil, nil, types.ErrGameFinished
 	}
 
-	isBlack := storedGame.Black == msg.Creator
-	isRed := storedGame.Red == msg.Creator
+	if storedGame.IsPending() {
+		return nil, nil, types.ErrGamePending
+	}
+
+	isBlack := storedGame.Black == creator
+	isRed := storedGame.Red == creator
 	var player rules.Player
 	if !isBlack && !isRed {
-		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+		return nil, nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
 	} else if isBlack && isRed {
 		player = rules.StringPieces[storedGame.Turn].Player
 	} else if isBlack {
@@ -35,74 +77,103 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 		player = rules.RED_PLAYER
 	}
 
-	game, err := storedGame.ParseGame()
+	game, err = storedGame.ParseGame()
 	if err != nil {
 		panic(err.Error())
 	}
 
 	if !game.TurnIs(player) {
-		return nil, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
+		return nil, nil, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
 	}
 
 	err = k.Keeper.CollectWager(ctx, &storedGame)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	captured, moveErr := game.Move(
-		rules.Pos{
-			X: int(msg.FromX),
-			Y: int(msg.FromY),
-		},
-		rules.Pos{
-			X: int(msg.ToX),
-			Y: int(msg.ToY),
-		},
-	)
+	captured, moveErr := game.MoveChain(positions)
 	if moveErr != nil {
-		return nil, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+		return nil, nil, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
 	}
 
-	storedGame.Winner = rules.PieceStrings[game.Winner()]
+	if storedGame.DrawOfferer != "" && storedGame.DrawOfferer != rules.PieceStrings[player] {
+		// Playing on declines the opponent's draw offer
+		storedGame.DrawOfferer = ""
+	}
 
-	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
-	if !found {
-		panic("SystemInfo not found")
+	storedGame.Winner = rules.PieceStrings[game.Winner()]
+	err = storedGame.SetNextTurn(ctx, rules.PieceStrings[game.Turn], k.Keeper.TurnDuration(ctx))
+	if err != nil {
+		panic(err.Error())
 	}
-	lastBoard := game.String()
+
+	payout, fee, refund := sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
 	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
-		k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
-		storedGame.Board = lastBoard
+		k.Keeper.InsertDeadlineQueue(ctx, &storedGame)
+		storedGame.PackedBoard = game.Pack()
+		storedGame.PositionHash = game.Hash()
+		storedGame.PositionHistory = game.History
+		storedGame.CapturingPiece = types.FormatCapturingPiece(game)
+		storedGame.DeadPieces = types.NewPositions(game.DeadPieces())
 	} else {
-		k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-		storedGame.Board = ""
-		k.Keeper.MustPayWinnings(ctx, &storedGame)
-		winnerInfo, _ := k.Keeper.MustRegisterPlayerWin(ctx, &storedGame)
-		k.Keeper.MustAddToLeaderboard(ctx, winnerInfo)
+		k.Keeper.RemoveFromDeadlineQueue(ctx, &storedGame)
+		k.Keeper.RemoveActiveGame(ctx, &storedGame)
+		if storedGame.Winner == rules.PieceStrings[rules.DRAW_PLAYER] {
+			refund = k.Keeper.MustRefundWager(ctx, &storedGame)
+			k.Keeper.MustRegisterPlayerDraw(ctx, &storedGame)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.GameDrawnEventType,
+					sdk.NewAttribute(types.GameDrawnEventCreator, creator),
+					sdk.NewAttribute(types.GameDrawnEventGameIndex, gameIndex),
+					sdk.NewAttribute(types.GameDrawnEventBoard, game.String()),
+				),
+			)
+		} else {
+			payout, fee = k.Keeper.MustPayWinnings(ctx, &storedGame)
+			winnerInfo, _ := k.Keeper.MustRegisterPlayerWin(ctx, &storedGame)
+			k.Keeper.MustAddToLeaderboard(ctx, winnerInfo)
+		}
+		k.Keeper.MustSettleBets(ctx, gameIndex, storedGame.Winner)
 	}
 
+	k.Keeper.SetGameMove(ctx, types.GameMove{
+		GameIndex:  gameIndex,
+		MoveNumber: storedGame.MoveCount,
+		Player:     rules.PieceStrings[player],
+		Positions:  types.NewPositions(positions),
+		Captured:   types.NewPositions(captured),
+		Board:      game.String(),
+	})
 	storedGame.MoveCount++
-	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
-	storedGame.Turn = rules.PieceStrings[game.Turn]
-	k.Keeper.SetStoredGame(ctx, storedGame)
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+		k.Keeper.SetStoredGame(ctx, storedGame)
+	} else if storedGame.Winner == rules.PieceStrings[rules.DRAW_PLAYER] {
+		k.Keeper.ArchiveGame(ctx, &storedGame, types.ArchiveReasonDraw, game.String())
+	} else {
+		k.Keeper.ArchiveGame(ctx, &storedGame, types.ArchiveReasonCheckmate, game.String())
+	}
 
-	ctx.GasMeter().ConsumeGas(types.PlayMoveGas, "Play a move")
+	err = ctx.EventManager().EmitTypedEvent(&types.EventMovePlayed{
+		Creator:      creator,
+		GameIndex:    gameIndex,
+		Positions:    types.NewPositions(positions),
+		Captured:     types.NewPositions(captured),
+		Winner:       storedGame.Winner,
+		Board:        game.String(),
+		Payout:       payout,
+		Fee:          fee,
+		Refund:       refund,
+		PositionHash: game.Hash(),
+		Black:        storedGame.Black,
+		Red:          storedGame.Red,
+		Turn:         storedGame.Turn,
+		Deadline:     storedGame.Deadline,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.MovePlayedEventType,
-			sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.MovePlayedEventGameIndex, msg.GameIndex),
-			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(captured.X), 10)),
-			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captured.Y), 10)),
-			sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
-			sdk.NewAttribute(types.MovePlayedEventBoard, lastBoard),
-		),
-	)
+	ctx.GasMeter().ConsumeGas(k.Keeper.PlayMoveGas(ctx), "Play a move")
 
-	return &types.MsgPlayMoveResponse{
-		CapturedX: int32(captured.X),
-		CapturedY: int32(captured.Y),
-		Winner:    rules.PieceStrings[game.Winner()],
-	}, nil
+	return game, captured, nil
 }
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	kingsOnlyBoard   = "*B******|********|********|********|********|********|********|******R*"
+	redBlockingBoard = "*****b**|********|********|********|********|**b*****|*b******|r*******"
+)
+
+func setupMsgServerWithStartedBoard(t testing.TB, board string) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectSpendableAny(context)
+	createLiveGame(server, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	storedGame.PackedBoard = testutil.PackBoard(board)
+	storedGame.MoveCount = 2
+	game, err := storedGame.ParseGame()
+	require.Nil(t, err)
+	storedGame.PositionHistory = []uint64{game.Hash()}
+	k.SetStoredGame(ctx, storedGame)
+	return server, *k, context, ctrl, bankMock
+}
+
+func shuffleKings(t testing.TB, msgServer types.MsgServer, context context.Context) {
+	for _, move := range []types.MsgPlayMove{
+		{Creator: bob, FromX: 1, FromY: 0, ToX: 2, ToY: 1},
+		{Creator: carol, FromX: 6, FromY: 7, ToX: 5, ToY: 6},
+		{Creator: bob, FromX: 2, FromY: 1, ToX: 1, ToY: 0},
+		{Creator: carol, FromX: 5, FromY: 6, ToX: 6, ToY: 7},
+	} {
+		move.GameIndex = "1"
+		_, err := msgServer.PlayMove(context, &move)
+		require.Nil(t, err)
+	}
+}
+
+func TestPlayMoveRepetitionOnceNotDrawn(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithStartedBoard(t, kingsOnlyBoard)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	shuffleKings(t, msgServer, context)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "*", game.Winner)
+	require.Equal(t, kingsOnlyBoard, storedBoard(t, game))
+	require.Len(t, game.PositionHistory, 5)
+}
+
+func TestPlayMoveThreefoldRepetitionDrawn(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithStartedBoard(t, kingsOnlyBoard)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45)
+	escrow.ExpectRefund(context, carol, 45)
+	shuffleKings(t, msgServer, context)
+	shuffleKings(t, msgServer, context)
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archivedGame, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.ArchivedGame{
+		Index:     "1",
+		Black:     bob,
+		Red:       carol,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Winner:    "d",
+		Reason:    "draw",
+		Board:     kingsOnlyBoard,
+		MoveCount: 10,
+		EndHeight: ctx.BlockHeight(),
+	}, archivedGame)
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.DrawnCount)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 6)
+	event := events[3]
+	require.Equal(t, "game-drawn", event.Type)
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "creator", Value: carol},
+		{Key: "game-index", Value: "1"},
+		{Key: "board", Value: kingsOnlyBoard},
+	}, event.Attributes)
+}
+
+func TestPlayMoveBlockedOpponentLoses(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithStartedBoard(t, redBlockingBoard)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 90)
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     5,
+		FromY:     0,
+		ToX:       4,
+		ToY:       1,
+	})
+	require.Nil(t, err)
+	require.Equal(t, "b", playMoveResponse.Winner)
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archivedGame, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "b", archivedGame.Winner)
+	require.Equal(t, types.ArchiveReasonCheckmate, archivedGame.Reason)
+}
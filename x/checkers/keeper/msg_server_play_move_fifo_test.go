@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/b9lab/checkers/x/checkers/testutil"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -13,12 +14,11 @@ func TestPlayMove2Games1MoveHasSavedFifo(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 
 	msgServer.PlayMove(context, &types.MsgPlayMove{
@@ -32,41 +32,46 @@ func TestPlayMove2Games1MoveHasSavedFifo(t *testing.T) {
 	systemInfo1, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "1",
+		NextId:                 3,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo1)
+	require.Equal(t, []string{"1", "2"}, queuedGames(keeper, ctx))
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(1),
-		BeforeIndex: "2",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       uint64(1),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHistory: []uint64{928273061913851149},
+		PositionHash:    928273061913851149,
 	}, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   uint64(0),
-		BeforeIndex: "-1",
-		AfterIndex:  "1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       46,
-		Denom:       "coin",
+		Index:           "2",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           carol,
+		Red:             alice,
+		MoveCount:       uint64(0),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		PositionHash:    14274319974383232533,
+		PositionHistory: []uint64{14274319974383232533},
 	}, game2)
 }
 
@@ -75,12 +80,11 @@ func TestPlayMove2Games2MovesHasSavedFifo(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: bob,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
@@ -102,40 +106,45 @@ func TestPlayMove2Games2MovesHasSavedFifo(t *testing.T) {
 	systemInfo1, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "2",
+		NextId:                 3,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo1)
+	require.Equal(t, []string{"1", "2"}, queuedGames(keeper, ctx))
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(1),
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       uint64(1),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHistory: []uint64{928273061913851149},
+		PositionHash:    928273061913851149,
 	}, game1)
 	game2, found := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   uint64(1),
-		BeforeIndex: "1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       46,
-		Denom:       "coin",
+		Index:           "2",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "r",
+		Black:           carol,
+		Red:             alice,
+		MoveCount:       uint64(1),
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+		PositionHistory: []uint64{928273061913851149},
+		PositionHash:    928273061913851149,
 	}, game2)
 }
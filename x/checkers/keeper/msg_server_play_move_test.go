@@ -7,6 +7,7 @@ import (
 	keepertest "github.com/b9lab/checkers/testutil/keeper"
 	"github.com/b9lab/checkers/x/checkers"
 	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/rules"
 	"github.com/b9lab/checkers/x/checkers/testutil"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,12 +23,12 @@ func setupMsgServerWithOneGameForPlayMove(t testing.TB) (types.MsgServer, keeper
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
 	server := keeper.NewMsgServerImpl(*k)
 	context := sdk.WrapSDKContext(ctx)
-	server.CreateGame(context, &types.MsgCreateGame{
+	bankMock.ExpectSpendableAny(context)
+	createLiveGame(server, context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	return server, *k, context, ctrl, bankMock
 }
@@ -71,12 +72,11 @@ func TestPlayMoveSameBlackRed(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
-	msgServer.CreateGame(context, &types.MsgCreateGame{
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     bob,
-		Wager:   46,
-		Denom:   "coin",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
 	})
 	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
@@ -110,25 +110,28 @@ func TestPlayMoveSavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   1,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       1,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHistory: []uint64{928273061913851149},
+		PositionHash:    928273061913851149,
 	}, game1)
 }
 
@@ -147,8 +150,8 @@ func TestPlayMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -158,6 +161,7 @@ func TestPlayMoveEmitted(t *testing.T) {
 			{Key: "captured-y", Value: "-1"},
 			{Key: "winner", Value: "*"},
 			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+			{Key: "position-hash", Value: "928273061913851149"},
 		},
 	}, event)
 }
@@ -214,12 +218,12 @@ func TestPlayMoveCannotParseGame(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	storedGame, _ := k.GetStoredGame(ctx, "1")
-	storedGame.Board = "not a board"
+	storedGame.PackedBoard = []byte("not a board")
 	k.SetStoredGame(ctx, storedGame)
 	defer func() {
 		r := recover()
 		require.NotNil(t, r, "The code did not panic")
-		require.Equal(t, r, "game cannot be parsed: invalid board string: not a board")
+		require.Equal(t, r, "game cannot be parsed: invalid packed board length: 11")
 	}()
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
@@ -314,25 +318,28 @@ func TestPlayMove2SavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   2,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       2,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHistory: []uint64{14062446519914266683},
+		PositionHash:    14062446519914266683,
 	}, game1)
 }
 
@@ -359,8 +366,8 @@ func TestPlayMove2Emitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[3]
 	require.Equal(t, "move-played", event.Type)
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: carol},
@@ -369,7 +376,8 @@ func TestPlayMove2Emitted(t *testing.T) {
 		{Key: "captured-y", Value: "-1"},
 		{Key: "winner", Value: "*"},
 		{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
-	}, event.Attributes[6:])
+		{Key: "position-hash", Value: "14062446519914266683"},
+	}, event.Attributes[7:])
 }
 
 func TestPlayMove2CalledBank(t *testing.T) {
@@ -463,25 +471,28 @@ func TestPlayMove3SavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   3,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
-		Denom:       "stake",
+		Index:           "1",
+		PackedBoard:     testutil.PackBoard("*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*"),
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       3,
+		BeforeIndex:     "-1",
+		AfterIndex:      "-1",
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		Winner:          "*",
+		Wager:           sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		PositionHistory: []uint64{12350534474189602416},
+		PositionHash:    12350534474189602416,
 	}, game1)
 }
 
@@ -649,3 +660,41 @@ func TestLeaderboardNoUpdatedOnNoWinner(t *testing.T) {
 		},
 	}, leaderboard.Winners)
 }
+
+func BenchmarkPlayMove(b *testing.B) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(b)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	// Start from the middle game, past the wager payments
+	game := rules.New()
+	for ply := 0; ply < 10; ply++ {
+		_, err := game.MoveChain(game.LegalMoves()[0].Positions)
+		require.Nil(b, err)
+	}
+	storedGame, found := keeper.GetStoredGame(ctx, "1")
+	require.True(b, found)
+	storedGame.PackedBoard = game.Pack()
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	storedGame.PositionHistory = game.History
+	storedGame.MoveCount = 10
+	next := game.LegalMoves()[0].Positions
+	msg := &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     uint64(next[0].X),
+		FromY:     uint64(next[0].Y),
+		ToX:       uint64(next[1].X),
+		ToY:       uint64(next[1].Y),
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		keeper.SetStoredGame(ctx, storedGame)
+		b.StartTimer()
+		_, err := msgServer.PlayMove(context, msg)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -20,30 +20,31 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 
-	game, found := keeper.GetStoredGame(ctx, "1")
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archivedGame, found := keeper.GetArchivedGame(ctx, "1")
 	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(len(testutil.Game1Moves)),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "b",
-		Wager:       45,
-		Denom:       "stake",
-	}, game)
+	require.EqualValues(t, types.ArchivedGame{
+		Index:     "1",
+		Black:     bob,
+		Red:       carol,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Winner:    "b",
+		Reason:    "checkmate",
+		Board:     "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********",
+		MoveCount: uint64(len(testutil.Game1Moves)),
+		EndHeight: ctx.BlockHeight(),
+	}, archivedGame)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 6)
+	event := events[3]
 	require.Equal(t, event.Type, "move-played")
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: bob},
@@ -52,7 +53,8 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 		{Key: "captured-y", Value: "5"},
 		{Key: "winner", Value: "b"},
 		{Key: "board", Value: "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********"},
-	}, event.Attributes[(len(testutil.Game1Moves)-1)*6:])
+		{Key: "position-hash", Value: "581197884260358751"},
+	}, event.Attributes[(len(testutil.Game1Moves)-1)*7:])
 }
 
 func TestPlayMoveUpToWinnerCalledBank(t *testing.T) {
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) PlayMoves(goCtx context.Context, msg *types.MsgPlayMoves) (*types.MsgPlayMovesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	positions := make([]rules.Pos, 0, len(msg.Positions))
+	for _, position := range msg.Positions {
+		positions = append(positions, rules.Pos{
+			X: int(position.X),
+			Y: int(position.Y),
+		})
+	}
+
+	game, captured, err := k.playMoves(ctx, msg.Creator, msg.GameIndex, positions)
+	if err != nil {
+		return nil, err
+	}
+	lastCaptured := rules.NO_POS
+	capturedPositions := make([]types.Position, 0, len(captured))
+	for _, capturedPos := range captured {
+		capturedPositions = append(capturedPositions, types.Position{
+			X: uint64(capturedPos.X),
+			Y: uint64(capturedPos.Y),
+		})
+		lastCaptured = capturedPos
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.MovePlayedEventType,
+			sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.MovePlayedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(lastCaptured.X), 10)),
+			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(lastCaptured.Y), 10)),
+			sdk.NewAttribute(types.MovePlayedEventCaptured, types.FormatPositions(capturedPositions)),
+			sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
+			sdk.NewAttribute(types.MovePlayedEventBoard, game.String()),
+			sdk.NewAttribute(types.MovePlayedEventPositionHash, strconv.FormatUint(game.Hash(), 10)),
+		),
+	)
+
+	return &types.MsgPlayMovesResponse{
+		Captured: capturedPositions,
+		Winner:   rules.PieceStrings[game.Winner()],
+	}, nil
+}
@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	doubleJumpBoard = "********|********|*b******|**r*****|********|****r***|********|r*******"
+)
+
+func setupMsgServerWithOneGameForPlayMoves(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectSpendableAny(context)
+	createLiveGame(server, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.PackedBoard = testutil.PackBoard(doubleJumpBoard)
+	storedGame.MoveCount = 2
+	k.SetStoredGame(ctx, storedGame)
+	return server, *k, context, ctrl, bankMock
+}
+
+func TestPlayMovesDoubleJump(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMoves(t)
+	defer ctrl.Finish()
+	playMovesResponse, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{
+			{X: 1, Y: 2},
+			{X: 3, Y: 4},
+			{X: 5, Y: 6},
+		},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMovesResponse{
+		Captured: []types.Position{
+			{X: 2, Y: 3},
+			{X: 4, Y: 5},
+		},
+		Winner: "*",
+	}, *playMovesResponse)
+}
+
+func TestPlayMovesDoubleJumpSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMoves(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{
+			{X: 1, Y: 2},
+			{X: 3, Y: 4},
+			{X: 5, Y: 6},
+		},
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "********|********|********|********|********|********|*****b**|r*******", storedBoard(t, game))
+	require.Equal(t, "r", game.Turn)
+	require.EqualValues(t, 3, game.MoveCount)
+}
+
+func TestPlayMovesDoubleJumpEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMoves(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{
+			{X: 1, Y: 2},
+			{X: 3, Y: 4},
+			{X: 5, Y: 6},
+		},
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 5)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "move-played",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "captured-x", Value: "4"},
+			{Key: "captured-y", Value: "5"},
+			{Key: "captured", Value: "2,3|4,5"},
+			{Key: "winner", Value: "*"},
+			{Key: "board", Value: "********|********|********|********|********|********|*****b**|r*******"},
+			{Key: "position-hash", Value: "12329342685587578541"},
+		},
+	}, events[3])
+}
+
+func TestPlayMovesStopHalfwayKeepsTurn(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMoves(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playMovesResponse, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{
+			{X: 1, Y: 2},
+			{X: 3, Y: 4},
+		},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, []types.Position{{X: 2, Y: 3}}, playMovesResponse.Captured)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "b", game.Turn)
+}
+
+func TestPlayMovesStopHalfwayHashesCapturingPiece(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMoves(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{
+			{X: 1, Y: 2},
+			{X: 3, Y: 4},
+		},
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	parsed, err := game.ParseGame()
+	require.Nil(t, err)
+	require.Equal(t, parsed.Hash(), game.PositionHash)
+	parsed.CapturingPiece = rules.NO_POS
+	require.NotEqual(t, parsed.Hash(), game.PositionHash)
+}
+
+func TestPlayMovesContinueWithoutCaptureIsAtomic(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMoves(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playMovesResponse, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Positions: []types.Position{
+			{X: 1, Y: 2},
+			{X: 3, Y: 4},
+			{X: 2, Y: 5},
+		},
+	})
+	require.Nil(t, playMovesResponse)
+	require.Equal(t, "Invalid move: {3 4} to {2 5}: wrong move", err.Error())
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, doubleJumpBoard, storedBoard(t, game))
+}
+
+func TestPlayMovesNotPlayerTurn(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMoves(t)
+	defer ctrl.Finish()
+	playMovesResponse, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   carol,
+		GameIndex: "1",
+		Positions: []types.Position{
+			{X: 0, Y: 7},
+			{X: 1, Y: 6},
+		},
+	})
+	require.Nil(t, playMovesResponse)
+	require.Equal(t, "{red}: player tried to play out of turn", err.Error())
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ProposeRematch(goCtx context.Context, msg *types.MsgProposeRematch) (*types.MsgProposeRematchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	archivedGame, color, err := k.getRematchableGame(ctx, msg.Creator, msg.GameIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if archivedGame.RematchProposer != "" {
+		return nil, sdkerrors.Wrapf(types.ErrRematchAlreadyProposed, "%s", archivedGame.RematchProposer)
+	}
+
+	archivedGame.RematchProposer = color
+	k.Keeper.SetArchivedGame(ctx, archivedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.RematchProposedEventType,
+			sdk.NewAttribute(types.RematchProposedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.RematchProposedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgProposeRematchResponse{}, nil
+}
+
+// getRematchableGame finds the finished game that the creator played and that has not been rematched yet.
+func (k msgServer) getRematchableGame(ctx sdk.Context, creator string, gameIndex string) (archivedGame types.ArchivedGame, color string, err error) {
+	archivedGame, found := k.Keeper.GetArchivedGame(ctx, gameIndex)
+	if !found {
+		if _, found = k.Keeper.GetStoredGame(ctx, gameIndex); found {
+			return archivedGame, "", types.ErrGameNotFinished
+		}
+		return archivedGame, "", sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+
+	if archivedGame.TournamentIndex != "" {
+		return archivedGame, "", sdkerrors.Wrapf(types.ErrTournamentGameRematch, "%s", archivedGame.TournamentIndex)
+	}
+
+	color, found = archivedGame.GetPlayerColor(creator)
+	if !found {
+		return archivedGame, "", sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
+	}
+
+	if archivedGame.RematchIndex != "" {
+		return archivedGame, "", sdkerrors.Wrapf(types.ErrRematchAlreadyAccepted, "%s", archivedGame.RematchIndex)
+	}
+
+	return archivedGame, color, nil
+}
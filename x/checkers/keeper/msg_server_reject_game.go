@@ -14,13 +14,17 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 
 	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+		return nil, k.Keeper.GetGameNotFoundError(ctx, msg.GameIndex)
 	}
 
 	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
 		return nil, types.ErrGameFinished
 	}
 
+	if storedGame.TournamentIndex != "" {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentGameRejected, "%s", storedGame.TournamentIndex)
+	}
+
 	if storedGame.Black == msg.Creator {
 		if 0 < storedGame.MoveCount {
 			return nil, types.ErrBlackAlreadyPlayed
@@ -33,17 +37,27 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
 	}
 
-	k.Keeper.MustRefundWager(ctx, &storedGame)
-
-	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
-	if !found {
-		panic("SystemInfo not found")
+	lastBoard, err := storedGame.FormatBoard()
+	if err != nil {
+		panic(err.Error())
+	}
+	refundedWager := sdk.NewCoins()
+	if storedGame.IsPending() {
+		// Declining the invitation, there are no wagers or bets yet
+		k.Keeper.DropPendingGame(ctx, &storedGame)
+	} else {
+		refundedWager = k.Keeper.MustRefundWager(ctx, &storedGame)
+		k.Keeper.MustSettleBets(ctx, msg.GameIndex, rules.PieceStrings[rules.NO_PLAYER])
+		k.Keeper.RemoveFromDeadlineQueue(ctx, &storedGame)
+		k.Keeper.RemoveActiveGame(ctx, &storedGame)
+		k.Keeper.ArchiveGame(ctx, &storedGame, types.ArchiveReasonReject, lastBoard)
 	}
-	k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-	k.Keeper.RemoveStoredGame(ctx, msg.GameIndex)
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
 
-	refund := uint64(types.RejectGameRefundGas)
+	refund := k.Keeper.RejectGameRefundGas(ctx)
+	if createGameGas := k.Keeper.CreateGameGas(ctx); createGameGas < refund {
+		// Governance changes each param on its own, never give back more than was charged
+		refund = createGameGas
+	}
 	if consumed := ctx.GasMeter().GasConsumed(); consumed < refund {
 		refund = consumed
 	}
@@ -55,6 +69,18 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 			sdk.NewAttribute(types.GameRejectedEventGameIndex, msg.GameIndex),
 		),
 	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGameRejected{
+		Creator:   msg.Creator,
+		GameIndex: msg.GameIndex,
+		Refund:    refundedWager,
+		Winner:    storedGame.Winner,
+		Board:     lastBoard,
+		Black:     storedGame.Black,
+		Red:       storedGame.Red,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.MsgRejectGameResponse{}, nil
 }
@@ -22,12 +22,12 @@ func setupMsgServerWithOneGameForRejectGame(t testing.TB) (types.MsgServer, keep
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
 	server := keeper.NewMsgServerImpl(*k)
 	context := sdk.WrapSDKContext(ctx)
-	server.CreateGame(context, &types.MsgCreateGame{
+	bankMock.ExpectSpendableAny(context)
+	createLiveGame(server, context, &types.MsgCreateGame{
 		Creator: alice,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
-		Denom:   "stake",
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	return server, *k, context, ctrl, bankMock
 }
@@ -65,9 +65,11 @@ func TestRejectGameByBlackNoMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -83,8 +85,8 @@ func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -107,6 +109,23 @@ func TestRejectGameByBlackRefundedGas(t *testing.T) {
 	require.LessOrEqual(t, after, before-5_000)
 }
 
+func TestRejectGameRefundsNoMoreThanCreateGameGas(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.RejectGameRefundGas = 10_000_000
+	require.Error(t, params.Validate())
+	keeper.SetParams(ctx, params)
+	before := ctx.GasMeter().GasConsumed()
+	msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	after := ctx.GasMeter().GasConsumed()
+	require.Less(t, before-after, params.CreateGameGas)
+}
+
 func TestRejectGameByRedNoMove(t *testing.T) {
 	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
 	defer ctrl.Finish()
@@ -129,9 +148,11 @@ func TestRejectGameByRedNoMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -147,8 +168,8 @@ func TestRejectGameByRedNoMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 5)
+	event := events[3]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -198,9 +219,11 @@ func TestRejectGameByRedOneMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -225,8 +248,8 @@ func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 7)
+	event := events[4]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
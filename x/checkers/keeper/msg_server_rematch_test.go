@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneResignedGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	return msgServer, keeper, context, ctrl, escrow
+}
+
+func TestProposeRematchGameNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	defer ctrl.Finish()
+	proposeResponse, err := msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, proposeResponse)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
+
+func TestProposeRematchGameNotFinished(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForResign(t)
+	defer ctrl.Finish()
+	proposeResponse, err := msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, proposeResponse)
+	require.Equal(t, "game is not finished yet", err.Error())
+}
+
+func TestProposeRematchWrongByCreator(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	defer ctrl.Finish()
+	proposeResponse, err := msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, proposeResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestProposeRematchTournamentGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	archivedGame, _ := keeper.GetArchivedGame(ctx, "1")
+	archivedGame.TournamentIndex = "3"
+	keeper.SetArchivedGame(ctx, archivedGame)
+	proposeResponse, err := msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, proposeResponse)
+	require.Equal(t, "3: a tournament game cannot be rematched", err.Error())
+}
+
+func TestProposeRematchByRedSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	proposeResponse, err := msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgProposeRematchResponse{}, *proposeResponse)
+	archivedGame, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", archivedGame.RematchProposer)
+	require.Equal(t, "", archivedGame.RematchIndex)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Contains(t, events, sdk.StringEvent{
+		Type: "rematch-proposed",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+		},
+	})
+}
+
+func TestProposeRematchTwice(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	defer ctrl.Finish()
+	msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	proposeResponse, err := msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, proposeResponse)
+	require.Equal(t, "r: a rematch has already been proposed by: %s", err.Error())
+}
+
+func TestAcceptRematchNotProposed(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	defer ctrl.Finish()
+	acceptResponse, err := msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, "no rematch has been proposed", err.Error())
+}
+
+func TestAcceptRematchOwnProposal(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	defer ctrl.Finish()
+	msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	acceptResponse, err := msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, "player cannot accept their own rematch proposal", err.Error())
+}
+
+func TestAcceptRematchCreatesSwappedGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	acceptResponse, err := msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptRematchResponse{
+		GameIndex: "2",
+	}, *acceptResponse)
+	game2, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, carol, game2.Black)
+	require.Equal(t, bob, game2.Red)
+	require.Equal(t, "45stake", game2.Wager.String())
+	require.Equal(t, "1", game2.PreviousIndex)
+	require.EqualValues(t, 0, game2.MoveCount)
+	archivedGame, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "2", archivedGame.RematchIndex)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Contains(t, events, sdk.StringEvent{
+		Type: "rematch-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "new-game-index", Value: "2"},
+		},
+	})
+}
+
+func TestAcceptRematchTwice(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	defer ctrl.Finish()
+	msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	acceptResponse, err := msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, "2: the rematch has already been accepted as game: %s", err.Error())
+}
+
+func TestRematchArchivedWithPreviousIndex(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneResignedGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.ProposeRematch(context, &types.MsgProposeRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, err)
+	archivedGame, found := keeper.GetArchivedGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "1", archivedGame.PreviousIndex)
+}
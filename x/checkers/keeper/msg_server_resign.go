@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) Resign(goCtx context.Context, msg *types.MsgResign) (*types.MsgResignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, k.Keeper.GetGameNotFoundError(ctx, msg.GameIndex)
+	}
+
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+
+	if storedGame.IsPending() {
+		return nil, types.ErrGamePending
+	}
+
+	color, found := storedGame.GetPlayerColor(msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	if storedGame.MoveCount < 2 {
+		return nil, types.ErrGameNotStarted
+	}
+
+	lastBoard, err := storedGame.FormatBoard()
+	if err != nil {
+		panic(err.Error())
+	}
+	k.Keeper.RemoveFromDeadlineQueue(ctx, &storedGame)
+	k.Keeper.RemoveActiveGame(ctx, &storedGame)
+	storedGame.Winner = rules.PieceStrings[rules.Opponents[rules.StringPieces[color].Player]]
+	payout, fee := k.Keeper.MustPayWinnings(ctx, &storedGame)
+	k.Keeper.MustSettleBets(ctx, msg.GameIndex, storedGame.Winner)
+	winnerInfo, _ := k.Keeper.MustRegisterPlayerResign(ctx, &storedGame)
+	k.Keeper.MustAddToLeaderboard(ctx, winnerInfo)
+	k.Keeper.ArchiveGame(ctx, &storedGame, types.ArchiveReasonResign, lastBoard)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameResignedEventType,
+			sdk.NewAttribute(types.GameResignedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameResignedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameResignedEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.GameResignedEventBoard, lastBoard),
+		),
+	)
+	err = ctx.EventManager().EmitTypedEvent(&types.EventGameResigned{
+		Creator:   msg.Creator,
+		GameIndex: msg.GameIndex,
+		Winner:    storedGame.Winner,
+		Board:     lastBoard,
+		Payout:    payout,
+		Fee:       fee,
+		Black:     storedGame.Black,
+		Red:       storedGame.Red,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResignResponse{
+		Winner: storedGame.Winner,
+	}, nil
+}
@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForResign(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectSpendableAny(context)
+	createLiveGame(server, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	return server, *k, context, ctrl, bankMock
+}
+
+func playTwoMovesForResign(t testing.TB, msgServer types.MsgServer, context context.Context) {
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+}
+
+func TestResignGameNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForResign(t)
+	defer ctrl.Finish()
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, resignResponse)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
+
+func TestResignWrongByCreator(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, resignResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestResignBeforeWagersCollected(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, resignResponse)
+	require.Equal(t, "game has not started, wagers are not collected yet", err.Error())
+}
+
+func TestResignByBlack(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgResignResponse{
+		Winner: "r",
+	}, *resignResponse)
+}
+
+func TestResignByBlackCalledBank(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	defer ctrl.Finish()
+	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
+	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
+	escrow.ExpectRefund(context, carol, 90).Times(1).After(payCarol)
+	playTwoMovesForResign(t, msgServer, context)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+}
+
+func TestResignByBlackSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
+	}, systemInfo)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archivedGame, found := keeper.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", archivedGame.Winner)
+	require.Equal(t, types.ArchiveReasonResign, archivedGame.Reason)
+	require.EqualValues(t, 2, archivedGame.MoveCount)
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{
+		Index:         bob,
+		ResignedCount: 1,
+	}, bobInfo)
+	carolInfo, found := keeper.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{
+		Index:    carol,
+		WonCount: 1,
+	}, carolInfo)
+	leaderboard, found := keeper.GetLeaderboard(ctx)
+	require.True(t, found)
+	require.Len(t, leaderboard.Winners, 1)
+	require.Equal(t, carol, leaderboard.Winners[0].PlayerAddress)
+	bobRating, found := keeper.GetPlayerRating(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerRating{
+		Index:           bob,
+		Rating:          1338,
+		RatingDeviation: 290,
+	}, bobRating)
+	carolRating, found := keeper.GetPlayerRating(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerRating{
+		Index:           carol,
+		Rating:          1662,
+		RatingDeviation: 290,
+	}, carolRating)
+	ratingLeaderboard, found := keeper.GetRatingLeaderboard(ctx)
+	require.True(t, found)
+	require.Len(t, ratingLeaderboard.Players, 2)
+	require.Equal(t, carol, ratingLeaderboard.Players[0].PlayerAddress)
+	require.EqualValues(t, 1662, ratingLeaderboard.Players[0].Rating)
+	require.Equal(t, bob, ratingLeaderboard.Players[1].PlayerAddress)
+	require.EqualValues(t, 1338, ratingLeaderboard.Players[1].Rating)
+}
+
+func TestResignByRedEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 8)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-resigned",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "b"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}, events[4])
+}
+
+func TestResignTwiceFinished(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, resignResponse)
+	require.Equal(t, "game is already finished", err.Error())
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) StartTournament(goCtx context.Context, msg *types.MsgStartTournament) (*types.MsgStartTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tournament, found := k.Keeper.GetTournament(ctx, msg.TournamentIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotFound, "%s", msg.TournamentIndex)
+	}
+	if tournament.Creator != msg.Creator {
+		return nil, sdkerrors.Wrapf(types.ErrNotTournamentCreator, "%s", msg.Creator)
+	}
+	if tournament.Status != types.TournamentStatusRegistering {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotOpen, "%s", tournament.Status)
+	}
+	if len(tournament.Players) < 2 {
+		return nil, sdkerrors.Wrapf(types.ErrNotEnoughPlayers, "%d players", len(tournament.Players))
+	}
+	// Otherwise pairing would have players meet again
+	if tournament.IsSwiss() && uint64(len(tournament.Players)) <= tournament.Rounds {
+		return nil, sdkerrors.Wrapf(types.ErrNotEnoughPlayersInSwiss, "%d players for %d rounds",
+			len(tournament.Players), tournament.Rounds)
+	}
+
+	tournament.Status = types.TournamentStatusRunning
+	gameIndices := k.Keeper.StartNextTournamentRound(ctx, &tournament)
+	k.Keeper.SetTournament(ctx, tournament)
+
+	return &types.MsgStartTournamentResponse{
+		GameIndices: gameIndices,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateGameMoveTimeSaved(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator:     alice,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		TimeControl: types.TimeControl{MoveTime: 30},
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.TimeControl{MoveTime: 30}, game1.TimeControl)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(30*time.Second)), game1.Deadline)
+}
+
+func TestCreateGameClockSaved(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator:     alice,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		TimeControl: types.TimeControl{TotalTime: 300, Increment: 2},
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, 300*time.Second, game1.BlackTimeLeft)
+	require.EqualValues(t, 300*time.Second, game1.RedTimeLeft)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(300*time.Second)), game1.Deadline)
+}
+
+func TestCreateGameMoveTimeOutOfBounds(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator:     alice,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		TimeControl: types.TimeControl{MoveTime: 1},
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "move time 1 is not within 10 and 604800: time control is outside of the allowed bounds: %s")
+}
+
+func TestCreateFasterGameGoesFirstInFifo(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   carol,
+		Red:     alice,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("coin", 46)),
+	})
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator:     carol,
+		Black:       alice,
+		Red:         bob,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("gold", 47)),
+		TimeControl: types.TimeControl{MoveTime: 60},
+	})
+	require.Equal(t, []string{"3", "1", "2"}, queuedGames(keeper, ctx))
+}
+
+func TestPlayMoveStopsClock(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator:     alice,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		TimeControl: types.TimeControl{TotalTime: 300, Increment: 2},
+	})
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(100 * time.Second))
+	laterContext := sdk.WrapSDKContext(later)
+	escrow.ExpectAny(laterContext)
+	_, err := msgServer.PlayMove(laterContext, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "2",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	game2, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.EqualValues(t, 202*time.Second, game2.BlackTimeLeft)
+	require.EqualValues(t, 300*time.Second, game2.RedTimeLeft)
+	require.Equal(t, types.FormatDeadline(later.BlockTime().Add(300*time.Second)), game2.Deadline)
+	require.Equal(t, []string{"2", "1"}, queuedGames(keeper, ctx))
+}
+
+func TestForfeitFasterGameBeforeOlder(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator:     alice,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		TimeControl: types.TimeControl{MoveTime: 60},
+	})
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(61 * time.Second))
+	keeper.ForfeitExpiredGames(sdk.WrapSDKContext(later))
+
+	_, found := keeper.GetStoredGame(ctx, "2")
+	require.False(t, found)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, []string{"1"}, queuedGames(keeper, ctx))
+}
@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/sample"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneTournament(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectSpendableAny(context)
+	server.CreateTournament(context, &types.MsgCreateTournament{
+		Creator:    alice,
+		Format:     types.TournamentFormatSwiss,
+		EntryFee:   sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		MaxPlayers: 3,
+		Rounds:     2,
+	})
+	return server, *k, context, ctrl, bankMock
+}
+
+func setupMsgServerWithOneFullTournament(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneTournament(t)
+	for _, player := range []string{alice, bob, carol} {
+		escrow.ExpectPay(context, player, 10)
+		msgServer.JoinTournament(context, &types.MsgJoinTournament{
+			Creator:         player,
+			TournamentIndex: "1",
+		})
+	}
+	return msgServer, keeper, context, ctrl, escrow
+}
+
+func TestCreateTournament(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	createResponse, err := msgServer.CreateTournament(context, &types.MsgCreateTournament{
+		Creator:        bob,
+		Format:         types.TournamentFormatElimination,
+		MaxPlayers:     8,
+		PrizeSharesBps: []uint64{7_000, 3_000},
+		Variant:        "pool",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgCreateTournamentResponse{
+		TournamentIndex: "2",
+	}, *createResponse)
+	tournament, found := keeper.GetTournament(ctx, "2")
+	require.True(t, found)
+	require.EqualValues(t, types.Tournament{
+		Index:          "2",
+		Creator:        bob,
+		Format:         types.TournamentFormatElimination,
+		MaxPlayers:     8,
+		Variant:        "pool",
+		PrizeSharesBps: []uint64{7_000, 3_000},
+		Status:         types.TournamentStatusRegistering,
+	}, tournament)
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, 3, systemInfo.NextTournamentId)
+	require.EqualValues(t, 1, systemInfo.NextId)
+	require.Empty(t, keeper.GetAllRunningTournamentIndex(ctx))
+}
+
+func TestCreateTournamentEmitted(t *testing.T) {
+	_, _, context, ctrl, _ := setupMsgServerWithOneTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "tournament-created",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: alice},
+			{Key: "tournament-index", Value: "1"},
+			{Key: "format", Value: "swiss"},
+			{Key: "entry-fee", Value: "10stake"},
+		},
+	}, events[0])
+}
+
+func TestCreateTournamentDenomNotAllowed(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.AllowedDenoms = []string{"stake"}
+	keeper.SetParams(ctx, params)
+	createResponse, err := msgServer.CreateTournament(context, &types.MsgCreateTournament{
+		Creator:    bob,
+		Format:     types.TournamentFormatElimination,
+		EntryFee:   sdk.NewCoins(sdk.NewInt64Coin("gold", 10)),
+		MaxPlayers: 8,
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "gold: denom is not allowed: %s")
+	_, found := keeper.GetTournament(ctx, "2")
+	require.False(t, found)
+}
+
+func TestJoinTournament(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 10).Times(1)
+	joinResponse, err := msgServer.JoinTournament(context, &types.MsgJoinTournament{
+		Creator:         bob,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgJoinTournamentResponse{}, *joinResponse)
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, []types.TournamentPlayer{{Address: bob}}, tournament.Players)
+	require.Equal(t, "10stake", tournament.PrizePool.String())
+}
+
+func TestJoinTournamentNotFound(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneTournament(t)
+	defer ctrl.Finish()
+	joinResponse, err := msgServer.JoinTournament(context, &types.MsgJoinTournament{
+		Creator:         bob,
+		TournamentIndex: "2",
+	})
+	require.Nil(t, joinResponse)
+	require.EqualError(t, err, "2: tournament by id not found")
+}
+
+func TestJoinTournamentTwice(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneTournament(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 10).Times(1)
+	msgServer.JoinTournament(context, &types.MsgJoinTournament{
+		Creator:         bob,
+		TournamentIndex: "1",
+	})
+	joinResponse, err := msgServer.JoinTournament(context, &types.MsgJoinTournament{
+		Creator:         bob,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, joinResponse)
+	require.EqualError(t, err, bob+": player is already registered in the tournament")
+}
+
+func TestJoinTournamentFull(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneFullTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	joinResponse, err := msgServer.JoinTournament(context, &types.MsgJoinTournament{
+		Creator:         sample.AccAddress(),
+		TournamentIndex: "1",
+	})
+	require.Nil(t, joinResponse)
+	require.EqualError(t, err, "3 players: tournament is full")
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.Len(t, tournament.Players, 3)
+	require.Equal(t, "30stake", tournament.PrizePool.String())
+}
+
+func TestJoinTournamentCannotPay(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 10).Return(errors.New("oops"))
+	joinResponse, err := msgServer.JoinTournament(context, &types.MsgJoinTournament{
+		Creator:         bob,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, joinResponse)
+	require.EqualError(t, err, "cannot pay the tournament entry fee: oops")
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.Empty(t, tournament.Players)
+	require.True(t, tournament.PrizePool.Empty())
+}
+
+func TestStartTournament(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneFullTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	startResponse, err := msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgStartTournamentResponse{
+		GameIndices: []string{"1"},
+	}, *startResponse)
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.TournamentStatusRunning, tournament.Status)
+	require.EqualValues(t, 1, tournament.CurrentRound)
+	require.EqualValues(t, []string{"1"}, tournament.RoundGameIndices)
+	require.EqualValues(t, []types.TournamentPlayer{
+		{Address: alice, Opponents: []string{bob}},
+		{Address: bob, Opponents: []string{alice}},
+		{Address: carol, Score: 2, HadBye: true},
+	}, tournament.Players)
+	require.EqualValues(t, []string{"1"}, keeper.GetAllRunningTournamentIndex(ctx))
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, alice, game1.Black)
+	require.Equal(t, bob, game1.Red)
+	require.Equal(t, "1", game1.TournamentIndex)
+	require.True(t, game1.Wager.Empty())
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 2,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       2,
+	}, systemInfo)
+}
+
+func TestStartTournamentEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneFullTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "tournament-round-started",
+		Attributes: []sdk.Attribute{
+			{Key: "tournament-index", Value: "1"},
+			{Key: "round", Value: "1"},
+			{Key: "game-indices", Value: "1"},
+		},
+	}, events[len(events)-1])
+}
+
+func TestStartTournamentNotCreator(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneFullTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	startResponse, err := msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         bob,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, startResponse)
+	require.EqualError(t, err, bob+": only the tournament creator can start it")
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.TournamentStatusRegistering, tournament.Status)
+}
+
+func TestStartTournamentNotEnoughPlayers(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneTournament(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 10).Times(1)
+	msgServer.JoinTournament(context, &types.MsgJoinTournament{
+		Creator:         bob,
+		TournamentIndex: "1",
+	})
+	startResponse, err := msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, startResponse)
+	require.EqualError(t, err, "1 players: tournament needs at least 2 players to start")
+}
+
+func TestStartSwissTournamentNotMorePlayersThanRounds(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	for _, player := range []string{alice, bob} {
+		escrow.ExpectPay(context, player, 10).Times(1)
+		msgServer.JoinTournament(context, &types.MsgJoinTournament{
+			Creator:         player,
+			TournamentIndex: "1",
+		})
+	}
+	startResponse, err := msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, startResponse)
+	require.EqualError(t, err, "2 players for 2 rounds: swiss tournament needs more players than rounds to start")
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.TournamentStatusRegistering, tournament.Status)
+	require.EqualValues(t, 0, tournament.CurrentRound)
+	_, found = keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+}
+
+func TestStartTournamentTwice(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneFullTournament(t)
+	defer ctrl.Finish()
+	msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+	startResponse, err := msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, startResponse)
+	require.EqualError(t, err, "running: tournament is not open for registration")
+	joinResponse, err := msgServer.JoinTournament(context, &types.MsgJoinTournament{
+		Creator:         sample.AccAddress(),
+		TournamentIndex: "1",
+	})
+	require.Nil(t, joinResponse)
+	require.EqualError(t, err, "running: tournament is not open for registration")
+}
+
+func TestRejectTournamentGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneFullTournament(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.StartTournament(context, &types.MsgStartTournament{
+		Creator:         alice,
+		TournamentIndex: "1",
+	})
+	rejectResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, rejectResponse)
+	require.EqualError(t, err, "1: a tournament game cannot be rejected")
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+}
@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithVariantBoard(t testing.TB, variant rules.Variant, pieces map[rules.Pos]rules.Piece) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectSpendableAny(context)
+	_, err := createLiveGame(server, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Variant: variant.Name(),
+	})
+	require.Nil(t, err)
+	game := rules.NewWithVariant(variant)
+	require.Nil(t, game.SetPieces(pieces))
+	storedGame, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	storedGame.PackedBoard = game.Pack()
+	storedGame.MoveCount = 2
+	k.SetStoredGame(ctx, storedGame)
+	return server, *k, context, ctrl, bankMock
+}
+
+func TestCreateInternationalGameSaved(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	createResponse, err := createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Variant: "international",
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, createResponse.GameIndex)
+	require.True(t, found)
+	require.Equal(t, "international", game.Variant)
+	require.Equal(t, "*b*b*b*b*b|b*b*b*b*b*|*b*b*b*b*b|b*b*b*b*b*|**********|"+
+		"**********|*r*r*r*r*r|r*r*r*r*r*|*r*r*r*r*r|r*r*r*r*r*", storedBoard(t, game))
+}
+
+func TestCreateUnknownVariantGameRejected(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	createResponse, err := createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Variant: "chess",
+	})
+	require.Nil(t, createResponse)
+	require.EqualError(t, err, "chess: unknown rule variant: %s")
+}
+
+var manBehindOpponentPieces = map[rules.Pos]rules.Piece{
+	{X: 2, Y: 3}: {Player: rules.RED_PLAYER, King: false},
+	{X: 3, Y: 4}: {Player: rules.BLACK_PLAYER, King: false},
+	{X: 7, Y: 6}: {Player: rules.RED_PLAYER, King: false},
+}
+
+func TestPlayMoveAmericanManCannotCaptureBackward(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithVariantBoard(t, rules.AMERICAN_VARIANT, manBehindOpponentPieces)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       1,
+		ToY:       2,
+	})
+	require.Nil(t, playMoveResponse)
+	require.EqualError(t, err, "Invalid move: {3 4} to {1 2}: wrong move")
+}
+
+func TestPlayMoveRussianManCapturesBackward(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithVariantBoard(t, rules.RUSSIAN_VARIANT, manBehindOpponentPieces)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     3,
+		FromY:     4,
+		ToX:       1,
+		ToY:       2,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveResponse{
+		CapturedX: 2,
+		CapturedY: 3,
+		Winner:    "*",
+	}, *playMoveResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "russian", game.Variant)
+	require.Equal(t, "r", game.Turn)
+	require.Equal(t, "********|********|*b******|********|********|********|*******r|********", storedBoard(t, game))
+}
+
+var kingFarFromOpponentPieces = map[rules.Pos]rules.Piece{
+	{X: 0, Y: 1}: {Player: rules.BLACK_PLAYER, King: true},
+	{X: 4, Y: 5}: {Player: rules.RED_PLAYER, King: false},
+	{X: 1, Y: 6}: {Player: rules.RED_PLAYER, King: false},
+}
+
+func TestPlayMoveAmericanKingCannotFly(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithVariantBoard(t, rules.AMERICAN_VARIANT, kingFarFromOpponentPieces)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     1,
+		ToX:       6,
+		ToY:       7,
+	})
+	require.Nil(t, playMoveResponse)
+	require.EqualError(t, err, "Invalid move: {0 1} to {6 7}: wrong move")
+}
+
+func TestPlayMovePoolFlyingKingCaptures(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithVariantBoard(t, rules.POOL_VARIANT, kingFarFromOpponentPieces)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     1,
+		ToX:       6,
+		ToY:       7,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveResponse{
+		CapturedX: 4,
+		CapturedY: 5,
+		Winner:    "*",
+	}, *playMoveResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "********|********|********|********|********|********|*r******|******B*", storedBoard(t, game))
+}
+
+var singleAndDoubleCapturePieces = map[rules.Pos]rules.Piece{
+	{X: 2, Y: 3}: {Player: rules.BLACK_PLAYER, King: false},
+	{X: 3, Y: 4}: {Player: rules.RED_PLAYER, King: false},
+	{X: 5, Y: 6}: {Player: rules.RED_PLAYER, King: false},
+	{X: 7, Y: 2}: {Player: rules.BLACK_PLAYER, King: false},
+	{X: 8, Y: 3}: {Player: rules.RED_PLAYER, King: false},
+}
+
+func TestPlayMoveInternationalMustTakeMaximumCapture(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithVariantBoard(t, rules.INTERNATIONAL_VARIANT, singleAndDoubleCapturePieces)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     7,
+		FromY:     2,
+		ToX:       9,
+		ToY:       4,
+	})
+	require.Nil(t, playMoveResponse)
+	require.EqualError(t, err, "Invalid move: {7 2} to {9 4}: wrong move")
+}
+
+func TestPlayMoveInternationalCapturingPieceSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithVariantBoard(t, rules.INTERNATIONAL_VARIANT, singleAndDoubleCapturePieces)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     2,
+		FromY:     3,
+		ToX:       4,
+		ToY:       5,
+	})
+	require.Nil(t, err)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "b", game.Turn)
+	require.Equal(t, "4,5", game.CapturingPiece)
+	require.Equal(t, []types.Position{{X: 3, Y: 4}}, game.DeadPieces)
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     7,
+		FromY:     2,
+		ToX:       9,
+		ToY:       4,
+	})
+	require.EqualError(t, err, "Invalid move: {7 2} to {9 4}: wrong move")
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     4,
+		FromY:     5,
+		ToX:       6,
+		ToY:       7,
+	})
+	require.Nil(t, err)
+	game, found = keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", game.Turn)
+	require.Equal(t, "", game.CapturingPiece)
+	require.Empty(t, game.DeadPieces)
+}
@@ -1,16 +1,175 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.MinMoveTime(ctx),
+		k.MaxMoveTime(ctx),
+		k.MinTotalTime(ctx),
+		k.MaxTotalTime(ctx),
+		k.MaxIncrement(ctx),
+		k.CreateGameGas(ctx),
+		k.PlayMoveGas(ctx),
+		k.RejectGameRefundGas(ctx),
+		k.MaxTurnDuration(ctx),
+		k.LeaderboardWinnerLength(ctx),
+		k.MinWager(ctx),
+		k.MaxWager(ctx),
+		k.AllowedDenoms(ctx),
+		k.MaxGamesPerPlayer(ctx),
+		k.FeeBps(ctx),
+		k.FeeCollector(ctx),
+		k.MaxBetMoveCount(ctx),
+		k.ArchiveRetentionBlocks(ctx),
+		k.InvitationTimeout(ctx),
+		k.MinBet(ctx),
+		k.MaxBetsPerGame(ctx),
+	)
+}
+
+// TurnDuration returns the time given for each move, read from only the params it depends on
+func (k Keeper) TurnDuration(ctx sdk.Context) time.Duration {
+	return types.Params{
+		MinMoveTime:     k.MinMoveTime(ctx),
+		MaxMoveTime:     k.MaxMoveTime(ctx),
+		MaxTurnDuration: k.MaxTurnDuration(ctx),
+	}.TurnDuration()
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// MinMoveTime returns the MinMoveTime param
+func (k Keeper) MinMoveTime(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMinMoveTime, &res)
+	return
+}
+
+// MaxMoveTime returns the MaxMoveTime param
+func (k Keeper) MaxMoveTime(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxMoveTime, &res)
+	return
+}
+
+// MinTotalTime returns the MinTotalTime param
+func (k Keeper) MinTotalTime(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMinTotalTime, &res)
+	return
+}
+
+// MaxTotalTime returns the MaxTotalTime param
+func (k Keeper) MaxTotalTime(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxTotalTime, &res)
+	return
+}
+
+// MaxIncrement returns the MaxIncrement param
+func (k Keeper) MaxIncrement(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxIncrement, &res)
+	return
+}
+
+// CreateGameGas returns the CreateGameGas param
+func (k Keeper) CreateGameGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyCreateGameGas, &res)
+	return
+}
+
+// PlayMoveGas returns the PlayMoveGas param
+func (k Keeper) PlayMoveGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPlayMoveGas, &res)
+	return
+}
+
+// RejectGameRefundGas returns the RejectGameRefundGas param
+func (k Keeper) RejectGameRefundGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRejectGameRefundGas, &res)
+	return
+}
+
+// MaxTurnDuration returns the MaxTurnDuration param
+func (k Keeper) MaxTurnDuration(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxTurnDuration, &res)
+	return
+}
+
+// LeaderboardWinnerLength returns the LeaderboardWinnerLength param
+func (k Keeper) LeaderboardWinnerLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyLeaderboardWinnerLength, &res)
+	return
+}
+
+// MinWager returns the MinWager param
+func (k Keeper) MinWager(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMinWager, &res)
+	return
+}
+
+// MaxWager returns the MaxWager param
+func (k Keeper) MaxWager(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMaxWager, &res)
+	return
+}
+
+// AllowedDenoms returns the AllowedDenoms param
+func (k Keeper) AllowedDenoms(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAllowedDenoms, &res)
+	return
+}
+
+// MaxGamesPerPlayer returns the MaxGamesPerPlayer param
+func (k Keeper) MaxGamesPerPlayer(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxGamesPerPlayer, &res)
+	return
+}
+
+// FeeBps returns the FeeBps param
+func (k Keeper) FeeBps(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyFeeBps, &res)
+	return
+}
+
+// FeeCollector returns the FeeCollector param
+func (k Keeper) FeeCollector(ctx sdk.Context) (res string) {
+	k.paramstore.Get(ctx, types.KeyFeeCollector, &res)
+	return
+}
+
+// MaxBetMoveCount returns the MaxBetMoveCount param
+func (k Keeper) MaxBetMoveCount(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxBetMoveCount, &res)
+	return
+}
+
+// ArchiveRetentionBlocks returns the ArchiveRetentionBlocks param
+func (k Keeper) ArchiveRetentionBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyArchiveRetentionBlocks, &res)
+	return
+}
+
+// InvitationTimeout returns the InvitationTimeout param
+func (k Keeper) InvitationTimeout(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyInvitationTimeout, &res)
+	return
+}
+
+// MinBet returns the MinBet param
+func (k Keeper) MinBet(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMinBet, &res)
+	return
+}
+
+// MaxBetsPerGame returns the MaxBetsPerGame param
+func (k Keeper) MaxBetsPerGame(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxBetsPerGame, &res)
+	return
+}
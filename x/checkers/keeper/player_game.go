@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPlayerGameStatus returns whether the game of the player is pending, active or finished, if it is indexed
+func (k Keeper) GetPlayerGameStatus(ctx sdk.Context, player string, gameIndex string) (status string, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerGameKeyPrefix))
+	b := store.Get(types.PlayerGameKey(player, gameIndex))
+	if b == nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+func (k Keeper) setPlayerGames(ctx sdk.Context, black string, red string, gameIndex string, status string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerGameKeyPrefix))
+	for _, player := range getDistinctPlayers(black, red) {
+		store.Set(types.PlayerGameKey(player, gameIndex), []byte(status))
+	}
+}
+
+// SetPlayerGames indexes the stored game under both of its players, with its current status.
+func (k Keeper) SetPlayerGames(ctx sdk.Context, storedGame *types.StoredGame) {
+	status := types.PlayerGameStatusFinished
+	if storedGame.IsPending() {
+		status = types.PlayerGameStatusPending
+	} else if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+		status = types.PlayerGameStatusActive
+	}
+	k.setPlayerGames(ctx, storedGame.Black, storedGame.Red, storedGame.Index, status)
+}
+
+// SetArchivedPlayerGames indexes the archived game under both of its players, as finished.
+func (k Keeper) SetArchivedPlayerGames(ctx sdk.Context, archivedGame *types.ArchivedGame) {
+	k.setPlayerGames(ctx, archivedGame.Black, archivedGame.Red, archivedGame.Index, types.PlayerGameStatusFinished)
+}
+
+func (k Keeper) removePlayerGames(ctx sdk.Context, black string, red string, gameIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerGameKeyPrefix))
+	for _, player := range getDistinctPlayers(black, red) {
+		store.Delete(types.PlayerGameKey(player, gameIndex))
+	}
+}
+
+// RemovePlayerGames forgets the archived game under both of its players, for when it is pruned.
+func (k Keeper) RemovePlayerGames(ctx sdk.Context, archivedGame *types.ArchivedGame) {
+	k.removePlayerGames(ctx, archivedGame.Black, archivedGame.Red, archivedGame.Index)
+}
+
+// RemovePendingPlayerGames forgets the pending game under both of its players, for when it is dropped.
+func (k Keeper) RemovePendingPlayerGames(ctx sdk.Context, storedGame *types.StoredGame) {
+	k.removePlayerGames(ctx, storedGame.Black, storedGame.Red, storedGame.Index)
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func requirePlayerGameStatus(t *testing.T, expected string, status string, found bool) {
+	if expected == "" {
+		require.False(t, found)
+		return
+	}
+	require.True(t, found)
+	require.Equal(t, expected, status)
+}
+
+func TestCreateGameIndexesPlayerGames(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	status, found := keeper.GetPlayerGameStatus(ctx, alice, "1")
+	requirePlayerGameStatus(t, "", status, found)
+	status, found = keeper.GetPlayerGameStatus(ctx, bob, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusPending, status, found)
+	status, found = keeper.GetPlayerGameStatus(ctx, carol, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusPending, status, found)
+}
+
+func TestAcceptGameActivatesPlayerGames(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	createLiveGame(msgServer, context, &types.MsgCreateGame{
+		Creator: alice,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	status, found := keeper.GetPlayerGameStatus(ctx, bob, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusActive, status, found)
+	status, found = keeper.GetPlayerGameStatus(ctx, carol, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusActive, status, found)
+}
+
+func TestRejectGameFinishesPlayerGames(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	status, found := keeper.GetPlayerGameStatus(ctx, bob, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+	status, found = keeper.GetPlayerGameStatus(ctx, carol, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+}
+
+func TestResignFinishesPlayerGames(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	status, found := keeper.GetPlayerGameStatus(ctx, bob, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+	status, found = keeper.GetPlayerGameStatus(ctx, carol, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+}
+
+func TestForfeitUnplayedFinishesPlayerGames(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &storedGame)
+	keeper.SetStoredGame(ctx, storedGame)
+	keeper.ForfeitExpiredGames(context)
+	status, found := keeper.GetPlayerGameStatus(ctx, bob, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+}
+
+func TestForfeitPlayedTwiceFinishesPlayerGames(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &storedGame)
+	keeper.SetStoredGame(ctx, storedGame)
+	keeper.ForfeitExpiredGames(context)
+	status, found := keeper.GetPlayerGameStatus(ctx, carol, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+}
+
+func TestInitGenesisIndexesPlayerGames(t *testing.T) {
+	k, ctx := keepertest.CheckersKeeper(t)
+	genesis := types.DefaultGenesis()
+	genesis.StoredGameList = []types.StoredGame{
+		{Index: "1", Black: bob, Red: carol, Winner: "*", Deadline: types.FormatDeadline(ctx.BlockTime())},
+		{Index: "2", Black: bob, Red: alice, Winner: "b"},
+	}
+	genesis.ArchivedGameList = []types.ArchivedGame{
+		{Index: "3", Black: carol, Red: bob, Winner: "r", Reason: types.ArchiveReasonResign},
+	}
+	checkers.InitGenesis(ctx, *k, *genesis)
+	status, found := k.GetPlayerGameStatus(ctx, bob, "1")
+	requirePlayerGameStatus(t, types.PlayerGameStatusActive, status, found)
+	status, found = k.GetPlayerGameStatus(ctx, bob, "2")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+	status, found = k.GetPlayerGameStatus(ctx, alice, "2")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+	status, found = k.GetPlayerGameStatus(ctx, alice, "1")
+	requirePlayerGameStatus(t, "", status, found)
+	status, found = k.GetPlayerGameStatus(ctx, bob, "3")
+	requirePlayerGameStatus(t, types.PlayerGameStatusFinished, status, found)
+}
@@ -15,6 +15,8 @@ func mustAddDeltaGameResultToPlayer(
 	wonDelta uint64,
 	lostDelta uint64,
 	forfeitedDelta uint64,
+	drawnDelta uint64,
+	resignedDelta uint64,
 ) (playerInfo types.PlayerInfo) {
 	playerInfo, found := k.GetPlayerInfo(ctx, player.String())
 	if !found {
@@ -23,25 +25,37 @@ func mustAddDeltaGameResultToPlayer(
 			WonCount:       0,
 			LostCount:      0,
 			ForfeitedCount: 0,
+			DrawnCount:     0,
+			ResignedCount:  0,
 		}
 	}
 	playerInfo.WonCount += wonDelta
 	playerInfo.LostCount += lostDelta
 	playerInfo.ForfeitedCount += forfeitedDelta
+	playerInfo.DrawnCount += drawnDelta
+	playerInfo.ResignedCount += resignedDelta
 	k.SetPlayerInfo(ctx, playerInfo)
 	return playerInfo
 }
 
 func (k *Keeper) MustAddWonGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 1, 0, 0)
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 1, 0, 0, 0, 0)
 }
 
 func (k *Keeper) MustAddLostGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 1, 0)
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 1, 0, 0, 0)
 }
 
 func (k *Keeper) MustAddForfeitedGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
-	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 1)
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 1, 0, 0)
+}
+
+func (k *Keeper) MustAddDrawnGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 0, 1, 0)
+}
+
+func (k *Keeper) MustAddResignedGameResultToPlayer(ctx sdk.Context, player sdk.AccAddress) types.PlayerInfo {
+	return mustAddDeltaGameResultToPlayer(k, ctx, player, 0, 0, 0, 0, 1)
 }
 
 func getWinnerAndLoserAddresses(storedGame *types.StoredGame) (winnerAddress sdk.AccAddress, loserAddress sdk.AccAddress) {
@@ -70,12 +84,35 @@ func getWinnerAndLoserAddresses(storedGame *types.StoredGame) (winnerAddress sdk
 
 func (k *Keeper) MustRegisterPlayerWin(ctx sdk.Context, storedGame *types.StoredGame) (winnerInfo types.PlayerInfo, loserInfo types.PlayerInfo) {
 	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
+	k.mustUpdatePlayerRatings(ctx, winnerAddress, loserAddress, types.WinScore)
 	return k.MustAddWonGameResultToPlayer(ctx, winnerAddress),
 		k.MustAddLostGameResultToPlayer(ctx, loserAddress)
 }
 
 func (k *Keeper) MustRegisterPlayerForfeit(ctx sdk.Context, storedGame *types.StoredGame) (winnerInfo types.PlayerInfo, forfeiterInfo types.PlayerInfo) {
 	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
+	k.mustUpdatePlayerRatings(ctx, winnerAddress, loserAddress, types.WinScore)
 	return k.MustAddWonGameResultToPlayer(ctx, winnerAddress),
 		k.MustAddForfeitedGameResultToPlayer(ctx, loserAddress)
 }
+
+func (k *Keeper) MustRegisterPlayerResign(ctx sdk.Context, storedGame *types.StoredGame) (winnerInfo types.PlayerInfo, resignerInfo types.PlayerInfo) {
+	winnerAddress, loserAddress := getWinnerAndLoserAddresses(storedGame)
+	k.mustUpdatePlayerRatings(ctx, winnerAddress, loserAddress, types.WinScore)
+	return k.MustAddWonGameResultToPlayer(ctx, winnerAddress),
+		k.MustAddResignedGameResultToPlayer(ctx, loserAddress)
+}
+
+func (k *Keeper) MustRegisterPlayerDraw(ctx sdk.Context, storedGame *types.StoredGame) (blackInfo types.PlayerInfo, redInfo types.PlayerInfo) {
+	blackAddress, err := storedGame.GetBlackAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	redAddress, err := storedGame.GetRedAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	k.mustUpdatePlayerRatings(ctx, blackAddress, redAddress, types.DrawScore)
+	return k.MustAddDrawnGameResultToPlayer(ctx, blackAddress),
+		k.MustAddDrawnGameResultToPlayer(ctx, redAddress)
+}
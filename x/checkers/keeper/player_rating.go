@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetPlayerRating set a specific playerRating in the store from its index
+func (k Keeper) SetPlayerRating(ctx sdk.Context, playerRating types.PlayerRating) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerRatingKeyPrefix))
+	b := k.cdc.MustMarshal(&playerRating)
+	store.Set(types.PlayerRatingKey(
+		playerRating.Index,
+	), b)
+}
+
+// GetPlayerRating returns a playerRating from its index
+func (k Keeper) GetPlayerRating(
+	ctx sdk.Context,
+	index string,
+
+) (val types.PlayerRating, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerRatingKeyPrefix))
+
+	b := store.Get(types.PlayerRatingKey(
+		index,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePlayerRating removes a playerRating from the store
+func (k Keeper) RemovePlayerRating(
+	ctx sdk.Context,
+	index string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerRatingKeyPrefix))
+	store.Delete(types.PlayerRatingKey(
+		index,
+	))
+}
+
+// GetAllPlayerRating returns all playerRating
+func (k Keeper) GetAllPlayerRating(ctx sdk.Context) (list []types.PlayerRating) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerRatingKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PlayerRating
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetPlayerRatingOrDefault returns the stored rating, or the starting rating of a player who has not finished a game yet.
+func (k Keeper) GetPlayerRatingOrDefault(ctx sdk.Context, index string) types.PlayerRating {
+	playerRating, found := k.GetPlayerRating(ctx, index)
+	if !found {
+		return types.NewDefaultPlayerRating(index)
+	}
+	return playerRating
+}
+
+// mustUpdatePlayerRatings rates a finished game, where score is that of the player against the opponent. A game
+// against oneself is not rated.
+func (k *Keeper) mustUpdatePlayerRatings(ctx sdk.Context, player sdk.AccAddress, opponent sdk.AccAddress, score sdk.Dec) {
+	if player.Equals(opponent) {
+		return
+	}
+	playerRating := k.GetPlayerRatingOrDefault(ctx, player.String())
+	opponentRating := k.GetPlayerRatingOrDefault(ctx, opponent.String())
+	playerRating, opponentRating =
+		types.UpdateRating(playerRating, opponentRating, score),
+		types.UpdateRating(opponentRating, playerRating, sdk.OneDec().Sub(score))
+	k.SetPlayerRating(ctx, playerRating)
+	k.SetPlayerRating(ctx, opponentRating)
+	k.MustAddToRatingLeaderboard(ctx, playerRating)
+	k.MustAddToRatingLeaderboard(ctx, opponentRating)
+}
+
+func (k *Keeper) MustAddToRatingLeaderboard(ctx sdk.Context, playerRating types.PlayerRating) types.RatingLeaderboard {
+	// A chain that started before ratings existed has no rating leaderboard yet.
+	leaderboard, _ := k.GetRatingLeaderboard(ctx)
+	err := leaderboard.UpdatePlayerRatingAtNow(types.GetDateAdded(ctx), playerRating, k.LeaderboardWinnerLength(ctx))
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotAddToRatingBoard.Error(), err.Error()))
+	}
+	k.SetRatingLeaderboard(ctx, leaderboard)
+	return leaderboard
+}
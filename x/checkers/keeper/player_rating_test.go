@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNPlayerRating(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.PlayerRating {
+	items := make([]types.PlayerRating, n)
+	for i := range items {
+		items[i].Index = strconv.Itoa(i)
+
+		keeper.SetPlayerRating(ctx, items[i])
+	}
+	return items
+}
+
+func TestPlayerRatingGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNPlayerRating(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetPlayerRating(ctx,
+			item.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestPlayerRatingRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNPlayerRating(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemovePlayerRating(ctx,
+			item.Index,
+		)
+		_, found := keeper.GetPlayerRating(ctx,
+			item.Index,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestPlayerRatingGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNPlayerRating(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllPlayerRating(ctx)),
+	)
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetRatingLeaderboard set ratingLeaderboard in the store
+func (k Keeper) SetRatingLeaderboard(ctx sdk.Context, ratingLeaderboard types.RatingLeaderboard) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RatingLeaderboardKey))
+	b := k.cdc.MustMarshal(&ratingLeaderboard)
+	store.Set([]byte{0}, b)
+}
+
+// GetRatingLeaderboard returns ratingLeaderboard
+func (k Keeper) GetRatingLeaderboard(ctx sdk.Context) (val types.RatingLeaderboard, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RatingLeaderboardKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRatingLeaderboard removes ratingLeaderboard from the store
+func (k Keeper) RemoveRatingLeaderboard(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RatingLeaderboardKey))
+	store.Delete([]byte{0})
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+)
+
+func createTestRatingLeaderboard(keeper *keeper.Keeper, ctx sdk.Context) types.RatingLeaderboard {
+	item := types.RatingLeaderboard{}
+	keeper.SetRatingLeaderboard(ctx, item)
+	return item
+}
+
+func TestRatingLeaderboardGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	item := createTestRatingLeaderboard(keeper, ctx)
+	rst, found := keeper.GetRatingLeaderboard(ctx)
+	require.True(t, found)
+	require.Equal(t,
+		nullify.Fill(&item),
+		nullify.Fill(&rst),
+	)
+}
+
+func TestRatingLeaderboardRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	createTestRatingLeaderboard(keeper, ctx)
+	keeper.RemoveRatingLeaderboard(ctx)
+	_, found := keeper.GetRatingLeaderboard(ctx)
+	require.False(t, found)
+}
@@ -4,10 +4,12 @@ import (
 	"github.com/b9lab/checkers/x/checkers/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
-// SetStoredGame set a specific storedGame in the store from its index
+// SetStoredGame set a specific storedGame in the store from its index. Only the packed board is stored.
 func (k Keeper) SetStoredGame(ctx sdk.Context, storedGame types.StoredGame) {
+	storedGame.Board = ""
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameKeyPrefix))
 	b := k.cdc.MustMarshal(&storedGame)
 	store.Set(types.StoredGameKey(
@@ -61,3 +63,17 @@ func (k Keeper) GetAllStoredGame(ctx sdk.Context) (list []types.StoredGame) {
 
 	return
 }
+
+// GetStoredGamePage returns a page of storedGame as stored, without deriving their board
+func (k Keeper) GetStoredGamePage(ctx sdk.Context, pagination *query.PageRequest) (list []types.StoredGame, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameKeyPrefix))
+	pageRes, err = query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var storedGame types.StoredGame
+		if err := k.cdc.Unmarshal(value, &storedGame); err != nil {
+			return err
+		}
+		list = append(list, storedGame)
+		return nil
+	})
+	return list, pageRes, err
+}
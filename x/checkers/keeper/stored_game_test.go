@@ -7,6 +7,7 @@ import (
 	keepertest "github.com/b9lab/checkers/testutil/keeper"
 	"github.com/b9lab/checkers/testutil/nullify"
 	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/rules"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,7 @@ func createNStoredGame(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.St
 	items := make([]types.StoredGame, n)
 	for i := range items {
 		items[i].Index = strconv.Itoa(i)
+		items[i].PackedBoard = rules.New().Pack()
 
 		keeper.SetStoredGame(ctx, items[i])
 	}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/testutil/nullify"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Prevent strconv unused error
+var _ = strconv.IntSize
+
+func createNTournament(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Tournament {
+	items := make([]types.Tournament, n)
+	for i := range items {
+		items[i].Index = strconv.Itoa(i)
+
+		keeper.SetTournament(ctx, items[i])
+	}
+	return items
+}
+
+func TestTournamentGet(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNTournament(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetTournament(ctx,
+			item.Index,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+func TestTournamentRemove(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNTournament(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveTournament(ctx,
+			item.Index,
+		)
+		_, found := keeper.GetTournament(ctx,
+			item.Index,
+		)
+		require.False(t, found)
+	}
+}
+
+func TestTournamentGetAll(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNTournament(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllTournament(ctx)),
+	)
+}
+
+func TestTournamentRunningIndex(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	items := createNTournament(keeper, ctx, 3)
+	require.Empty(t, keeper.GetAllRunningTournamentIndex(ctx))
+
+	items[0].Status = types.TournamentStatusRunning
+	keeper.SetTournament(ctx, items[0])
+	items[2].Status = types.TournamentStatusRunning
+	keeper.SetTournament(ctx, items[2])
+	require.EqualValues(t, []string{"0", "2"}, keeper.GetAllRunningTournamentIndex(ctx))
+
+	items[0].Status = types.TournamentStatusFinished
+	keeper.SetTournament(ctx, items[0])
+	require.EqualValues(t, []string{"2"}, keeper.GetAllRunningTournamentIndex(ctx))
+
+	keeper.RemoveTournament(ctx, items[2].Index)
+	require.Empty(t, keeper.GetAllRunningTournamentIndex(ctx))
+}
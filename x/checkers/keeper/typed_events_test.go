@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// getTypedEvents parses back the typed events of the same type as the sample, in the order they were emitted.
+func getTypedEvents(t *testing.T, ctx sdk.Context, sample proto.Message) (found []string) {
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(sample) {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		found = append(found, parsed.String())
+	}
+	return found
+}
+
+func TestCreateGameTypedEvent(t *testing.T) {
+	_, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	require.Equal(t, []string{
+		(&types.EventGameCreated{
+			Creator:   alice,
+			GameIndex: "1",
+			Black:     bob,
+			Red:       carol,
+			Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		}).String(),
+	}, getTypedEvents(t, ctx, &types.EventGameCreated{}))
+}
+
+func TestPlayMoveTypedEvent(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Equal(t, []string{
+		(&types.EventMovePlayed{
+			Creator:      bob,
+			GameIndex:    "1",
+			Positions:    []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+			Winner:       "*",
+			Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+			PositionHash: 928273061913851149,
+			Black:        bob,
+			Red:          carol,
+			Turn:         "r",
+			Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+		}).String(),
+	}, getTypedEvents(t, ctx, &types.EventMovePlayed{}))
+}
+
+func TestPlayMoveUpToWinnerTypedEvent(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
+	events := getTypedEvents(t, ctx, &types.EventMovePlayed{})
+	require.Len(t, events, len(testutil.Game1Moves))
+	require.Equal(t, (&types.EventMovePlayed{
+		Creator:      bob,
+		GameIndex:    "1",
+		Positions:    []types.Position{{X: 1, Y: 6}, {X: 3, Y: 4}},
+		Captured:     []types.Position{{X: 2, Y: 5}},
+		Winner:       "b",
+		Board:        "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********",
+		Payout:       sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+		PositionHash: 581197884260358751,
+		Black:        bob,
+		Red:          carol,
+		Turn:         "r",
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.SecondsToDuration(types.DefaultMaxTurnDuration))),
+	}).String(), events[len(events)-1])
+}
+
+func TestForfeitPlayedTwiceTypedEvent(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game1)
+	keeper.SetStoredGame(ctx, game1)
+	keeper.ForfeitExpiredGames(context)
+	require.Equal(t, []string{
+		(&types.EventGameForfeited{
+			GameIndex: "1",
+			Winner:    "r",
+			Board:     storedBoard(t, game1),
+			Payout:    sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+			Black:     bob,
+			Red:       carol,
+		}).String(),
+	}, getTypedEvents(t, ctx, &types.EventGameForfeited{}))
+}
+
+func TestForfeitUnplayedTypedEvent(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.InsertDeadlineQueue(ctx, &game1)
+	keeper.SetStoredGame(ctx, game1)
+	keeper.ForfeitExpiredGames(context)
+	require.Equal(t, []string{
+		(&types.EventGameForfeited{
+			GameIndex: "1",
+			Winner:    "*",
+			Board:     storedBoard(t, game1),
+			Black:     bob,
+			Red:       carol,
+		}).String(),
+	}, getTypedEvents(t, ctx, &types.EventGameForfeited{}))
+}
+
+func TestRejectGameByRedOneMoveTypedEvent(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45)
+	escrow.ExpectRefund(context, bob, 45)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Equal(t, []string{
+		(&types.EventGameRejected{
+			Creator:   carol,
+			GameIndex: "1",
+			Refund:    sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+			Winner:    "*",
+			Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+			Black:     bob,
+			Red:       carol,
+		}).String(),
+	}, getTypedEvents(t, ctx, &types.EventGameRejected{}))
+}
+
+func TestResignTypedEvent(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForResign(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForResign(t, msgServer, context)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Equal(t, []string{
+		(&types.EventGameResigned{
+			Creator:   carol,
+			GameIndex: "1",
+			Winner:    "b",
+			Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+			Payout:    sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+			Fee:       sdk.NewCoins(),
+			Black:     bob,
+			Red:       carol,
+		}).String(),
+	}, getTypedEvents(t, ctx, &types.EventGameResigned{}))
+}
+
+func TestAcceptDrawTypedEvent(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	playTwoMovesForDraw(t, msgServer, context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Equal(t, []string{
+		(&types.EventGameDrawn{
+			Creator:   bob,
+			GameIndex: "1",
+			Winner:    "d",
+			Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+			Refund:    sdk.NewCoins(sdk.NewInt64Coin("stake", 90)),
+			Black:     bob,
+			Red:       carol,
+		}).String(),
+	}, getTypedEvents(t, ctx, &types.EventGameDrawn{}))
+}
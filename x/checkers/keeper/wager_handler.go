@@ -6,6 +6,7 @@ import (
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) error {
@@ -15,7 +16,7 @@ func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) err
 		if err != nil {
 			panic(err.Error())
 		}
-		err = k.bank.SendCoinsFromAccountToModule(ctx, black, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
+		err = k.bank.SendCoinsFromAccountToModule(ctx, black, types.ModuleName, storedGame.Wager)
 		if err != nil {
 			return sdkerrors.Wrapf(err, types.ErrBlackCannotPay.Error())
 		}
@@ -25,7 +26,7 @@ func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) err
 		if err != nil {
 			panic(err.Error())
 		}
-		err = k.bank.SendCoinsFromAccountToModule(ctx, red, types.ModuleName, sdk.NewCoins(storedGame.GetWagerCoin()))
+		err = k.bank.SendCoinsFromAccountToModule(ctx, red, types.ModuleName, storedGame.Wager)
 		if err != nil {
 			return sdkerrors.Wrapf(err, types.ErrRedCannotPay.Error())
 		}
@@ -33,7 +34,39 @@ func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) err
 	return nil
 }
 
-func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame) {
+// ValidatePlayersCanPay checks ahead of the game that both players have enough to pay the wager when their first
+// move comes. A player on both sides has to be able to pay twice.
+func (k *Keeper) ValidatePlayersCanPay(ctx sdk.Context, storedGame *types.StoredGame) error {
+	if storedGame.Wager.Empty() {
+		return nil
+	}
+	black, err := storedGame.GetBlackAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	red, err := storedGame.GetRedAddress()
+	if err != nil {
+		panic(err.Error())
+	}
+	if black.Equals(red) {
+		owed := storedGame.Wager.Add(storedGame.Wager...)
+		if spendable := k.bank.SpendableCoins(ctx, black); !spendable.IsAllGTE(owed) {
+			return sdkerrors.Wrapf(types.ErrBlackCannotPay, "spendable %s is below %s", spendable, owed)
+		}
+		return nil
+	}
+	if spendable := k.bank.SpendableCoins(ctx, black); !spendable.IsAllGTE(storedGame.Wager) {
+		return sdkerrors.Wrapf(types.ErrBlackCannotPay, "spendable %s is below %s", spendable, storedGame.Wager)
+	}
+	if spendable := k.bank.SpendableCoins(ctx, red); !spendable.IsAllGTE(storedGame.Wager) {
+		return sdkerrors.Wrapf(types.ErrRedCannotPay, "spendable %s is below %s", spendable, storedGame.Wager)
+	}
+	return nil
+}
+
+// MustPayWinnings pays the winner the pot, minus the fee, and returns both amounts. When the fee cannot be paid, the
+// winner gets it too, as this runs in EndBlock on forfeits.
+func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame) (payout sdk.Coins, fee sdk.Coins) {
 	winnerAddress, found, err := storedGame.GetWinnerAddress()
 	if err != nil {
 		panic(err.Error())
@@ -41,33 +74,165 @@ func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame)
 	if !found {
 		panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Winner))
 	}
-	winnings := storedGame.GetWagerCoin()
+	winnings := storedGame.Wager
 	if storedGame.MoveCount == 0 {
 		panic(types.ErrNothingToPay.Error())
 	} else if 1 < storedGame.MoveCount {
-		winnings = winnings.Add(winnings)
+		winnings = winnings.Add(winnings...)
+	}
+	payout, fee = k.GetParams(ctx).SplitWinnings(winnings)
+	feeCollector := ""
+	if !fee.IsZero() {
+		feeCollector, err = k.payFee(ctx, fee)
+		if err != nil {
+			k.Logger(ctx).Error("cannot pay fee, winner keeps it", "game", storedGame.Index, "fee", fee, "error", err)
+			payout, fee, feeCollector = winnings, sdk.NewCoins(), ""
+		}
 	}
-	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(winnings))
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, payout)
 	if err != nil {
 		panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
 	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.WinningsPaidEventType,
+			sdk.NewAttribute(types.WinningsPaidEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.WinningsPaidEventWinner, winnerAddress.String()),
+			sdk.NewAttribute(types.WinningsPaidEventPayout, payout.String()),
+			sdk.NewAttribute(types.WinningsPaidEventFee, fee.String()),
+			sdk.NewAttribute(types.WinningsPaidEventFeeCollector, feeCollector),
+		),
+	)
+	return payout, fee
 }
 
-func (k *Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame) {
+// payFee sends the fee to the treasury address, to the community pool, or to the fee collector module when there is
+// none, and adds it to the collected totals. It returns where the fee went.
+func (k *Keeper) payFee(ctx sdk.Context, fee sdk.Coins) (feeCollector string, err error) {
+	feeCollector = k.FeeCollector(ctx)
+	switch feeCollector {
+	case "":
+		feeCollector = authtypes.FeeCollectorName
+		err = k.bank.SendCoinsFromModuleToModule(ctx, types.ModuleName, feeCollector, fee)
+	case types.FeeCollectorCommunityPool:
+		err = k.distribution.FundCommunityPool(ctx, fee, authtypes.NewModuleAddress(types.ModuleName))
+	default:
+		var treasury sdk.AccAddress
+		treasury, err = sdk.AccAddressFromBech32(feeCollector)
+		if err == nil {
+			err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, treasury, fee)
+		}
+	}
+	if err != nil {
+		return "", sdkerrors.Wrapf(types.ErrCannotPayFee, "%s", err)
+	}
+	collectedFees, _ := k.GetCollectedFees(ctx)
+	collectedFees.Total = collectedFees.Total.Add(fee...)
+	k.SetCollectedFees(ctx, collectedFees)
+	return feeCollector, nil
+}
+
+// MustRefundWager gives back the wagers collected so far, and returns their total.
+func (k *Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame) (refund sdk.Coins) {
+	refund = sdk.NewCoins()
 	if storedGame.MoveCount == 1 {
 		// Refund
 		black, err := storedGame.GetBlackAddress()
 		if err != nil {
 			panic(err.Error())
 		}
-		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, black, sdk.NewCoins(storedGame.GetWagerCoin()))
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, black, storedGame.Wager)
 		if err != nil {
 			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
 		}
+		refund = storedGame.Wager
 	} else if storedGame.MoveCount == 0 {
 		// Do nothing
 	} else {
-		// TODO Implement a draw mechanism.
-		panic(fmt.Sprintf(types.ErrNotInRefundState.Error(), storedGame.MoveCount))
+		// Draw, both wagers have been collected
+		black, err := storedGame.GetBlackAddress()
+		if err != nil {
+			panic(err.Error())
+		}
+		red, err := storedGame.GetRedAddress()
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, black, storedGame.Wager)
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, red, storedGame.Wager)
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+		}
+		refund = storedGame.Wager.Add(storedGame.Wager...)
+	}
+	return refund
+}
+
+// CollectEntryFee takes the entry fee of a player joining a tournament into the prize pool.
+func (k *Keeper) CollectEntryFee(ctx sdk.Context, tournament *types.Tournament, player sdk.AccAddress) error {
+	if tournament.EntryFee.Empty() {
+		return nil
+	}
+	err := k.bank.SendCoinsFromAccountToModule(ctx, player, types.ModuleName, tournament.EntryFee)
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrCannotPayEntryFee.Error())
+	}
+	tournament.PrizePool = tournament.PrizePool.Add(tournament.EntryFee...)
+	return nil
+}
+
+// MustPayPrizes pays the prizes set on the players of a finished tournament, which empties the prize pool.
+func (k *Keeper) MustPayPrizes(ctx sdk.Context, tournament *types.Tournament) {
+	for _, player := range tournament.Players {
+		if player.Prize.Empty() {
+			continue
+		}
+		address, err := sdk.AccAddressFromBech32(player.Address)
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, player.Prize)
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotPayPrize.Error(), err.Error()))
+		}
+	}
+	tournament.PrizePool = sdk.NewCoins()
+}
+
+// CollectBet takes a spectator bet into the bet escrow, which is kept apart from the wagers.
+func (k *Keeper) CollectBet(ctx sdk.Context, bettor sdk.AccAddress, amount sdk.Coins) error {
+	err := k.bank.SendCoinsFromAccountToModule(ctx, bettor, types.BetEscrowName, amount)
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrCannotPayBet.Error())
+	}
+	return nil
+}
+
+// MustSettleBets pays out the spectator bets on a game that is over, and forgets them. The bets are refunded when
+// the winner is neither black nor red, as on a draw or on a game that was never really played.
+func (k *Keeper) MustSettleBets(ctx sdk.Context, gameIndex string, winner string) {
+	gameBets, found := k.GetGameBets(ctx, gameIndex)
+	if !found {
+		return
+	}
+	for _, payout := range gameBets.GetPayouts(winner) {
+		bettor, err := sdk.AccAddressFromBech32(payout.Bettor)
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.BetEscrowName, bettor, payout.Amount)
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotPayBetPayout.Error(), err.Error()))
+		}
 	}
+	k.RemoveGameBets(ctx, gameIndex)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.BetsSettledEventType,
+			sdk.NewAttribute(types.BetsSettledEventGameIndex, gameIndex),
+			sdk.NewAttribute(types.BetsSettledEventWinner, winner),
+			sdk.NewAttribute(types.BetsSettledEventPool, gameBets.GetPool().String()),
+		),
+	)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/b9lab/checkers/x/checkers/testutil"
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -50,8 +51,7 @@ func TestWagerHandlerCollectFailedNoMove(t *testing.T) {
 	err := keeper.CollectWager(ctx, &types.StoredGame{
 		Black:     alice,
 		MoveCount: 0,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.NotNil(t, err)
 	require.EqualError(t, err, "black cannot pay the wager: Oops")
@@ -82,8 +82,7 @@ func TestWagerHandlerCollectFailedOneMove(t *testing.T) {
 	err := keeper.CollectWager(ctx, &types.StoredGame{
 		Red:       bob,
 		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.NotNil(t, err)
 	require.EqualError(t, err, "red cannot pay the wager: Oops")
@@ -97,8 +96,7 @@ func TestWagerHandlerCollectNoMove(t *testing.T) {
 	err := keeper.CollectWager(ctx, &types.StoredGame{
 		Black:     alice,
 		MoveCount: 0,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, err)
 }
@@ -111,8 +109,7 @@ func TestWagerHandlerCollectOneMove(t *testing.T) {
 	err := keeper.CollectWager(ctx, &types.StoredGame{
 		Red:       bob,
 		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 	require.Nil(t, err)
 }
@@ -161,7 +158,6 @@ func TestWagerHandlerPayWrongNotPayTime(t *testing.T) {
 		Red:       bob,
 		Winner:    "b",
 		MoveCount: 0,
-		Denom:     "stake",
 	})
 }
 
@@ -184,8 +180,7 @@ func TestWagerHandlerPayWrongEscrowFailed(t *testing.T) {
 		Red:       bob,
 		Winner:    "b",
 		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -199,8 +194,7 @@ func TestWagerHandlerPayEscrowCalledOneMove(t *testing.T) {
 		Red:       bob,
 		Winner:    "b",
 		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -214,23 +208,212 @@ func TestWagerHandlerPayEscrowCalledTwoMoves(t *testing.T) {
 		Red:       bob,
 		Winner:    "b",
 		MoveCount: 2,
-		Wager:     45,
-		Denom:     "coin",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("coin", 45)),
 	})
 }
 
-func TestWagerHandlerRefundWrongManyMoves(t *testing.T) {
+func setFeeParams(keeper keeper.Keeper, ctx sdk.Context, feeBps uint64, feeCollector string) {
+	params := keeper.GetParams(ctx)
+	params.FeeBps = feeBps
+	params.FeeCollector = feeCollector
+	keeper.SetParams(ctx, params)
+}
+
+func TestWagerHandlerPayFeeToFeeCollector(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFeeParams(keeper, ctx, 250, "")
+	payFee := escrow.ExpectFee(context, 2)
+	escrow.ExpectRefund(context, alice, 88).After(payFee)
+	payout, fee := keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Index:     "1",
+		Black:     alice,
+		Red:       bob,
+		Winner:    "b",
+		MoveCount: 2,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Equal(t, "88stake", payout.String())
+	require.Equal(t, "2stake", fee.String())
+	collectedFees, found := keeper.GetCollectedFees(ctx)
+	require.True(t, found)
+	require.Equal(t, "2stake", collectedFees.Total.String())
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "winnings-paid",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: alice},
+			{Key: "payout", Value: "88stake"},
+			{Key: "fee", Value: "2stake"},
+			{Key: "fee-collector", Value: "fee_collector"},
+		},
+	}, events[0])
+}
+
+func TestWagerHandlerPayFeeToTreasury(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFeeParams(keeper, ctx, 1_000, carol)
+	escrow.ExpectRefund(context, alice, 81).Times(2)
+	escrow.ExpectRefund(context, carol, 9).Times(2)
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Index:     "1",
+		Black:     alice,
+		Red:       bob,
+		Winner:    "b",
+		MoveCount: 2,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Index:     "2",
+		Black:     alice,
+		Red:       bob,
+		Winner:    "b",
+		MoveCount: 2,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	collectedFees, found := keeper.GetCollectedFees(ctx)
+	require.True(t, found)
+	require.Equal(t, "18stake", collectedFees.Total.String())
+}
+
+func TestWagerHandlerPayFeeRoundedToNothing(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFeeParams(keeper, ctx, 100, "")
+	escrow.ExpectRefund(context, alice, 90)
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:     alice,
+		Red:       bob,
+		Winner:    "b",
+		MoveCount: 2,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	collectedFees, found := keeper.GetCollectedFees(ctx)
+	require.True(t, found)
+	require.True(t, collectedFees.Total.IsZero())
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "winnings-paid",
+		Attributes: []sdk.Attribute{
+			{Key: "game-index", Value: ""},
+			{Key: "winner", Value: alice},
+			{Key: "payout", Value: "90stake"},
+			{Key: "fee", Value: ""},
+			{Key: "fee-collector", Value: ""},
+		},
+	}, events[0])
+}
+
+func TestWagerHandlerPayFeeToCommunityPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	distribution := testutil.NewMockDistributionKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithDistribution(t, escrow, distribution)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	context := sdk.WrapSDKContext(ctx)
+	setFeeParams(*k, ctx, 1_000, types.FeeCollectorCommunityPool)
+	fund := distribution.EXPECT().
+		FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 9)), authtypes.NewModuleAddress(types.ModuleName)).
+		Times(1)
+	escrow.ExpectRefund(context, alice, 81).After(fund)
+	payout, fee := k.MustPayWinnings(ctx, &types.StoredGame{
+		Index:     "1",
+		Black:     alice,
+		Red:       bob,
+		Winner:    "b",
+		MoveCount: 2,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Equal(t, "81stake", payout.String())
+	require.Equal(t, "9stake", fee.String())
+	collectedFees, found := k.GetCollectedFees(ctx)
+	require.True(t, found)
+	require.Equal(t, "9stake", collectedFees.Total.String())
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.Equal(t, sdk.Attribute{Key: "fee-collector", Value: "community-pool"}, events[0].Attributes[4])
+}
+
+func TestWagerHandlerPayFeeFailedGoesToWinner(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFeeParams(keeper, ctx, 1_000, carol)
+	carolAddress, _ := sdk.AccAddressFromBech32(carol)
+	payFee := escrow.EXPECT().
+		SendCoinsFromModuleToAccount(ctx, types.ModuleName, carolAddress, gomock.Any()).
+		Times(1).
+		Return(errors.New("Oops"))
+	escrow.ExpectRefund(context, alice, 90).After(payFee)
+	payout, fee := keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Index:     "1",
+		Black:     alice,
+		Red:       bob,
+		Winner:    "b",
+		MoveCount: 2,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Equal(t, "90stake", payout.String())
+	require.True(t, fee.IsZero())
+	collectedFees, found := keeper.GetCollectedFees(ctx)
+	require.True(t, found)
+	require.True(t, collectedFees.Total.IsZero())
+}
+
+func TestWagerHandlerPayWrongFeeFailed(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	setFeeParams(keeper, ctx, 250, "")
+	payFee := escrow.ExpectFee(context, 2).Return(errors.New("Oops"))
+	escrow.ExpectRefund(context, alice, 90).After(payFee)
+	payout, fee := keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:     alice,
+		Red:       bob,
+		Winner:    "b",
+		MoveCount: 2,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Equal(t, "90stake", payout.String())
+	require.True(t, fee.IsZero())
+}
+
+func TestWagerHandlerRefundWrongManyMovesNoRed(t *testing.T) {
 	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	defer func() {
 		r := recover()
 		require.NotNil(t, r, "The code did not panic")
-		require.Equal(t, "game is not in a state to refund, move count: 2", r)
+		require.Equal(t, "red address is invalid: : empty address string is not allowed", r)
 	}()
 	keeper.MustRefundWager(ctx, &types.StoredGame{
+		Black:     alice,
+		MoveCount: 2,
+	})
+}
+
+func TestWagerHandlerRefundManyMovesCalled(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	refundAlice := escrow.ExpectRefundWithDenom(context, alice, 45, "gold").Times(1)
+	escrow.ExpectRefundWithDenom(context, bob, 45, "gold").Times(1).After(refundAlice)
+	refund := keeper.MustRefundWager(ctx, &types.StoredGame{
+		Black:     alice,
+		Red:       bob,
 		MoveCount: 2,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("gold", 45)),
 	})
+	require.Equal(t, "90gold", refund.String())
 }
 
 func TestWagerHandlerRefundNoMoves(t *testing.T) {
@@ -273,8 +456,7 @@ func TestWagerHandlerRefundWrongEscrowFailed(t *testing.T) {
 	keeper.MustRefundWager(ctx, &types.StoredGame{
 		Black:     alice,
 		MoveCount: 1,
-		Wager:     45,
-		Denom:     "stake",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
 	})
 }
 
@@ -286,7 +468,71 @@ func TestWagerHandlerRefundCalled(t *testing.T) {
 	keeper.MustRefundWager(ctx, &types.StoredGame{
 		Black:     alice,
 		MoveCount: 1,
-		Wager:     45,
-		Denom:     "gold",
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("gold", 45)),
+	})
+}
+
+func TestWagerHandlerValidateNoWager(t *testing.T) {
+	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	err := keeper.ValidatePlayersCanPay(ctx, &types.StoredGame{
+		Black: alice,
+		Red:   bob,
 	})
+	require.Nil(t, err)
+}
+
+func TestWagerHandlerValidateBlackCannotPay(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectSpendable(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 44), sdk.NewInt64Coin("gold", 10)))
+	err := keeper.ValidatePlayersCanPay(ctx, &types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45), sdk.NewInt64Coin("gold", 10)),
+	})
+	require.EqualError(t, err, "spendable 10gold,44stake is below 10gold,45stake: black cannot pay the wager")
+}
+
+func TestWagerHandlerValidateRedCannotPay(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectSpendable(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)))
+	escrow.ExpectSpendable(context, bob, sdk.NewCoins(sdk.NewInt64Coin("coin", 45)))
+	err := keeper.ValidatePlayersCanPay(ctx, &types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.EqualError(t, err, "spendable 45coin is below 45stake: red cannot pay the wager")
+}
+
+func TestWagerHandlerValidateSelfPlayPaysTwice(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectSpendable(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 89)))
+	err := keeper.ValidatePlayersCanPay(ctx, &types.StoredGame{
+		Black: alice,
+		Red:   alice,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.EqualError(t, err, "spendable 89stake is below 90stake: black cannot pay the wager")
+}
+
+func TestWagerHandlerValidateBothCanPay(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectSpendable(context, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 45)))
+	escrow.ExpectSpendable(context, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	err := keeper.ValidatePlayersCanPay(ctx, &types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+	})
+	require.Nil(t, err)
 }
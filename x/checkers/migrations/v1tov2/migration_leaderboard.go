@@ -12,8 +12,8 @@ import (
 func addParsedCandidatesAndSort(parsedWinners []types.WinningPlayerParsed, candidates []types.WinningPlayerParsed) []types.WinningPlayerParsed {
 	updated := append(parsedWinners, candidates...)
 	types.SortWinners(updated)
-	if types.LeaderboardWinnerLength < uint64(len(updated)) {
-		updated = updated[:types.LeaderboardWinnerLength]
+	if LeaderboardWinnerLength < uint64(len(updated)) {
+		updated = updated[:LeaderboardWinnerLength]
 	}
 	return updated
 }
@@ -40,7 +40,7 @@ func handlePlayerInfosChannel(ctx sdk.Context, k keeper.Keeper,
 	playerInfosChannel <-chan []types.PlayerInfo,
 	done chan<- bool,
 	chunk uint64) {
-	winners := make([]types.WinningPlayerParsed, 0, types.LeaderboardWinnerLength+chunk)
+	winners := make([]types.WinningPlayerParsed, 0, LeaderboardWinnerLength+chunk)
 	for receivedInfo := range playerInfosChannel {
 		if receivedInfo != nil {
 			winners = AddCandidatesAndSort(winners, ctx, receivedInfo)
@@ -107,14 +107,14 @@ func Test101Players(t *testing.T) {
 		t.Run(fmt.Sprintf("chunk %d", chunk), func(t *testing.T) {
 			keeper, context := setupKeeperForV1ToV2Migration(t)
 			ctx := sdk.UnwrapSDKContext(context)
-			expectedWinners := make([]types.WinningPlayer, types.LeaderboardWinnerLength)
-			for i := uint64(0); i <= types.LeaderboardWinnerLength; i++ {
+			expectedWinners := make([]types.WinningPlayer, v1tov2.LeaderboardWinnerLength)
+			for i := uint64(0); i <= v1tov2.LeaderboardWinnerLength; i++ {
 				keeper.SetPlayerInfo(ctx, types.PlayerInfo{
 					Index:    strconv.FormatUint(i, 10),
 					WonCount: i,
 				})
 				if i > 0 {
-					expectedWinners[types.LeaderboardWinnerLength-i] = types.WinningPlayer{
+					expectedWinners[v1tov2.LeaderboardWinnerLength-i] = types.WinningPlayer{
 						PlayerAddress: strconv.FormatUint(i, 10),
 						WonCount:      i,
 						DateAdded:     "0001-01-01 00:00:00 +0000 UTC",
@@ -124,7 +124,7 @@ func Test101Players(t *testing.T) {
 			v1tov2.MapPlayerInfosReduceToLeaderboard(ctx, keeper, chunk)
 			leaderboard, found := keeper.GetLeaderboard(ctx)
 			require.True(t, found)
-			require.Equal(t, types.LeaderboardWinnerLength, uint64(len(leaderboard.Winners)))
+			require.Equal(t, v1tov2.LeaderboardWinnerLength, uint64(len(leaderboard.Winners)))
 			require.EqualValues(t, expectedWinners, leaderboard.Winners)
 		})
 	}
@@ -136,14 +136,14 @@ func Test201Players(t *testing.T) {
 
 			keeper, context := setupKeeperForV1ToV2Migration(t)
 			ctx := sdk.UnwrapSDKContext(context)
-			expectedWinners := make([]types.WinningPlayer, types.LeaderboardWinnerLength)
-			for i := uint64(0); i <= types.LeaderboardWinnerLength*2; i++ {
+			expectedWinners := make([]types.WinningPlayer, v1tov2.LeaderboardWinnerLength)
+			for i := uint64(0); i <= v1tov2.LeaderboardWinnerLength*2; i++ {
 				keeper.SetPlayerInfo(ctx, types.PlayerInfo{
 					Index:    strconv.FormatUint(i, 10),
 					WonCount: i,
 				})
 				if i > 100 {
-					expectedWinners[types.LeaderboardWinnerLength*2-i] = types.WinningPlayer{
+					expectedWinners[v1tov2.LeaderboardWinnerLength*2-i] = types.WinningPlayer{
 						PlayerAddress: strconv.FormatUint(i, 10),
 						WonCount:      i,
 						DateAdded:     "0001-01-01 00:00:00 +0000 UTC",
@@ -153,7 +153,7 @@ func Test201Players(t *testing.T) {
 			v1tov2.MapPlayerInfosReduceToLeaderboard(ctx, keeper, chunk)
 			leaderboard, found := keeper.GetLeaderboard(ctx)
 			require.True(t, found)
-			require.Equal(t, types.LeaderboardWinnerLength, uint64(len(leaderboard.Winners)))
+			require.Equal(t, v1tov2.LeaderboardWinnerLength, uint64(len(leaderboard.Winners)))
 			require.EqualValues(t, expectedWinners, leaderboard.Winners)
 		})
 	}
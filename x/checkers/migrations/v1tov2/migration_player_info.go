@@ -70,11 +70,8 @@ func handlePlayerInfoChannel(ctx sdk.Context, k keeper.Keeper,
 }
 
 func MapStoredGamesReduceToPlayerInfo(ctx sdk.Context, k keeper.Keeper, chunk uint64) error {
-	context := sdk.WrapSDKContext(ctx)
-	response, err := k.StoredGameAll(context, &types.QueryAllStoredGameRequest{
-		Pagination: &query.PageRequest{
-			Limit: chunk,
-		},
+	storedGames, pageRes, err := k.GetStoredGamePage(ctx, &query.PageRequest{
+		Limit: chunk,
 	})
 	if err != nil {
 		return err
@@ -85,19 +82,17 @@ func MapStoredGamesReduceToPlayerInfo(ctx sdk.Context, k keeper.Keeper, chunk ui
 
 	go handleStoredGameChannel(ctx, k, gamesChannel, playerInfoChannel)
 	go handlePlayerInfoChannel(ctx, k, playerInfoChannel, done)
-	gamesChannel <- response.StoredGame
+	gamesChannel <- storedGames
 
-	for response.Pagination.NextKey != nil {
-		response, err = k.StoredGameAll(context, &types.QueryAllStoredGameRequest{
-			Pagination: &query.PageRequest{
-				Key:   response.Pagination.NextKey,
-				Limit: chunk,
-			},
+	for pageRes.NextKey != nil {
+		storedGames, pageRes, err = k.GetStoredGamePage(ctx, &query.PageRequest{
+			Key:   pageRes.NextKey,
+			Limit: chunk,
 		})
 		if err != nil {
 			return err
 		}
-		gamesChannel <- response.StoredGame
+		gamesChannel <- storedGames
 	}
 	close(gamesChannel)
 
@@ -0,0 +1,121 @@
+package v2tov3
+
+import (
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func PerformMigration(ctx sdk.Context, k keeper.Keeper) error {
+	ctx.Logger().Info("Start to set the checkers params and system info...")
+	k.SetParams(ctx, types.DefaultParams())
+	MigrateSystemInfo(ctx, k)
+	if _, found := k.GetRatingLeaderboard(ctx); !found {
+		k.SetRatingLeaderboard(ctx, types.RatingLeaderboard{Players: []types.RatedPlayer{}})
+	}
+	if _, found := k.GetCollectedFees(ctx); !found {
+		k.SetCollectedFees(ctx, types.CollectedFees{Total: sdk.NewCoins()})
+	}
+	ctx.Logger().Info("Checkers params and system info done")
+	ctx.Logger().Info("Start to migrate the checkers games...")
+	for _, storedGame := range k.GetAllStoredGame(ctx) {
+		err := MigrateStoredGame(ctx, k, storedGame)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "game %s", storedGame.Index)
+		}
+	}
+	ctx.Logger().Info("Checkers games migration done")
+	return nil
+}
+
+// MigrateSystemInfo keeps the next game id, and starts the lists and ids that the former version did not have. Its
+// FIFO of active games is dropped, as the games are queued by deadline instead.
+func MigrateSystemInfo(ctx sdk.Context, k keeper.Keeper) {
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		systemInfo.NextId = types.DefaultIndex
+	}
+	if systemInfo.NextChallengeId == 0 {
+		systemInfo.NextChallengeId = types.DefaultIndex
+	}
+	if systemInfo.NextTournamentId == 0 {
+		systemInfo.NextTournamentId = types.DefaultIndex
+	}
+	if systemInfo.ChallengeFifoHeadIndex == "" {
+		systemInfo.ChallengeFifoHeadIndex = types.NoFifoIndex
+	}
+	if systemInfo.ChallengeFifoTailIndex == "" {
+		systemInfo.ChallengeFifoTailIndex = types.NoFifoIndex
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+}
+
+// MigrateStoredGame archives a game of the former version that is over, whose board was already cleared. Otherwise
+// it packs the board and books the game, which the former version had no pending state for, as active in the indices
+// that the former version did not have. In both cases the wager is moved into coins.
+func MigrateStoredGame(ctx sdk.Context, k keeper.Keeper, storedGame types.StoredGame) error {
+	err := MoveLegacyWager(&storedGame)
+	if err != nil {
+		return err
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		k.ArchiveGame(ctx, &storedGame, types.ArchiveReasonUnknown, storedGame.Board)
+		return nil
+	}
+	if _, err = storedGame.GetDeadlineAsTime(); err != nil {
+		return err
+	}
+	err = PackStoredGameBoard(&storedGame)
+	if err != nil {
+		return err
+	}
+	// The former FIFO of active games
+	storedGame.BeforeIndex = types.NoFifoIndex
+	storedGame.AfterIndex = types.NoFifoIndex
+	k.InsertDeadlineQueue(ctx, &storedGame)
+	k.SetStoredGame(ctx, storedGame)
+	k.SetPlayerGames(ctx, &storedGame)
+	k.AddActiveGame(ctx, &storedGame)
+	return nil
+}
+
+// MoveLegacyWager turns the single-denom wager of a former version into coins, so that what sits in escrow is paid
+// out or refunded as before. A wager already in coins is left as is.
+func MoveLegacyWager(storedGame *types.StoredGame) error {
+	if storedGame.LegacyWager != 0 && storedGame.Wager.Empty() {
+		coin := sdk.Coin{Denom: storedGame.LegacyDenom, Amount: sdk.NewIntFromUint64(storedGame.LegacyWager)}
+		if err := coin.Validate(); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidWager, "%s", err)
+		}
+		storedGame.Wager = sdk.NewCoins(coin)
+	}
+	storedGame.LegacyWager = 0
+	storedGame.LegacyDenom = ""
+	return nil
+}
+
+// PackStoredGameBoard replaces the board string of a former version with the packed board, and records the position
+// hash of games started before it was. A game whose board is already packed is left as is.
+func PackStoredGameBoard(storedGame *types.StoredGame) error {
+	if len(storedGame.PackedBoard) != 0 {
+		return nil
+	}
+	variant, err := storedGame.ParseVariant()
+	if err != nil {
+		return err
+	}
+	board, err := rules.ParseWithVariant(storedGame.Board, variant)
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrGameNotParseable.Error())
+	}
+	storedGame.PackedBoard = board.Pack()
+	storedGame.Board = ""
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return err
+	}
+	storedGame.PositionHash = game.Hash()
+	return nil
+}
@@ -0,0 +1,218 @@
+package v2tov3_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/b9lab/checkers/testutil/keeper"
+	"github.com/b9lab/checkers/x/checkers/keeper"
+	"github.com/b9lab/checkers/x/checkers/migrations/v2tov3"
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	midGameBoard = "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"
+)
+
+func TestPackStoredGameBoard(t *testing.T) {
+	storedGame := types.StoredGame{
+		Index: "1",
+		Board: midGameBoard,
+		Turn:  "r",
+	}
+	require.Nil(t, v2tov3.PackStoredGameBoard(&storedGame))
+	require.Equal(t, "", storedGame.Board)
+	require.Equal(t, testutil.PackBoard(midGameBoard), storedGame.PackedBoard)
+	game, err := storedGame.ParseGame()
+	require.Nil(t, err)
+	require.Equal(t, midGameBoard, game.String())
+	require.Equal(t, game.Hash(), storedGame.PositionHash)
+}
+
+func TestPackStoredGameBoardInternational(t *testing.T) {
+	storedGame := types.StoredGame{
+		Index:   "1",
+		Board:   rules.NewWithVariant(rules.INTERNATIONAL_VARIANT).String(),
+		Turn:    "b",
+		Variant: rules.INTERNATIONAL,
+	}
+	require.Nil(t, v2tov3.PackStoredGameBoard(&storedGame))
+	require.Equal(t, rules.NewWithVariant(rules.INTERNATIONAL_VARIANT).Pack(), storedGame.PackedBoard)
+}
+
+func TestPackStoredGameBoardAlreadyPacked(t *testing.T) {
+	storedGame := types.StoredGame{
+		Index:       "1",
+		Turn:        "b",
+		PackedBoard: testutil.PackBoard(midGameBoard),
+	}
+	require.Nil(t, v2tov3.PackStoredGameBoard(&storedGame))
+	require.Equal(t, testutil.PackBoard(midGameBoard), storedGame.PackedBoard)
+}
+
+func TestPackStoredGameBoardNotParseable(t *testing.T) {
+	storedGame := types.StoredGame{
+		Index: "1",
+		Board: "not a board",
+		Turn:  "b",
+	}
+	require.EqualError(t, v2tov3.PackStoredGameBoard(&storedGame), "game cannot be parsed: invalid board string: not a board")
+}
+
+func TestMoveLegacyWager(t *testing.T) {
+	storedGame := types.StoredGame{LegacyWager: 45, LegacyDenom: "stake"}
+	require.Nil(t, v2tov3.MoveLegacyWager(&storedGame))
+	require.Equal(t, "45stake", storedGame.Wager.String())
+	require.EqualValues(t, 0, storedGame.LegacyWager)
+	require.Equal(t, "", storedGame.LegacyDenom)
+}
+
+func TestMoveLegacyWagerNone(t *testing.T) {
+	storedGame := types.StoredGame{LegacyDenom: "stake"}
+	require.Nil(t, v2tov3.MoveLegacyWager(&storedGame))
+	require.True(t, storedGame.Wager.Empty())
+	require.Equal(t, "", storedGame.LegacyDenom)
+}
+
+func TestMoveLegacyWagerAlreadyCoins(t *testing.T) {
+	storedGame := types.StoredGame{
+		Wager:       sdk.NewCoins(sdk.NewInt64Coin("gold", 10)),
+		LegacyWager: 45,
+		LegacyDenom: "stake",
+	}
+	require.Nil(t, v2tov3.MoveLegacyWager(&storedGame))
+	require.Equal(t, "10gold", storedGame.Wager.String())
+	require.EqualValues(t, 0, storedGame.LegacyWager)
+}
+
+func TestMoveLegacyWagerInvalidDenom(t *testing.T) {
+	storedGame := types.StoredGame{LegacyWager: 45}
+	require.EqualError(t,
+		v2tov3.MoveLegacyWager(&storedGame),
+		"invalid denom: : wager is invalid")
+}
+
+// setLegacyGames stores the games as the former version did, with the board spelled out.
+func setLegacyGames(ctx sdk.Context, storeKey sdk.StoreKey, storedGames ...types.StoredGame) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.StoredGameKeyPrefix))
+	for _, storedGame := range storedGames {
+		store.Set(types.StoredGameKey(storedGame.Index), cdc.MustMarshal(&storedGame))
+	}
+}
+
+func TestPerformMigration(t *testing.T) {
+	k, ctx, storeKey := keepertest.CheckersKeeperWithStoreKey(t, nil)
+	ctx = ctx.WithBlockHeight(50).WithBlockTime(time.Date(2022, time.November, 1, 0, 0, 0, 0, time.UTC))
+	deadline := types.FormatDeadline(ctx.BlockTime().Add(time.Hour))
+	setLegacyGames(ctx, storeKey,
+		types.StoredGame{Index: "1", Board: rules.New().String(), Turn: "b", Black: testutil.Alice, Red: testutil.Bob,
+			BeforeIndex: "-1", AfterIndex: "2", Deadline: deadline, Winner: "*"},
+		types.StoredGame{Index: "2", Board: midGameBoard, Turn: "r", Black: testutil.Bob, Red: testutil.Carol,
+			BeforeIndex: "1", AfterIndex: "-1", Deadline: deadline, Winner: "*", MoveCount: 2,
+			LegacyWager: 45, LegacyDenom: "stake"})
+	k.SetSystemInfo(ctx, types.SystemInfo{NextId: 4})
+	require.Nil(t, v2tov3.PerformMigration(ctx, *k))
+	game1, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.StoredGame{
+		Index:        "1",
+		Turn:         "b",
+		Black:        testutil.Alice,
+		Red:          testutil.Bob,
+		BeforeIndex:  "-1",
+		AfterIndex:   "-1",
+		Deadline:     deadline,
+		Winner:       "*",
+		PackedBoard:  rules.New().Pack(),
+		PositionHash: rules.New().Hash(),
+	}, game1)
+	game2, found := k.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "", game2.Board)
+	require.Equal(t, testutil.PackBoard(midGameBoard), game2.PackedBoard)
+	require.Equal(t, "45stake", game2.Wager.String())
+	require.EqualValues(t, 0, game2.LegacyWager)
+	require.Equal(t, "", game2.LegacyDenom)
+
+	require.Equal(t, []string{"1", "2"}, k.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(2*time.Hour))))
+	require.EqualValues(t, 1, k.GetActiveGameCount(ctx, testutil.Alice))
+	require.EqualValues(t, 2, k.GetActiveGameCount(ctx, testutil.Bob))
+	require.EqualValues(t, 1, k.GetActiveGameCount(ctx, testutil.Carol))
+	status, found := k.GetPlayerGameStatus(ctx, testutil.Carol, "2")
+	require.True(t, found)
+	require.Equal(t, types.PlayerGameStatusActive, status)
+	systemInfo, found := k.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId:                 4,
+		NextChallengeId:        1,
+		ChallengeFifoHeadIndex: "-1",
+		ChallengeFifoTailIndex: "-1",
+		NextTournamentId:       1,
+	}, systemInfo)
+	require.EqualValues(t, types.DefaultParams(), k.GetParams(ctx))
+	ratingLeaderboard, found := k.GetRatingLeaderboard(ctx)
+	require.True(t, found)
+	require.Empty(t, ratingLeaderboard.Players)
+	collectedFees, found := k.GetCollectedFees(ctx)
+	require.True(t, found)
+	require.True(t, collectedFees.Total.IsZero())
+	for _, invariant := range []sdk.Invariant{keeper.GameFifoInvariant(*k), keeper.LeaderboardInvariant(*k)} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+}
+
+func TestPerformMigrationArchivesFinishedGames(t *testing.T) {
+	k, ctx, storeKey := keepertest.CheckersKeeperWithStoreKey(t, nil)
+	ctx = ctx.WithBlockHeight(50)
+	setLegacyGames(ctx, storeKey,
+		types.StoredGame{Index: "1", Turn: "b", Black: testutil.Alice, Red: testutil.Bob,
+			BeforeIndex: "-1", AfterIndex: "-1", Deadline: types.FormatDeadline(ctx.BlockTime()), Winner: "r",
+			MoveCount: 7, LegacyWager: 45, LegacyDenom: "stake"})
+	k.SetSystemInfo(ctx, types.SystemInfo{NextId: 2})
+	require.Nil(t, v2tov3.PerformMigration(ctx, *k))
+	_, found := k.GetStoredGame(ctx, "1")
+	require.False(t, found)
+	archivedGame, found := k.GetArchivedGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.ArchivedGame{
+		Index:     "1",
+		Black:     testutil.Alice,
+		Red:       testutil.Bob,
+		Wager:     sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Winner:    "r",
+		Reason:    types.ArchiveReasonUnknown,
+		MoveCount: 7,
+		EndHeight: 50,
+	}, archivedGame)
+	require.Nil(t, archivedGame.Validate())
+	status, found := k.GetPlayerGameStatus(ctx, testutil.Bob, "1")
+	require.True(t, found)
+	require.Equal(t, types.PlayerGameStatusFinished, status)
+	require.EqualValues(t, 0, k.GetActiveGameCount(ctx, testutil.Alice))
+	require.Empty(t, k.GetExpiredGameIndices(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))))
+}
+
+func TestPerformMigrationInvalidDeadline(t *testing.T) {
+	k, ctx, storeKey := keepertest.CheckersKeeperWithStoreKey(t, nil)
+	setLegacyGames(ctx, storeKey, types.StoredGame{Index: "1", Board: midGameBoard, Turn: "b", Black: testutil.Alice,
+		Red: testutil.Bob, Deadline: "not a deadline", Winner: "*"})
+	require.EqualError(t, v2tov3.PerformMigration(ctx, *k),
+		"game 1: deadline cannot be parsed: not a deadline: parsing time \"not a deadline\" as \"2006-01-02 15:04:05.999999999 +0000 UTC\": cannot parse \"not a deadline\" as \"2006\"")
+}
+
+func TestPerformMigrationNotParseable(t *testing.T) {
+	k, ctx, storeKey := keepertest.CheckersKeeperWithStoreKey(t, nil)
+	setLegacyGames(ctx, storeKey, types.StoredGame{Index: "1", Board: "not a board", Turn: "b", Black: testutil.Alice,
+		Red: testutil.Bob, Deadline: types.FormatDeadline(ctx.BlockTime()), Winner: "*"})
+	require.EqualError(t, v2tov3.PerformMigration(ctx, *k), "game 1: game cannot be parsed: invalid board string: not a board")
+}
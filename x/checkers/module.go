@@ -16,12 +16,17 @@ import (
 	v1 "github.com/b9lab/checkers/x/checkers/migrations/v1"
 	"github.com/b9lab/checkers/x/checkers/migrations/v1tov2"
 	v2 "github.com/b9lab/checkers/x/checkers/migrations/v2"
+	"github.com/b9lab/checkers/x/checkers/migrations/v2tov3"
+	v3 "github.com/b9lab/checkers/x/checkers/migrations/v3"
 	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/b9lab/checkers/x/checkers/watch"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
 
 var (
@@ -83,6 +88,17 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
 }
 
+// RegisterWatchService registers the streaming Watch service on the node's gRPC server. It stays out of
+// RegisterServices because the query router only serves unary calls, and because it needs the node's event bus,
+// which the client context carries once the node has started.
+func (AppModuleBasic) RegisterWatchService(clientCtx client.Context, server gogogrpc.Server) {
+	var events rpcclient.EventsClient
+	if clientCtx.Client != nil {
+		events = clientCtx.Client
+	}
+	types.RegisterWatchServer(server, watch.NewServer(events, types.NewQueryClient(clientCtx)))
+}
+
 // GetTxCmd returns the capability module's root tx command.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.GetTxCmd()
@@ -148,10 +164,17 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}); err != nil {
 		panic(fmt.Errorf("failed to register migration of %s to v2: %w", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, v2.TargetConsensusVersion, func(ctx sdk.Context) error {
+		return v2tov3.PerformMigration(ctx, am.keeper)
+	}); err != nil {
+		panic(fmt.Errorf("failed to register migration of %s to v3: %w", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
@@ -172,7 +195,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return v2.TargetConsensusVersion }
+func (AppModule) ConsensusVersion() uint64 { return v3.TargetConsensusVersion }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -181,5 +204,9 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	am.keeper.ForfeitExpiredGames(sdk.WrapSDKContext(ctx))
+	am.keeper.AdvanceTournaments(sdk.WrapSDKContext(ctx))
+	am.keeper.ExpireChallenges(sdk.WrapSDKContext(ctx))
+	am.keeper.ExpireInvitations(sdk.WrapSDKContext(ctx))
+	am.keeper.PruneArchivedGames(sdk.WrapSDKContext(ctx))
 	return []abci.ValidatorUpdate{}
 }
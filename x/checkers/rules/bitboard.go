@@ -0,0 +1,173 @@
+package rules
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Bitboard has one bit per playable square. The playable squares are the dark ones, numbered row by row from the
+// top left, so an 8x8 board uses the low 32 bits and a 10x10 board the low 50.
+type Bitboard uint64
+
+// The largest board whose playable squares fit in a Bitboard.
+const MAX_BOARD_DIM = 11
+
+func squareBit(square int) Bitboard {
+	return Bitboard(1) << uint(square)
+}
+
+func (board Bitboard) Has(square int) bool {
+	return board&squareBit(square) != 0
+}
+
+func (board Bitboard) Count() int {
+	return bits.OnesCount64(uint64(board))
+}
+
+// ForEach calls do with every square set on the board, in increasing order.
+func (board Bitboard) ForEach(do func(square int)) {
+	for board != 0 {
+		do(bits.TrailingZeros64(uint64(board)))
+		board &= board - 1
+	}
+}
+
+// geometry holds the squares, diagonals and masks of a board dimension, computed once.
+type geometry struct {
+	dim     int
+	squares int
+	// The square at y*dim+x, -1 on the light squares.
+	squareAt  []int
+	positions []Pos
+	// rays[square][dir] lists the squares along Diagonals[dir] going away from square, nearest first.
+	rays [][len(Diagonals)][]int
+	// The squares a man of each side can step to, indexed by sideOf.
+	manSteps [2][]Bitboard
+	// The squares a non-flying king can step to.
+	kingSteps []Bitboard
+	// The diagonals along which the men of each side move and capture when they cannot capture backward.
+	forwardDirs [2][]int
+	// The far row where the men of each side are crowned.
+	crownRow [2]Bitboard
+}
+
+var geometries = map[int]*geometry{}
+
+func init() {
+	for _, variant := range Variants {
+		if _, ok := geometries[variant.BoardDim()]; !ok {
+			geometries[variant.BoardDim()] = newGeometry(variant.BoardDim())
+		}
+	}
+}
+
+// geometryOf returns the precomputed geometry of the variants, or computes it for another dimension.
+func geometryOf(dim int) *geometry {
+	if geo, ok := geometries[dim]; ok {
+		return geo
+	}
+	return newGeometry(dim)
+}
+
+func newGeometry(dim int) *geometry {
+	if dim < 1 || MAX_BOARD_DIM < dim {
+		panic(fmt.Sprintf("board dimension %d does not fit in a bitboard", dim))
+	}
+	geo := &geometry{
+		dim:      dim,
+		squareAt: make([]int, dim*dim),
+	}
+	for y := 0; y < dim; y++ {
+		for x := 0; x < dim; x++ {
+			if isDarkSquare(Pos{x, y}) {
+				geo.squareAt[y*dim+x] = len(geo.positions)
+				geo.positions = append(geo.positions, Pos{x, y})
+			} else {
+				geo.squareAt[y*dim+x] = -1
+			}
+		}
+	}
+	geo.squares = len(geo.positions)
+	geo.rays = make([][len(Diagonals)][]int, geo.squares)
+	geo.kingSteps = make([]Bitboard, geo.squares)
+	for side := range geo.manSteps {
+		geo.manSteps[side] = make([]Bitboard, geo.squares)
+	}
+	for dir, diagonal := range Diagonals {
+		for _, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
+			if diagonal.Y == Forward[player] {
+				geo.forwardDirs[sideOf(player)] = append(geo.forwardDirs[sideOf(player)], dir)
+			}
+		}
+	}
+	for square, pos := range geo.positions {
+		for dir, diagonal := range Diagonals {
+			ray := []int{}
+			for next := (Pos{pos.X + diagonal.X, pos.Y + diagonal.Y}); geo.onBoard(next); next = (Pos{next.X + diagonal.X, next.Y + diagonal.Y}) {
+				ray = append(ray, geo.square(next))
+			}
+			geo.rays[square][dir] = ray
+			if len(ray) == 0 {
+				continue
+			}
+			geo.kingSteps[square] |= squareBit(ray[0])
+			for _, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
+				if diagonal.Y == Forward[player] {
+					geo.manSteps[sideOf(player)][square] |= squareBit(ray[0])
+				}
+			}
+		}
+		if pos.Y == dim-1 {
+			geo.crownRow[sideOf(BLACK_PLAYER)] |= squareBit(square)
+		}
+		if pos.Y == 0 {
+			geo.crownRow[sideOf(RED_PLAYER)] |= squareBit(square)
+		}
+	}
+	return geo
+}
+
+// isDarkSquare tells whether the pieces can stand on the position. The top left square is light.
+func isDarkSquare(pos Pos) bool {
+	return (pos.X+pos.Y)%2 == 1
+}
+
+func (geo *geometry) onBoard(pos Pos) bool {
+	return 0 <= pos.X && pos.X < geo.dim && 0 <= pos.Y && pos.Y < geo.dim
+}
+
+// square returns the playable square at the position, or -1.
+func (geo *geometry) square(pos Pos) int {
+	if !geo.onBoard(pos) {
+		return -1
+	}
+	return geo.squareAt[pos.Y*geo.dim+pos.X]
+}
+
+// direction finds the diagonal going from src to dst and how many squares away dst is, or -1 when they are not on
+// a shared diagonal.
+func (geo *geometry) direction(src, dst int) (dir int, distance int) {
+	from, to := geo.positions[src], geo.positions[dst]
+	dx, dy := to.X-from.X, to.Y-from.Y
+	if dx == 0 || (dx != dy && dx != -dy) {
+		return -1, 0
+	}
+	distance = dx
+	if distance < 0 {
+		distance = -distance
+	}
+	for dir, diagonal := range Diagonals {
+		if diagonal.X*distance == dx && diagonal.Y*distance == dy {
+			return dir, distance
+		}
+	}
+	return -1, 0
+}
+
+// sideOf indexes the per-side tables, black first.
+func sideOf(player Player) int {
+	if player == RED_PLAYER {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,302 @@
+package rules
+
+func (board *board) occupied() Bitboard {
+	return board.black | board.red
+}
+
+func (board *board) own(player Player) Bitboard {
+	switch player {
+	case BLACK_PLAYER:
+		return board.black
+	case RED_PLAYER:
+		return board.red
+	}
+	return 0
+}
+
+func (board *board) pieceOn(square int) (Piece, bool) {
+	if square < 0 {
+		return NO_PIECE, false
+	}
+	king := board.kings.Has(square)
+	switch {
+	case board.black.Has(square):
+		return Piece{BLACK_PLAYER, king}, true
+	case board.red.Has(square):
+		return Piece{RED_PLAYER, king}, true
+	}
+	return NO_PIECE, false
+}
+
+// The board string bytes of the pieces, by side and then king, and of the empty squares.
+var pieceBytes [2][2]byte
+var emptyByte = PieceStrings[NO_PLAYER][0]
+
+func init() {
+	for s, piece := range StringPieces {
+		if piece != NO_PIECE {
+			king := 0
+			if piece.King {
+				king = 1
+			}
+			pieceBytes[sideOf(piece.Player)][king] = s[0]
+		}
+	}
+}
+
+// pieceByte spells the piece on the occupied square as in the board string.
+func (board *board) pieceByte(square int) byte {
+	side, king := 0, 0
+	if board.red.Has(square) {
+		side = 1
+	}
+	if board.kings.Has(square) {
+		king = 1
+	}
+	return pieceBytes[side][king]
+}
+
+func (board *board) put(square int, piece Piece) {
+	if previous, ok := board.pieceOn(square); ok {
+		board.hash ^= zobristPiece(previous, square)
+	}
+	bit := squareBit(square)
+	board.black &^= bit
+	board.red &^= bit
+	board.kings &^= bit
+	switch piece.Player {
+	case BLACK_PLAYER:
+		board.black |= bit
+	case RED_PLAYER:
+		board.red |= bit
+	default:
+		return
+	}
+	if piece.King {
+		board.kings |= bit
+	}
+	board.hash ^= zobristPiece(piece, square)
+}
+
+func (board *board) move(src, dst int) {
+	piece, _ := board.pieceOn(src)
+	board.hash ^= zobristPiece(piece, src) ^ zobristPiece(piece, dst)
+	srcBit, dstBit := squareBit(src), squareBit(dst)
+	if board.black&srcBit != 0 {
+		board.black ^= srcBit | dstBit
+	} else {
+		board.red ^= srcBit | dstBit
+	}
+	if board.kings&srcBit != 0 {
+		board.kings ^= srcBit | dstBit
+	}
+}
+
+// opponents are the pieces that the piece on square can capture.
+func (board *board) opponents(square int) Bitboard {
+	if board.black.Has(square) {
+		return board.red
+	}
+	return board.black
+}
+
+// directions lists the diagonals along which the piece on square moves, or captures.
+func (board *board) directions(square int, capturing bool, variant Variant) []int {
+	if board.kings.Has(square) || (capturing && variant.MenCaptureBackward()) {
+		return allDirections
+	}
+	if board.black.Has(square) {
+		return board.geo.forwardDirs[sideOf(BLACK_PLAYER)]
+	}
+	return board.geo.forwardDirs[sideOf(RED_PLAYER)]
+}
+
+var allDirections = []int{0, 1, 2, 3}
+
+func (board *board) flies(square int, variant Variant) bool {
+	return board.kings.Has(square) && variant.FlyingKings()
+}
+
+// hasStep tells whether the piece on square can move without capturing, which only needs a free adjacent square.
+func (board *board) hasStep(square int) bool {
+	steps := board.geo.kingSteps[square]
+	if !board.kings.Has(square) {
+		steps = board.geo.manSteps[sideOf(BLACK_PLAYER)][square]
+		if board.red.Has(square) {
+			steps = board.geo.manSteps[sideOf(RED_PLAYER)][square]
+		}
+	}
+	return steps&^board.occupied() != 0
+}
+
+// forEachStep calls do with every square where the piece on square can go without capturing.
+func (board *board) forEachStep(square int, variant Variant, do func(dst int)) {
+	occupied := board.occupied()
+	flying := board.flies(square, variant)
+	for _, dir := range board.directions(square, false, variant) {
+		for _, dst := range board.geo.rays[square][dir] {
+			if occupied.Has(dst) {
+				break
+			}
+			do(dst)
+			if !flying {
+				break
+			}
+		}
+	}
+}
+
+// stepTo tells whether the piece on src can go to dst without capturing.
+func (board *board) stepTo(src, dst int, variant Variant) bool {
+	dir, distance := board.geo.direction(src, dst)
+	if dir < 0 || (1 < distance && !board.flies(src, variant)) || !containsDirection(board.directions(src, false, variant), dir) {
+		return false
+	}
+	occupied := board.occupied()
+	for _, square := range board.geo.rays[src][dir][:distance] {
+		if occupied.Has(square) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsDirection(dirs []int, dir int) bool {
+	for _, candidate := range dirs {
+		if candidate == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// capturable finds, along the ray, the index of the opponent piece that can be jumped, or -1. A flying king looks
+// past the free squares, the others only at the adjacent one. A piece already captured in this turn can be neither
+// jumped again nor passed.
+func (board *board) capturable(ray []int, flying bool, opponents Bitboard) int {
+	occupied := board.occupied()
+	over := 0
+	if flying {
+		for over < len(ray) && !occupied.Has(ray[over]) {
+			over++
+		}
+	}
+	if over+1 < len(ray) && (opponents &^ board.dead).Has(ray[over]) && !occupied.Has(ray[over+1]) {
+		return over
+	}
+	return -1
+}
+
+// hasJump tells whether the piece on square can capture.
+func (board *board) hasJump(square int, variant Variant) bool {
+	flying := board.flies(square, variant)
+	opponents := board.opponents(square)
+	for _, dir := range board.directions(square, true, variant) {
+		if 0 <= board.capturable(board.geo.rays[square][dir], flying, opponents) {
+			return true
+		}
+	}
+	return false
+}
+
+// forEachJump calls do with every square where the piece on square can land when capturing, along with the square
+// of the piece it captures.
+func (board *board) forEachJump(square int, variant Variant, do func(over int, dst int)) {
+	occupied := board.occupied()
+	flying := board.flies(square, variant)
+	opponents := board.opponents(square)
+	for _, dir := range board.directions(square, true, variant) {
+		ray := board.geo.rays[square][dir]
+		over := board.capturable(ray, flying, opponents)
+		if over < 0 {
+			continue
+		}
+		for _, dst := range ray[over+1:] {
+			if occupied.Has(dst) {
+				break
+			}
+			do(ray[over], dst)
+			if !flying {
+				break
+			}
+		}
+	}
+}
+
+// jumpTo finds the square of the piece captured when the piece on src lands on dst, or -1 when it is no capture.
+func (board *board) jumpTo(src, dst int, variant Variant) int {
+	dir, distance := board.geo.direction(src, dst)
+	if dir < 0 || !containsDirection(board.directions(src, true, variant), dir) {
+		return -1
+	}
+	ray := board.geo.rays[src][dir]
+	over := board.capturable(ray, board.flies(src, variant), board.opponents(src))
+	if over < 0 || distance <= over+1 || (!board.flies(src, variant) && distance != 2) {
+		return -1
+	}
+	occupied := board.occupied()
+	for _, square := range ray[over+1 : distance] {
+		if occupied.Has(square) {
+			return -1
+		}
+	}
+	return ray[over]
+}
+
+// applyJump moves the piece and marks the captured one dead, without checking or passing the turn. Dead pieces stay
+// on the board until the capture ends, see removeDead.
+func (board *board) applyJump(src, dst, over int, variant Variant) {
+	board.move(src, dst)
+	board.dead |= squareBit(over)
+	if variant.PromoteMidCapture() {
+		board.crown(dst)
+	}
+}
+
+// removeDead takes off the pieces captured in the capture that just ended.
+func (board *board) removeDead() {
+	board.dead.ForEach(func(square int) {
+		board.put(square, NO_PIECE)
+	})
+	board.dead = 0
+}
+
+// captureMemo keeps the capture depths already explored, keyed by all they depend on, so that the longest chains
+// are only searched once per turn.
+type captureMemo map[captureKey]int
+
+type captureKey struct {
+	black, red, kings, dead Bitboard
+	src                     int
+}
+
+// captureDepth is the most pieces the piece on src can capture in a single turn.
+func (board *board) captureDepth(src int, variant Variant, memo captureMemo) int {
+	key := captureKey{board.black, board.red, board.kings, board.dead, src}
+	if depth, ok := memo[key]; ok {
+		return depth
+	}
+	depth := 0
+	board.forEachJump(src, variant, func(over int, dst int) {
+		after := *board
+		after.applyJump(src, dst, over, variant)
+		if candidate := 1 + after.captureDepth(dst, variant, memo); depth < candidate {
+			depth = candidate
+		}
+	})
+	memo[key] = depth
+	return depth
+}
+
+// crown makes a king of the man on square when it stands on its far row.
+func (board *board) crown(square int) {
+	if board.kings.Has(square) {
+		return
+	}
+	if (board.black.Has(square) && board.geo.crownRow[sideOf(BLACK_PLAYER)].Has(square)) ||
+		(board.red.Has(square) && board.geo.crownRow[sideOf(RED_PLAYER)].Has(square)) {
+		man, _ := board.pieceOn(square)
+		board.kings |= squareBit(square)
+		board.hash ^= zobristPiece(man, square) ^ zobristPiece(Piece{man.Player, true}, square)
+	}
+}
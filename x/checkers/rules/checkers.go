@@ -1,9 +1,9 @@
 package rules
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -11,7 +11,13 @@ const (
 	BOARD_DIM = 8
 	RED       = "red"
 	BLACK     = "black"
+	DRAW      = "draw"
 	ROW_SEP   = "|"
+
+	// A position seen this many times is a draw.
+	REPETITION_LIMIT = 3
+	// This many moves by each player without a capture or a man moving is a draw.
+	NO_PROGRESS_LIMIT = 40
 )
 
 type Player struct {
@@ -27,6 +33,7 @@ var PieceStrings = map[Player]string{
 	RED_PLAYER:   "r",
 	BLACK_PLAYER: "b",
 	NO_PLAYER:    "*",
+	DRAW_PLAYER:  "d",
 }
 
 var NO_PIECE = Piece{NO_PLAYER, false}
@@ -51,6 +58,7 @@ var RED_PLAYER = Player{RED}
 var NO_PLAYER = Player{
 	Color: "NO_PLAYER",
 }
+var DRAW_PLAYER = Player{DRAW}
 
 var Players = map[string]Player{
 	RED:   RED_PLAYER,
@@ -62,84 +70,128 @@ var Opponents = map[Player]Player{
 	RED_PLAYER:   BLACK_PLAYER,
 }
 
-var Usable = map[Pos]bool{}
-var Moves = map[Player]map[Pos]map[Pos]bool{}
-var Jumps = map[Player]map[Pos]map[Pos]Pos{}
-var KingMoves = map[Pos]map[Pos]bool{}
-var KingJumps = map[Pos]map[Pos]Pos{}
+// The four diagonal steps a piece can take.
+var Diagonals = [...]Pos{{1, 1}, {-1, 1}, {1, -1}, {-1, -1}}
 
-func Capture(src, dst Pos) Pos {
-	return Pos{(src.X + dst.X) / 2, (src.Y + dst.Y) / 2}
+// Forward is the Y direction in which the men of the player advance.
+var Forward = map[Player]int{
+	BLACK_PLAYER: 1,
+	RED_PLAYER:   -1,
 }
 
-func init() {
-
-	// Initialize usable spaces
-	for y := 0; y < BOARD_DIM; y++ {
-		for x := (y + 1) % 2; x < BOARD_DIM; x += 2 {
-			Usable[Pos{X: x, Y: y}] = true
-		}
-	}
-
-	// Initialize deep maps
-	for _, p := range Players {
-		Moves[p] = map[Pos]map[Pos]bool{}
-		Jumps[p] = map[Pos]map[Pos]Pos{}
-	}
-
-	// Compute possible moves, jumps and captures
-	for pos := range Usable {
-		KingMoves[pos] = map[Pos]bool{}
-		KingJumps[pos] = map[Pos]Pos{}
-		var directions = []int{1, -1}
-		for i, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
-			Moves[player][pos] = map[Pos]bool{}
-			Jumps[player][pos] = map[Pos]Pos{}
-			movOff := 1
-			jmpOff := 2
-			for _, direction := range directions {
-				mov := Pos{pos.X + (movOff * direction), pos.Y + (movOff * directions[i])}
-				if Usable[mov] {
-					Moves[player][pos][mov] = true
-					KingMoves[pos][mov] = true
-				}
-				jmp := Pos{pos.X + (jmpOff * direction), pos.Y + (jmpOff * directions[i])}
-				if Usable[jmp] {
-					capturePos := Capture(pos, jmp)
-					Jumps[player][pos][jmp] = capturePos
-					KingJumps[pos][jmp] = capturePos
-				}
-			}
-		}
-	}
+// board places the pieces on the playable squares, one bitboard per color plus one for the kings of either color.
+type board struct {
+	geo   *geometry
+	black Bitboard
+	red   Bitboard
+	kings Bitboard
+	// The pieces captured so far in the capture under way. They stay on the board, where they block, until it ends.
+	dead Bitboard
+	// The Zobrist hash of the pieces, updated as they are placed, moved and crowned.
+	hash uint64
 }
 
 type Game struct {
-	Pieces map[Pos]Piece
-	Turn   Player
+	board
+	Turn Player
+	// Hashes of the positions reached since the last capture or man move, the current one last. A new game starts
+	// with its initial position.
+	History []uint64
+	Variant Variant
+	// The piece that has to continue capturing, NO_POS when the turn is not in the middle of a capture.
+	CapturingPiece Pos
+	// Shared by the copies of the game, which is fine as its keys hold the whole board.
+	memo captureMemo
 }
 
 func New() *Game {
-	pieces := make(map[Pos]Piece)
-	game := &Game{pieces, BLACK_PLAYER}
+	return NewWithVariant(DEFAULT_VARIANT)
+}
+
+func NewWithVariant(variant Variant) *Game {
+	game := emptyGame(variant)
 	game.addInitialPieces()
+	game.recordPosition(true)
 	return game
 }
 
+func emptyGame(variant Variant) *Game {
+	return &Game{
+		board:          board{geo: geometryOf(variant.BoardDim())},
+		Turn:           BLACK_PLAYER,
+		History:        []uint64{},
+		Variant:        variant,
+		CapturingPiece: NO_POS,
+	}
+}
+
 func (game *Game) addInitialPieces() {
-	for pos := range Usable {
-		if pos.Y >= 0 && pos.Y < 3 {
-			game.Pieces[pos] = Piece{BLACK_PLAYER, false}
+	dim := game.Variant.BoardDim()
+	rows := game.Variant.StartRows()
+	for square, pos := range game.geo.positions {
+		if pos.Y < rows {
+			game.put(square, Piece{BLACK_PLAYER, false})
 		}
-		if pos.Y >= BOARD_DIM-3 && pos.Y < BOARD_DIM {
-			game.Pieces[pos] = Piece{RED_PLAYER, false}
+		if pos.Y >= dim-rows {
+			game.put(square, Piece{RED_PLAYER, false})
 		}
 	}
 }
 
+func (game *Game) OnBoard(pos Pos) bool {
+	return game.geo.onBoard(pos)
+}
+
 func (game *Game) PieceAt(pos Pos) bool {
-	_, ok := game.Pieces[pos]
-	return ok
+	square := game.geo.square(pos)
+	return 0 <= square && game.occupied().Has(square)
+}
+
+// Pieces lists the pieces by position.
+func (game *Game) Pieces() map[Pos]Piece {
+	pieces := make(map[Pos]Piece, game.occupied().Count())
+	game.occupied().ForEach(func(square int) {
+		pieces[game.geo.positions[square]], _ = game.pieceOn(square)
+	})
+	return pieces
+}
+
+// SetPieces replaces all the pieces on the board. The pieces can only stand on the dark squares.
+func (game *Game) SetPieces(pieces map[Pos]Piece) error {
+	placed := board{geo: game.geo}
+	for pos, piece := range pieces {
+		square := game.geo.square(pos)
+		if square < 0 {
+			return errors.New(fmt.Sprintf("invalid board, piece not on a dark square: %v, %v", pos.X, pos.Y))
+		}
+		placed.put(square, piece)
+	}
+	game.board = placed
+	return nil
+}
+
+// DeadPieces lists the pieces captured so far in the capture under way, which are still on the board.
+func (game *Game) DeadPieces() []Pos {
+	dead := make([]Pos, 0, game.dead.Count())
+	game.dead.ForEach(func(square int) {
+		dead = append(dead, game.geo.positions[square])
+	})
+	return dead
+}
+
+// SetDeadPieces marks the pieces as captured in the capture under way. They have to be opponents of the player to move.
+func (game *Game) SetDeadPieces(positions []Pos) error {
+	opponents := game.own(Opponents[game.Turn])
+	dead := Bitboard(0)
+	for _, pos := range positions {
+		square := game.geo.square(pos)
+		if square < 0 || !opponents.Has(square) {
+			return errors.New(fmt.Sprintf("invalid dead piece, no opponent piece at: %v, %v", pos.X, pos.Y))
+		}
+		dead |= squareBit(square)
+	}
+	game.dead = dead
+	return nil
 }
 
 func (game *Game) TurnIs(player Player) bool {
@@ -147,128 +199,135 @@ func (game *Game) TurnIs(player Player) bool {
 }
 
 func (game *Game) Winner() Player {
-	red_count := 0
-	black_count := 0
-	for _, piece := range game.Pieces {
-		switch {
-		case piece.Player == BLACK_PLAYER:
-			black_count += 1
-		case piece.Player == RED_PLAYER:
-			red_count += 1
-		}
-	}
+	black_count := game.black.Count()
+	red_count := game.red.Count()
 	if black_count > 0 && red_count <= 0 {
 		return BLACK_PLAYER
 	} else if red_count > 0 && black_count <= 0 {
 		return RED_PLAYER
 	}
+	if !game.playerHasMove(game.Turn) {
+		// The side to move is blocked
+		return Opponents[game.Turn]
+	}
+	if game.IsRepetition() || game.IsNoProgress() {
+		return DRAW_PLAYER
+	}
 	return NO_PLAYER
 }
 
+// IsRepetition tells whether the current position has been reached REPETITION_LIMIT times.
+func (game *Game) IsRepetition() bool {
+	if len(game.History) == 0 {
+		return false
+	}
+	current := game.History[len(game.History)-1]
+	seen := 0
+	for _, hash := range game.History {
+		if hash == current {
+			seen++
+		}
+	}
+	return REPETITION_LIMIT <= seen
+}
+
+// IsNoProgress tells whether both players have made NO_PROGRESS_LIMIT moves without a capture or a man moving.
+func (game *Game) IsNoProgress() bool {
+	return 2*NO_PROGRESS_LIMIT < len(game.History)
+}
+
+func (game *Game) recordPosition(progress bool) {
+	if progress {
+		game.History = []uint64{}
+	}
+	game.History = append(game.History, game.Hash())
+}
+
+// squares finds the playable squares of src and dst, when src has a piece and dst is free.
+func (game *Game) squares(src, dst Pos) (srcSquare int, dstSquare int, ok bool) {
+	srcSquare, dstSquare = game.geo.square(src), game.geo.square(dst)
+	occupied := game.occupied()
+	ok = 0 <= srcSquare && 0 <= dstSquare && occupied.Has(srcSquare) && !occupied.Has(dstSquare)
+	return
+}
+
 func (game *Game) ValidMove(src, dst Pos) bool {
-	if !game.PieceAt(src) || game.PieceAt(dst) {
+	srcSquare, dstSquare, ok := game.squares(src, dst)
+	if !ok {
 		return false
 	}
-	piece := game.Pieces[src]
-	if (!piece.King && Moves[piece.Player][src][dst]) || (piece.King && KingMoves[src][dst]) {
+	if game.CapturingPiece == NO_POS && game.stepTo(srcSquare, dstSquare, game.Variant) {
+		piece, _ := game.pieceOn(srcSquare)
 		return !game.playerHasJump(piece.Player)
 	}
 	return game.ValidJump(src, dst)
 }
 
 func (game *Game) ValidJump(src, dst Pos) bool {
-	if !game.PieceAt(src) || game.PieceAt(dst) {
+	srcSquare, dstSquare, ok := game.squares(src, dst)
+	if !ok {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		capLoc, jumpOk := Jumps[piece.Player][src][dst]
-		return jumpOk && game.PieceAt(capLoc) && game.Pieces[capLoc].Player == Opponents[piece.Player]
-	} else {
-		capLoc, kingJumpOk := KingJumps[src][dst]
-		return kingJumpOk && game.PieceAt(capLoc) && game.Pieces[capLoc].Player == Opponents[piece.Player]
+	if game.CapturingPiece != NO_POS && game.CapturingPiece != src {
+		return false
 	}
-}
-
-func (game *Game) kingPiece(dst Pos) {
-	if !game.PieceAt(dst) {
-		return
+	over := game.jumpTo(srcSquare, dstSquare, game.Variant)
+	if over < 0 {
+		return false
 	}
-	piece := game.Pieces[dst]
-	if (dst.Y == 0 && piece.Player == RED_PLAYER) ||
-		(dst.Y == BOARD_DIM-1 && piece.Player == BLACK_PLAYER) {
-		piece.King = true
-		game.Pieces[dst] = piece
+	if game.Variant.MaximumCapture() {
+		piece, _ := game.pieceOn(srcSquare)
+		after := game.board
+		after.applyJump(srcSquare, dstSquare, over, game.Variant)
+		return 1+after.captureDepth(dstSquare, game.Variant, game.captureMemo()) == game.mostCaptures(piece.Player)
 	}
+	return true
 }
 
-func (game *Game) updateTurn(dst Pos, jumped bool) {
-	opponent := Opponents[game.Turn]
-	if (!jumped || !game.jumpPossibleFrom(dst)) && game.playerHasMove(opponent) {
-		game.Turn = opponent
+func (game *Game) mostCaptures(player Player) int {
+	memo := game.captureMemo()
+	if game.CapturingPiece != NO_POS {
+		return game.captureDepth(game.geo.square(game.CapturingPiece), game.Variant, memo)
 	}
+	most := 0
+	game.own(player).ForEach(func(square int) {
+		if depth := game.captureDepth(square, game.Variant, memo); most < depth {
+			most = depth
+		}
+	})
+	return most
 }
 
-func (game *Game) jumpPossibleFrom(src Pos) bool {
-	if !game.PieceAt(src) {
-		return false
-	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		// enumerate all player jumps and return true if one is valid
-		for dst := range Jumps[piece.Player][src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
-	} else {
-		// enumerate all king jumps and return true if one is valid
-		for dst := range KingJumps[src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
+func (game *Game) captureMemo() captureMemo {
+	if game.memo == nil {
+		game.memo = captureMemo{}
 	}
-	return false
+	return game.memo
 }
 
-func (game *Game) movePossibleFrom(src Pos) bool {
-	if !game.PieceAt(src) {
-		return false
-	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		for dst := range Moves[piece.Player][src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
-		}
+func (game *Game) updateTurn(dst int, jumped bool) {
+	if jumped && game.hasJump(dst, game.Variant) {
+		game.CapturingPiece = game.geo.positions[dst]
 	} else {
-		for dst := range KingMoves[src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
-		}
+		game.CapturingPiece = NO_POS
+		game.Turn = Opponents[game.Turn]
 	}
-	return false
 }
 
 func (game *Game) playerHasMove(player Player) bool {
-	for loc, piece := range game.Pieces {
-		if piece.Player == player && (game.movePossibleFrom(loc) || game.jumpPossibleFrom(loc)) {
-			return true
-		}
-	}
-	return false
+	found := false
+	game.own(player).ForEach(func(square int) {
+		found = found || game.hasStep(square) || game.hasJump(square, game.Variant)
+	})
+	return found
 }
 
 func (game *Game) playerHasJump(player Player) bool {
-	for loc, piece := range game.Pieces {
-		if piece.Player == player && game.jumpPossibleFrom(loc) {
-			return true
-		}
-	}
-	return false
+	found := false
+	game.own(player).ForEach(func(square int) {
+		found = found || game.hasJump(square, game.Variant)
+	})
+	return found
 }
 
 func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
@@ -280,47 +339,154 @@ func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
 	if game.PieceAt(dst) {
 		return NO_POS, errors.New(fmt.Sprintf("Already piece at destination position: %v", dst))
 	}
-	if !game.TurnIs(game.Pieces[src].Player) {
-		return NO_POS, errors.New(fmt.Sprintf("Not %v's turn", game.Pieces[src].Player))
+	piece, _ := game.pieceOn(game.geo.square(src))
+	if !game.TurnIs(piece.Player) {
+		return NO_POS, errors.New(fmt.Sprintf("Not %v's turn", piece.Player))
 	}
 	if !game.ValidMove(src, dst) {
 		return NO_POS, errors.New(fmt.Sprintf("Invalid move: %v to %v", src, dst))
 	}
-	if game.ValidJump(src, dst) {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
-		captured = Capture(src, dst)
-		delete(game.Pieces, captured)
+	captured = game.play(game.geo.square(src), game.geo.square(dst))
+	game.recordPosition(!piece.King || captured != NO_POS)
+	return
+}
+
+// play makes the already validated move and passes the turn when it is over, without recording the position.
+func (game *Game) play(src, dst int) (captured Pos) {
+	captured = NO_POS
+	if over := game.jumpTo(src, dst, game.Variant); 0 <= over {
+		captured = game.geo.positions[over]
+		game.applyJump(src, dst, over, game.Variant)
 	} else {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
+		game.move(src, dst)
 	}
 	game.updateTurn(dst, captured != NO_POS)
-	game.kingPiece(dst)
-	return
+	if game.CapturingPiece == NO_POS {
+		game.removeDead()
+		// A man passing the far row in the middle of a capture is only crowned if it stops there
+		game.crown(dst)
+	}
+	return captured
+}
+
+func (game *Game) Copy() *Game {
+	history := make([]uint64, len(game.History))
+	copy(history, game.History)
+	return &Game{
+		board:          game.board,
+		Turn:           game.Turn,
+		History:        history,
+		Variant:        game.Variant,
+		CapturingPiece: game.CapturingPiece,
+		memo:           game.memo,
+	}
+}
+
+// MoveChain plays the ordered positions as a single turn. Every hop after the first must be a capture made by
+// the same piece while the turn stays with its player. On error the game is left untouched.
+func (game *Game) MoveChain(positions []Pos) (captured []Pos, err error) {
+	if len(positions) < 2 {
+		return nil, errors.New(fmt.Sprintf("Move chain needs at least 2 positions, got %d", len(positions)))
+	}
+	working := game.Copy()
+	player := working.Turn
+	captured = make([]Pos, 0, len(positions)-1)
+	for i := 1; i < len(positions); i++ {
+		if 1 < i && (len(captured) < i-1 || !working.TurnIs(player)) {
+			return nil, errors.New(fmt.Sprintf("Cannot continue move chain from %v to %v", positions[i-1], positions[i]))
+		}
+		hopCaptured, err := working.Move(positions[i-1], positions[i])
+		if err != nil {
+			return nil, err
+		}
+		if hopCaptured != NO_POS {
+			captured = append(captured, hopCaptured)
+		} else if 1 < i {
+			return nil, errors.New(fmt.Sprintf("Move chain continues without capture: %v to %v", positions[i-1], positions[i]))
+		}
+	}
+	*game = *working
+	return captured, nil
+}
+
+type LegalMove struct {
+	Positions []Pos
+	Captured  []Pos
+}
+
+// LegalMoves lists every move the side to move can play, each capture chain played to its end. When a capture is
+// possible, only captures are listed.
+func (game *Game) LegalMoves() []LegalMove {
+	moves := []LegalMove{}
+	canStep := game.CapturingPiece == NO_POS && !game.playerHasJump(game.Turn)
+	game.own(game.Turn).ForEach(func(src int) {
+		srcPos := game.geo.positions[src]
+		if game.CapturingPiece != NO_POS && game.CapturingPiece != srcPos {
+			return
+		}
+		if canStep {
+			game.forEachStep(src, game.Variant, func(dst int) {
+				moves = append(moves, LegalMove{Positions: []Pos{srcPos, game.geo.positions[dst]}, Captured: []Pos{}})
+			})
+		}
+		moves = append(moves, game.captureChainsFrom(src, []Pos{srcPos}, []Pos{})...)
+	})
+	sort.Slice(moves, func(i, j int) bool {
+		return lessPositions(moves[i].Positions, moves[j].Positions)
+	})
+	return moves
+}
+
+func (game *Game) captureChainsFrom(src int, positions []Pos, captured []Pos) []LegalMove {
+	chains := []LegalMove{}
+	game.forEachJump(src, game.Variant, func(over int, dst int) {
+		dstPos := game.geo.positions[dst]
+		if !game.ValidJump(game.geo.positions[src], dstPos) {
+			return
+		}
+		// Only the board, the turn and the capturing piece matter to the rest of the chain
+		after := Game{board: game.board, Turn: game.Turn, Variant: game.Variant, CapturingPiece: game.CapturingPiece, memo: game.captureMemo()}
+		capLoc := after.play(src, dst)
+		chainPositions := append(append([]Pos{}, positions...), dstPos)
+		chainCaptured := append(append([]Pos{}, captured...), capLoc)
+		if after.CapturingPiece == dstPos {
+			chains = append(chains, after.captureChainsFrom(dst, chainPositions, chainCaptured)...)
+		} else {
+			chains = append(chains, LegalMove{Positions: chainPositions, Captured: chainCaptured})
+		}
+	})
+	return chains
+}
+
+func lessPositions(left []Pos, right []Pos) bool {
+	for i := 0; i < len(left) && i < len(right); i++ {
+		if left[i].Y != right[i].Y {
+			return left[i].Y < right[i].Y
+		}
+		if left[i].X != right[i].X {
+			return left[i].X < right[i].X
+		}
+	}
+	return len(left) < len(right)
 }
 
 func (game *Game) String() string {
-	var buf bytes.Buffer
-	for y := 0; y < BOARD_DIM; y++ {
-		for x := 0; x < BOARD_DIM; x++ {
-			pos := Pos{x, y}
-			if game.PieceAt(pos) {
-				piece := game.Pieces[pos]
-				val := PieceStrings[piece.Player]
-				if piece.King {
-					val = strings.ToUpper(val)
-				}
-				buf.WriteString(val)
+	dim := game.Variant.BoardDim()
+	occupied := game.occupied()
+	buf := make([]byte, 0, dim*dim+dim-1)
+	for y := 0; y < dim; y++ {
+		for x := 0; x < dim; x++ {
+			if square := game.geo.squareAt[y*dim+x]; 0 <= square && occupied.Has(square) {
+				buf = append(buf, game.pieceByte(square))
 			} else {
-				buf.WriteString(PieceStrings[NO_PLAYER])
+				buf = append(buf, emptyByte)
 			}
 		}
-		if y < (BOARD_DIM - 1) {
-			buf.WriteString(ROW_SEP)
+		if y < (dim - 1) {
+			buf = append(buf, ROW_SEP...)
 		}
 	}
-	return buf.String()
+	return string(buf)
 }
 
 func ParsePiece(s string) (Piece, bool) {
@@ -329,20 +495,28 @@ func ParsePiece(s string) (Piece, bool) {
 }
 
 func Parse(s string) (*Game, error) {
-	if len(s) != BOARD_DIM*BOARD_DIM+(BOARD_DIM-1) {
+	return ParseWithVariant(s, DEFAULT_VARIANT)
+}
+
+func ParseWithVariant(s string, variant Variant) (*Game, error) {
+	dim := variant.BoardDim()
+	if len(s) != dim*dim+(dim-1) {
 		return nil, errors.New(fmt.Sprintf("invalid board string: %v", s))
 	}
-	pieces := make(map[Pos]Piece)
-	result := &Game{pieces, BLACK_PLAYER}
+	result := emptyGame(variant)
 	for y, row := range strings.Split(s, ROW_SEP) {
 		for x, c := range strings.Split(row, "") {
-			if x >= BOARD_DIM || y >= BOARD_DIM {
+			if x >= dim || y >= dim {
 				return nil, errors.New(fmt.Sprintf("invalid board, piece out of bounds: %v, %v", x, y))
 			}
 			if piece, ok := ParsePiece(c); !ok {
 				return nil, errors.New(fmt.Sprintf("invalid board, invalid piece at %v, %v", x, y))
 			} else if piece != NO_PIECE {
-				result.Pieces[Pos{x, y}] = piece
+				square := result.geo.square(Pos{x, y})
+				if square < 0 {
+					return nil, errors.New(fmt.Sprintf("invalid board, piece not on a dark square: %v, %v", x, y))
+				}
+				result.put(square, piece)
 			}
 		}
 	}
@@ -0,0 +1,245 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitboardFitsPlayableSquares(t *testing.T) {
+	for _, variant := range []rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT} {
+		game := rules.NewWithVariant(variant)
+		require.Len(t, game.Pieces(), variant.BoardDim()*variant.StartRows())
+		parsed, err := rules.ParseWithVariant(game.String(), variant)
+		require.Nil(t, err)
+		require.Equal(t, game.String(), parsed.String())
+	}
+}
+
+func TestParsePieceOnLightSquare(t *testing.T) {
+	game, err := rules.Parse("b*b*b*b*|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*")
+	require.Nil(t, game)
+	require.EqualError(t, err, "invalid board, piece not on a dark square: 0, 0")
+}
+
+func TestSetPieces(t *testing.T) {
+	game := rules.New()
+	require.Nil(t, game.SetPieces(map[rules.Pos]rules.Piece{
+		{X: 1, Y: 0}: {Player: rules.BLACK_PLAYER, King: true},
+		{X: 2, Y: 7}: {Player: rules.RED_PLAYER},
+	}))
+	require.Equal(t, "*B******|********|********|********|********|********|********|**r*****", game.String())
+	require.EqualError(t,
+		game.SetPieces(map[rules.Pos]rules.Piece{{X: 0, Y: 0}: {Player: rules.RED_PLAYER}}),
+		"invalid board, piece not on a dark square: 0, 0")
+}
+
+func TestHashStable(t *testing.T) {
+	require.EqualValues(t, uint64(0xc61892635b602615), rules.New().Hash())
+	require.EqualValues(t, uint64(0x9fcf540b0a02d65), rules.NewWithVariant(rules.INTERNATIONAL_VARIANT).Hash())
+}
+
+func TestHashIncrementalMatchesParsed(t *testing.T) {
+	for _, variant := range []rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT, rules.RUSSIAN_VARIANT} {
+		game := rules.NewWithVariant(variant)
+		for game.Winner() == rules.NO_PLAYER {
+			move := game.LegalMoves()[len(game.LegalMoves())-1]
+			for hop := 1; hop < len(move.Positions); hop++ {
+				_, err := game.Move(move.Positions[hop-1], move.Positions[hop])
+				require.Nil(t, err)
+				parsed, err := rules.ParseWithVariant(game.String(), variant)
+				require.Nil(t, err)
+				parsed.Turn = game.Turn
+				parsed.CapturingPiece = game.CapturingPiece
+				require.Nil(t, parsed.SetDeadPieces(game.DeadPieces()))
+				require.Equal(t, parsed.Hash(), game.Hash(), "%s after %v", variant.Name(), move.Positions[:hop+1])
+			}
+		}
+	}
+}
+
+func TestHashTellsTurnApart(t *testing.T) {
+	game := rules.New()
+	_, err := game.Move(rules.Pos{X: 1, Y: 2}, rules.Pos{X: 2, Y: 3})
+	require.Nil(t, err)
+	parsed, err := rules.Parse(game.String())
+	require.Nil(t, err)
+	require.NotEqual(t, parsed.Hash(), game.Hash())
+	parsed.Turn = rules.RED_PLAYER
+	require.Equal(t, parsed.Hash(), game.Hash())
+}
+
+func TestPackRoundTrip(t *testing.T) {
+	for _, variant := range []rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT, rules.RUSSIAN_VARIANT} {
+		game := rules.NewWithVariant(variant)
+		playFirstMoves(t, game, 40)
+		unpacked, err := rules.UnpackWithVariant(game.Pack(), variant)
+		require.Nil(t, err)
+		require.Equal(t, game.String(), unpacked.String())
+		unpacked.Turn = game.Turn
+		unpacked.CapturingPiece = game.CapturingPiece
+		require.Equal(t, game.Hash(), unpacked.Hash())
+	}
+}
+
+func TestPackLength(t *testing.T) {
+	require.Len(t, rules.New().Pack(), 12)
+	require.Len(t, rules.NewWithVariant(rules.INTERNATIONAL_VARIANT).Pack(), 20)
+}
+
+func TestPackKings(t *testing.T) {
+	game, err := rules.Parse("*B******|********|********|********|********|********|********|**r*****")
+	require.Nil(t, err)
+	require.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0x08, 1, 0, 0, 0}, game.Pack())
+	unpacked, err := rules.Unpack(game.Pack())
+	require.Nil(t, err)
+	require.Equal(t, game.String(), unpacked.String())
+}
+
+func TestUnpackInvalid(t *testing.T) {
+	_, err := rules.Unpack([]byte{0, 0, 0})
+	require.EqualError(t, err, "invalid packed board length: 3")
+	_, err = rules.Unpack([]byte{3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+	require.EqualError(t, err, "invalid packed board, invalid piece at 1, 0")
+	_, err = rules.Unpack([]byte{0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0})
+	require.EqualError(t, err, "invalid packed board, king without a piece at 1, 0")
+}
+
+// playFirstMoves plays the first legal move, in LegalMoves order, for at most plies turns.
+func playFirstMoves(tb testing.TB, game *rules.Game, plies int) []rules.LegalMove {
+	played := []rules.LegalMove{}
+	for i := 0; i < plies && game.Winner() == rules.NO_PLAYER; i++ {
+		move := game.LegalMoves()[0]
+		if _, err := game.MoveChain(move.Positions); err != nil {
+			tb.Fatal(err)
+		}
+		played = append(played, move)
+	}
+	return played
+}
+
+func benchmarkVariants(b *testing.B, run func(b *testing.B, variant rules.Variant)) {
+	for _, variant := range []rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT, rules.RUSSIAN_VARIANT} {
+		b.Run(variant.Name(), func(b *testing.B) {
+			run(b, variant)
+		})
+	}
+}
+
+func BenchmarkMove(b *testing.B) {
+	benchmarkVariants(b, func(b *testing.B, variant rules.Variant) {
+		moves := playFirstMoves(b, rules.NewWithVariant(variant), 30)
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			game := rules.NewWithVariant(variant)
+			for _, move := range moves {
+				for hop := 1; hop < len(move.Positions); hop++ {
+					if _, err := game.Move(move.Positions[hop-1], move.Positions[hop]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		}
+	})
+}
+
+func BenchmarkLegalMoves(b *testing.B) {
+	benchmarkVariants(b, func(b *testing.B, variant rules.Variant) {
+		game := rules.NewWithVariant(variant)
+		playFirstMoves(b, game, 10)
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			game.LegalMoves()
+		}
+	})
+}
+
+func BenchmarkWinner(b *testing.B) {
+	benchmarkVariants(b, func(b *testing.B, variant rules.Variant) {
+		game := rules.NewWithVariant(variant)
+		playFirstMoves(b, game, 10)
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			game.Winner()
+		}
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	benchmarkVariants(b, func(b *testing.B, variant rules.Variant) {
+		board := rules.NewWithVariant(variant).String()
+		b.ResetTimer()
+		for n := 0; n < b.N; n++ {
+			if _, err := rules.ParseWithVariant(board, variant); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestMaxChainPositionsIsInternational(t *testing.T) {
+	require.Equal(t, 21, rules.MaxChainPositions())
+}
+
+func TestNewGameHistoryHasStartPosition(t *testing.T) {
+	game := rules.New()
+	require.Equal(t, []uint64{game.Hash()}, game.History)
+}
+
+func TestManMoveRestartsHistory(t *testing.T) {
+	game := rules.New()
+	_, err := game.Move(rules.Pos{X: 1, Y: 2}, rules.Pos{X: 2, Y: 3})
+	require.Nil(t, err)
+	require.Equal(t, []uint64{game.Hash()}, game.History)
+}
+
+func TestInternationalKingCannotJumpDeadPieceAgain(t *testing.T) {
+	game, err := rules.ParseWithVariant("**********|****r*****|**********|**r*r*****|*r********|"+
+		"r*********|*******B**|********r*|*r********|**r*******", rules.INTERNATIONAL_VARIANT)
+	require.Nil(t, err)
+	// Once it took 8,7, the king would have to fly back over it to go on to 4,3, so taking it is a single capture
+	// and falls short of the maximum
+	_, err = game.MoveChain([]rules.Pos{{X: 7, Y: 6}, {X: 9, Y: 8}, {X: 3, Y: 2}})
+	require.EqualError(t, err, "Invalid move: {7 6} to {9 8}")
+	require.Equal(t, []rules.LegalMove{{
+		Positions: []rules.Pos{{X: 7, Y: 6}, {X: 3, Y: 2}, {X: 5, Y: 0}},
+		Captured:  []rules.Pos{{X: 4, Y: 3}, {X: 4, Y: 1}},
+	}}, game.LegalMoves())
+}
+
+func TestRussianDeadPieceBlocksTheKing(t *testing.T) {
+	game, err := rules.ParseWithVariant("*r***r**|**r***r*|********|r*r*****|***B****|********|*r*r****|********",
+		rules.RUSSIAN_VARIANT)
+	require.Nil(t, err)
+	_, err = game.Move(rules.Pos{X: 3, Y: 4}, rules.Pos{X: 7, Y: 0})
+	require.Nil(t, err)
+	require.Equal(t, rules.NO_POS, game.CapturingPiece)
+	require.Equal(t, rules.RED_PLAYER, game.Turn)
+	require.Empty(t, game.DeadPieces())
+	require.Equal(t, "*r***r*B|**r*****|********|r*r*****|********|********|*r*r****|********", game.String())
+}
+
+func TestDeadPiecesStayUntilTheCaptureEnds(t *testing.T) {
+	game, err := rules.ParseWithVariant("*r***r**|**r***r*|********|r*r*****|***B****|********|*r*r****|********",
+		rules.RUSSIAN_VARIANT)
+	require.Nil(t, err)
+	captured, err := game.Move(rules.Pos{X: 3, Y: 4}, rules.Pos{X: 1, Y: 2})
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 2, Y: 3}, captured)
+	require.Equal(t, rules.Pos{X: 1, Y: 2}, game.CapturingPiece)
+	require.Equal(t, []rules.Pos{{X: 2, Y: 3}}, game.DeadPieces())
+	require.Equal(t, "*r***r**|**r***r*|*B******|r*r*****|********|********|*r*r****|********", game.String())
+	_, err = game.Move(rules.Pos{X: 1, Y: 2}, rules.Pos{X: 3, Y: 0})
+	require.Nil(t, err)
+	require.Equal(t, rules.NO_POS, game.CapturingPiece)
+	require.Empty(t, game.DeadPieces())
+	require.Equal(t, "*r*B*r**|******r*|********|r*******|********|********|*r*r****|********", game.String())
+}
+
+func TestSetDeadPiecesNeedsOpponents(t *testing.T) {
+	game := rules.New()
+	require.EqualError(t, game.SetDeadPieces([]rules.Pos{{X: 1, Y: 0}}), "invalid dead piece, no opponent piece at: 1, 0")
+	require.Nil(t, game.SetDeadPieces([]rules.Pos{{X: 0, Y: 5}}))
+	require.Equal(t, []rules.Pos{{X: 0, Y: 5}}, game.DeadPieces())
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The 2-bit codes of the squares in a packed board.
+const (
+	packedEmpty = 0
+	packedBlack = 1
+	packedRed   = 2
+)
+
+// packedLengths are the byte lengths of the squares part and of the kings part of a packed board.
+func (geo *geometry) packedLengths() (squares int, kings int) {
+	return (2*geo.squares + 7) / 8, (geo.squares + 7) / 8
+}
+
+// Pack encodes the pieces with 2 bits per dark square, in square order, followed by the kings mask in little-endian
+// order. It does not encode the turn.
+func (game *Game) Pack() []byte {
+	squaresLength, kingsLength := game.geo.packedLengths()
+	packed := make([]byte, squaresLength+kingsLength)
+	game.black.ForEach(func(square int) {
+		packed[square/4] |= packedBlack << uint(2*(square%4))
+	})
+	game.red.ForEach(func(square int) {
+		packed[square/4] |= packedRed << uint(2*(square%4))
+	})
+	for i := 0; i < kingsLength; i++ {
+		packed[squaresLength+i] = byte(game.kings >> uint(8*i))
+	}
+	return packed
+}
+
+func Unpack(packed []byte) (*Game, error) {
+	return UnpackWithVariant(packed, DEFAULT_VARIANT)
+}
+
+func UnpackWithVariant(packed []byte, variant Variant) (*Game, error) {
+	result := emptyGame(variant)
+	squaresLength, kingsLength := result.geo.packedLengths()
+	if len(packed) != squaresLength+kingsLength {
+		return nil, errors.New(fmt.Sprintf("invalid packed board length: %v", len(packed)))
+	}
+	var kings Bitboard
+	for i, b := range packed[squaresLength:] {
+		kings |= Bitboard(b) << uint(8*i)
+	}
+	for square := 0; square < 4*squaresLength; square++ {
+		code := packed[square/4] >> uint(2*(square%4)) & 3
+		if result.geo.squares <= square {
+			if code != packedEmpty {
+				return nil, errors.New(fmt.Sprintf("invalid packed board, piece past the last square: %v", square))
+			}
+			continue
+		}
+		pos := result.geo.positions[square]
+		king := kings.Has(square)
+		switch code {
+		case packedEmpty:
+			if king {
+				return nil, errors.New(fmt.Sprintf("invalid packed board, king without a piece at %v, %v", pos.X, pos.Y))
+			}
+		case packedBlack:
+			result.put(square, Piece{BLACK_PLAYER, king})
+		case packedRed:
+			result.put(square, Piece{RED_PLAYER, king})
+		default:
+			return nil, errors.New(fmt.Sprintf("invalid packed board, invalid piece at %v, %v", pos.X, pos.Y))
+		}
+	}
+	if kings>>uint(result.geo.squares) != 0 {
+		return nil, errors.New("invalid packed board, king past the last square")
+	}
+	return result, nil
+}
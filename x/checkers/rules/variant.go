@@ -0,0 +1,123 @@
+package rules
+
+const (
+	AMERICAN      = "american"
+	INTERNATIONAL = "international"
+	RUSSIAN       = "russian"
+	POOL          = "pool"
+)
+
+// Variant describes the rules that differ between the supported checkers games.
+type Variant interface {
+	Name() string
+	BoardDim() int
+	// How many rows of men each player starts with.
+	StartRows() int
+	MenCaptureBackward() bool
+	// Whether kings move and capture any distance along a diagonal.
+	FlyingKings() bool
+	// Whether a player has to pick the capture sequence that takes the most pieces.
+	MaximumCapture() bool
+	// Whether a man reaching the far row during a capture is crowned at once and continues as a king.
+	PromoteMidCapture() bool
+}
+
+type ruleSet struct {
+	name               string
+	boardDim           int
+	startRows          int
+	menCaptureBackward bool
+	flyingKings        bool
+	maximumCapture     bool
+	promoteMidCapture  bool
+}
+
+func (set ruleSet) Name() string {
+	return set.name
+}
+
+func (set ruleSet) BoardDim() int {
+	return set.boardDim
+}
+
+func (set ruleSet) StartRows() int {
+	return set.startRows
+}
+
+func (set ruleSet) MenCaptureBackward() bool {
+	return set.menCaptureBackward
+}
+
+func (set ruleSet) FlyingKings() bool {
+	return set.flyingKings
+}
+
+func (set ruleSet) MaximumCapture() bool {
+	return set.maximumCapture
+}
+
+func (set ruleSet) PromoteMidCapture() bool {
+	return set.promoteMidCapture
+}
+
+var AMERICAN_VARIANT Variant = ruleSet{
+	name:      AMERICAN,
+	boardDim:  BOARD_DIM,
+	startRows: 3,
+}
+
+var INTERNATIONAL_VARIANT Variant = ruleSet{
+	name:               INTERNATIONAL,
+	boardDim:           10,
+	startRows:          4,
+	menCaptureBackward: true,
+	flyingKings:        true,
+	maximumCapture:     true,
+}
+
+var RUSSIAN_VARIANT Variant = ruleSet{
+	name:               RUSSIAN,
+	boardDim:           BOARD_DIM,
+	startRows:          3,
+	menCaptureBackward: true,
+	flyingKings:        true,
+	promoteMidCapture:  true,
+}
+
+var POOL_VARIANT Variant = ruleSet{
+	name:               POOL,
+	boardDim:           BOARD_DIM,
+	startRows:          3,
+	menCaptureBackward: true,
+	flyingKings:        true,
+}
+
+var DEFAULT_VARIANT = AMERICAN_VARIANT
+
+var Variants = map[string]Variant{
+	AMERICAN:      AMERICAN_VARIANT,
+	INTERNATIONAL: INTERNATIONAL_VARIANT,
+	RUSSIAN:       RUSSIAN_VARIANT,
+	POOL:          POOL_VARIANT,
+}
+
+// ParseVariant finds the variant by name, the empty name being the default variant.
+func ParseVariant(name string) (Variant, bool) {
+	if name == "" {
+		return DEFAULT_VARIANT, true
+	}
+	variant, ok := Variants[name]
+	return variant, ok
+}
+
+// MaxChainPositions is the most positions a move can have in any variant: the start square, then a landing square
+// per piece captured, and the opponent cannot have more pieces than it started with.
+func MaxChainPositions() int {
+	most := 0
+	for _, variant := range Variants {
+		if positions := 1 + variant.StartRows()*variant.BoardDim()/2; most < positions {
+			most = positions
+		}
+	}
+	return most
+}
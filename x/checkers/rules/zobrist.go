@@ -0,0 +1,69 @@
+package rules
+
+// The seed of the Zobrist keys. The keys, and so every position hash recorded on chain, depend on it alone, so it
+// must never change.
+const ZOBRIST_SEED uint64 = 0x636865636b657273 // "checkers"
+
+var (
+	// The key of each piece, by side, then king, then square.
+	zobristPieces [2][2][64]uint64
+	// Mixed in when red is to move.
+	zobristRedToMove uint64
+	// Mixed in for the square of the piece that has to continue capturing.
+	zobristCapturing [64]uint64
+	// Mixed in for the square of each piece captured in the capture under way.
+	zobristDead [64]uint64
+)
+
+func init() {
+	state := ZOBRIST_SEED
+	for side := range zobristPieces {
+		for king := range zobristPieces[side] {
+			for square := range zobristPieces[side][king] {
+				zobristPieces[side][king][square] = splitMix64(&state)
+			}
+		}
+	}
+	zobristRedToMove = splitMix64(&state)
+	for square := range zobristCapturing {
+		zobristCapturing[square] = splitMix64(&state)
+	}
+	for square := range zobristDead {
+		zobristDead[square] = splitMix64(&state)
+	}
+}
+
+// splitMix64 is a fixed pseudo-random generator, so that the keys are the same on every node and Go version.
+func splitMix64(state *uint64) uint64 {
+	*state += 0x9e3779b97f4a7c15
+	z := *state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func zobristPiece(piece Piece, square int) uint64 {
+	king := 0
+	if piece.King {
+		king = 1
+	}
+	return zobristPieces[sideOf(piece.Player)][king][square]
+}
+
+// Hash is the Zobrist hash of the position: the pieces, the side to move, the piece that has to continue capturing
+// and the pieces it already captured. The pieces part is kept up to date as they move.
+func (game *Game) Hash() uint64 {
+	hash := game.hash
+	if game.Turn == RED_PLAYER {
+		hash ^= zobristRedToMove
+	}
+	if game.CapturingPiece != NO_POS {
+		if square := game.geo.square(game.CapturingPiece); 0 <= square {
+			hash ^= zobristCapturing[square]
+		}
+	}
+	game.dead.ForEach(func(square int) {
+		hash ^= zobristDead[square]
+	})
+	return hash
+}
@@ -5,12 +5,14 @@ import (
 
 	"github.com/b9lab/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 )
 
 func (escrow *MockBankEscrowKeeper) ExpectAny(context context.Context) {
 	escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 	escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	escrow.EXPECT().SendCoinsFromModuleToModule(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 }
 
 func coinsOf(amount uint64, denom string) sdk.Coins {
@@ -45,3 +47,41 @@ func (escrow *MockBankEscrowKeeper) ExpectRefundWithDenom(context context.Contex
 	}
 	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.ModuleName, whoAddr, coinsOf(amount, denom))
 }
+
+func (escrow *MockBankEscrowKeeper) ExpectFee(context context.Context, amount uint64) *gomock.Call {
+	return escrow.EXPECT().SendCoinsFromModuleToModule(sdk.UnwrapSDKContext(context), types.ModuleName, authtypes.FeeCollectorName, coinsOf(amount, "stake"))
+}
+
+// ExpectSpendableAny lets any player afford any wager that the tests use.
+func (escrow *MockBankEscrowKeeper) ExpectSpendableAny(context context.Context) {
+	escrow.EXPECT().SpendableCoins(sdk.UnwrapSDKContext(context), gomock.Any()).
+		Return(sdk.NewCoins(
+			sdk.NewInt64Coin("coin", 1_000_000),
+			sdk.NewInt64Coin("gold", 1_000_000),
+			sdk.NewInt64Coin("stake", 1_000_000))).
+		AnyTimes()
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectSpendable(context context.Context, who string, spendable sdk.Coins) *gomock.Call {
+	whoAddr, err := sdk.AccAddressFromBech32(who)
+	if err != nil {
+		panic(err)
+	}
+	return escrow.EXPECT().SpendableCoins(sdk.UnwrapSDKContext(context), whoAddr).Return(spendable)
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectBet(context context.Context, who string, amount sdk.Coins) *gomock.Call {
+	whoAddr, err := sdk.AccAddressFromBech32(who)
+	if err != nil {
+		panic(err)
+	}
+	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), whoAddr, types.BetEscrowName, amount)
+}
+
+func (escrow *MockBankEscrowKeeper) ExpectBetPayout(context context.Context, who string, amount sdk.Coins) *gomock.Call {
+	whoAddr, err := sdk.AccAddressFromBech32(who)
+	if err != nil {
+		panic(err)
+	}
+	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.BetEscrowName, whoAddr, amount)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/b9lab/checkers/x/checkers/rules"
 	"github.com/b9lab/checkers/x/checkers/types"
 	"github.com/stretchr/testify/require"
 )
@@ -82,3 +83,12 @@ func PlayAllMoves(t *testing.T, msgServer types.MsgServer, context context.Conte
 		require.Nil(t, err)
 	}
 }
+
+// PackBoard packs the board of an 8x8 variant as stored in StoredGame.
+func PackBoard(board string) []byte {
+	game, err := rules.Parse(board)
+	if err != nil {
+		panic(err)
+	}
+	return game.Pack()
+}
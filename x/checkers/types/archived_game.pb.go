@@ -0,0 +1,1032 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/archived_game.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ArchivedGame struct {
+	Index           string                                   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Black           string                                   `protobuf:"bytes,2,opt,name=black,proto3" json:"black,omitempty"`
+	Red             string                                   `protobuf:"bytes,3,opt,name=red,proto3" json:"red,omitempty"`
+	Wager           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=wager,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wager"`
+	Winner          string                                   `protobuf:"bytes,5,opt,name=winner,proto3" json:"winner,omitempty"`
+	Reason          string                                   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Board           string                                   `protobuf:"bytes,7,opt,name=board,proto3" json:"board,omitempty"`
+	MoveCount       uint64                                   `protobuf:"varint,8,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	EndHeight       int64                                    `protobuf:"varint,9,opt,name=endHeight,proto3" json:"endHeight,omitempty"`
+	Variant         string                                   `protobuf:"bytes,10,opt,name=variant,proto3" json:"variant,omitempty"`
+	TournamentIndex string                                   `protobuf:"bytes,11,opt,name=tournamentIndex,proto3" json:"tournamentIndex,omitempty"`
+	PreviousIndex   string                                   `protobuf:"bytes,12,opt,name=previousIndex,proto3" json:"previousIndex,omitempty"`
+	RematchProposer string                                   `protobuf:"bytes,13,opt,name=rematchProposer,proto3" json:"rematchProposer,omitempty"`
+	RematchIndex    string                                   `protobuf:"bytes,14,opt,name=rematchIndex,proto3" json:"rematchIndex,omitempty"`
+	TimeControl     TimeControl                              `protobuf:"bytes,15,opt,name=timeControl,proto3" json:"timeControl"`
+}
+
+func (m *ArchivedGame) Reset()         { *m = ArchivedGame{} }
+func (m *ArchivedGame) String() string { return proto.CompactTextString(m) }
+func (*ArchivedGame) ProtoMessage()    {}
+func (*ArchivedGame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d0cd01e4f963bc9, []int{0}
+}
+func (m *ArchivedGame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedGame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedGame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedGame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedGame.Merge(m, src)
+}
+func (m *ArchivedGame) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedGame) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedGame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedGame proto.InternalMessageInfo
+
+func (m *ArchivedGame) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetBlack() string {
+	if m != nil {
+		return m.Black
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetRed() string {
+	if m != nil {
+		return m.Red
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetWager() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Wager
+	}
+	return nil
+}
+
+func (m *ArchivedGame) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetBoard() string {
+	if m != nil {
+		return m.Board
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetMoveCount() uint64 {
+	if m != nil {
+		return m.MoveCount
+	}
+	return 0
+}
+
+func (m *ArchivedGame) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *ArchivedGame) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetTournamentIndex() string {
+	if m != nil {
+		return m.TournamentIndex
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetPreviousIndex() string {
+	if m != nil {
+		return m.PreviousIndex
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetRematchProposer() string {
+	if m != nil {
+		return m.RematchProposer
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetRematchIndex() string {
+	if m != nil {
+		return m.RematchIndex
+	}
+	return ""
+}
+
+func (m *ArchivedGame) GetTimeControl() TimeControl {
+	if m != nil {
+		return m.TimeControl
+	}
+	return TimeControl{}
+}
+
+func init() {
+	proto.RegisterType((*ArchivedGame)(nil), "b9lab.checkers.checkers.ArchivedGame")
+}
+
+func init() { proto.RegisterFile("checkers/archived_game.proto", fileDescriptor_7d0cd01e4f963bc9) }
+
+var fileDescriptor_7d0cd01e4f963bc9 = []byte{
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0x13, 0x31,
+	0x10, 0xc6, 0xb3, 0xe4, 0x4f, 0x89, 0x93, 0x52, 0x64, 0x55, 0x60, 0x4a, 0xb5, 0x8d, 0xaa, 0x1e,
+	0x56, 0x48, 0xec, 0xd2, 0x72, 0xe2, 0x48, 0x82, 0x04, 0x48, 0x1c, 0x50, 0xc4, 0x89, 0x4b, 0xe5,
+	0xdd, 0x1d, 0xed, 0x5a, 0xc9, 0x7a, 0x22, 0xdb, 0xd9, 0x96, 0xb7, 0xe0, 0x39, 0x78, 0x01, 0x5e,
+	0xa1, 0xc7, 0x1e, 0x39, 0x01, 0x4a, 0x5e, 0x04, 0xd9, 0xde, 0x26, 0x6d, 0x25, 0x4e, 0x99, 0xf9,
+	0xf9, 0xf3, 0xe7, 0x2f, 0x3b, 0x43, 0x0e, 0xb3, 0x12, 0xb2, 0x19, 0x28, 0x9d, 0x70, 0x95, 0x95,
+	0xa2, 0x86, 0xfc, 0xbc, 0xe0, 0x15, 0xc4, 0x0b, 0x85, 0x06, 0xe9, 0xd3, 0xf4, 0xcd, 0x9c, 0xa7,
+	0xf1, 0x8d, 0x66, 0x53, 0x1c, 0xec, 0x17, 0x58, 0xa0, 0xd3, 0x24, 0xb6, 0xf2, 0xf2, 0x83, 0x30,
+	0x43, 0x5d, 0xa1, 0x4e, 0x52, 0xae, 0x21, 0xa9, 0x4f, 0x53, 0x30, 0xfc, 0x34, 0xc9, 0x50, 0xc8,
+	0xe6, 0xfc, 0xf9, 0xe6, 0x31, 0x23, 0x2a, 0x38, 0xcf, 0x50, 0x1a, 0x85, 0x73, 0x7f, 0x78, 0xfc,
+	0xb3, 0x43, 0x86, 0x6f, 0x9b, 0x0c, 0xef, 0x79, 0x05, 0x74, 0x9f, 0x74, 0x85, 0xcc, 0xe1, 0x92,
+	0x05, 0xa3, 0x20, 0xea, 0x4f, 0x7d, 0x63, 0x69, 0x3a, 0xe7, 0xd9, 0x8c, 0x3d, 0xf0, 0xd4, 0x35,
+	0xf4, 0x31, 0x69, 0x2b, 0xc8, 0x59, 0xdb, 0x31, 0x5b, 0x52, 0x4e, 0xba, 0x17, 0xbc, 0x00, 0xc5,
+	0x3a, 0xa3, 0x76, 0x34, 0x38, 0x7b, 0x16, 0xfb, 0x6c, 0xb1, 0xcd, 0x16, 0x37, 0xd9, 0xe2, 0x09,
+	0x0a, 0x39, 0x7e, 0x75, 0xf5, 0xfb, 0xa8, 0xf5, 0xe3, 0xcf, 0x51, 0x54, 0x08, 0x53, 0x2e, 0xd3,
+	0x38, 0xc3, 0x2a, 0x69, 0xfe, 0x88, 0xff, 0x79, 0xa9, 0xf3, 0x59, 0x62, 0xbe, 0x2d, 0x40, 0xbb,
+	0x0b, 0x7a, 0xea, 0x9d, 0xe9, 0x13, 0xd2, 0xbb, 0x10, 0x52, 0x82, 0x62, 0x5d, 0xf7, 0x6e, 0xd3,
+	0x59, 0xae, 0x80, 0x6b, 0x94, 0xac, 0xe7, 0xb9, 0xef, 0x5c, 0x74, 0xe4, 0x2a, 0x67, 0x3b, 0x4d,
+	0x74, 0xdb, 0xd0, 0x43, 0xd2, 0xaf, 0xb0, 0x86, 0x09, 0x2e, 0xa5, 0x61, 0x0f, 0x47, 0x41, 0xd4,
+	0x99, 0x6e, 0x81, 0x3d, 0x05, 0x99, 0x7f, 0x00, 0x51, 0x94, 0x86, 0xf5, 0x47, 0x41, 0xd4, 0x9e,
+	0x6e, 0x01, 0x65, 0x64, 0xa7, 0xe6, 0x4a, 0x70, 0x69, 0x18, 0x71, 0x9e, 0x37, 0x2d, 0x8d, 0xc8,
+	0x9e, 0xc1, 0xa5, 0x92, 0xbc, 0x02, 0x69, 0x3e, 0xba, 0xcf, 0x38, 0x70, 0x8a, 0xfb, 0x98, 0x9e,
+	0x90, 0xdd, 0x85, 0x82, 0x5a, 0xe0, 0x52, 0x7b, 0xdd, 0xd0, 0xe9, 0xee, 0x42, 0xeb, 0xa7, 0xa0,
+	0xe2, 0x26, 0x2b, 0x3f, 0x2b, 0x5c, 0xa0, 0x06, 0xc5, 0x76, 0xbd, 0xdf, 0x3d, 0x4c, 0x8f, 0xc9,
+	0xb0, 0x41, 0xde, 0xee, 0x91, 0x93, 0xdd, 0x61, 0xf4, 0x13, 0x19, 0xd8, 0x0d, 0x98, 0xf8, 0x05,
+	0x60, 0x7b, 0xa3, 0x20, 0x1a, 0x9c, 0x9d, 0xc4, 0xff, 0xd9, 0xb6, 0xf8, 0xcb, 0x56, 0x3b, 0xee,
+	0xd8, 0x69, 0x4d, 0x6f, 0x5f, 0x1f, 0xbf, 0xbb, 0x5a, 0x85, 0xc1, 0xf5, 0x2a, 0x0c, 0xfe, 0xae,
+	0xc2, 0xe0, 0xfb, 0x3a, 0x6c, 0x5d, 0xaf, 0xc3, 0xd6, 0xaf, 0x75, 0xd8, 0xfa, 0xfa, 0xe2, 0xd6,
+	0x48, 0x9d, 0x79, 0xb2, 0xd9, 0xc0, 0xcb, 0x6d, 0xe9, 0x46, 0x9b, 0xf6, 0xdc, 0x1a, 0xbe, 0xfe,
+	0x37, 0x00, 0xab, 0x82, 0x9f, 0x4a, 0x12, 0x03, 0x00, 0x00,
+}
+
+func (m *ArchivedGame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedGame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TimeControl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintArchivedGame(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.RematchIndex) > 0 {
+		i -= len(m.RematchIndex)
+		copy(dAtA[i:], m.RematchIndex)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.RematchIndex)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RematchProposer) > 0 {
+		i -= len(m.RematchProposer)
+		copy(dAtA[i:], m.RematchProposer)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.RematchProposer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PreviousIndex) > 0 {
+		i -= len(m.PreviousIndex)
+		copy(dAtA[i:], m.PreviousIndex)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.PreviousIndex)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.TournamentIndex) > 0 {
+		i -= len(m.TournamentIndex)
+		copy(dAtA[i:], m.TournamentIndex)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.TournamentIndex)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintArchivedGame(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MoveCount != 0 {
+		i = encodeVarintArchivedGame(dAtA, i, uint64(m.MoveCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Board) > 0 {
+		i -= len(m.Board)
+		copy(dAtA[i:], m.Board)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.Board)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Wager) > 0 {
+		for iNdEx := len(m.Wager) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wager[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintArchivedGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Red) > 0 {
+		i -= len(m.Red)
+		copy(dAtA[i:], m.Red)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.Red)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Black) > 0 {
+		i -= len(m.Black)
+		copy(dAtA[i:], m.Black)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.Black)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintArchivedGame(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintArchivedGame(dAtA []byte, offset int, v uint64) int {
+	offset -= sovArchivedGame(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ArchivedGame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = len(m.Black)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = len(m.Red)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	if len(m.Wager) > 0 {
+		for _, e := range m.Wager {
+			l = e.Size()
+			n += 1 + l + sovArchivedGame(uint64(l))
+		}
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = len(m.Board)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	if m.MoveCount != 0 {
+		n += 1 + sovArchivedGame(uint64(m.MoveCount))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovArchivedGame(uint64(m.EndHeight))
+	}
+	l = len(m.Variant)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = len(m.TournamentIndex)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = len(m.PreviousIndex)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = len(m.RematchProposer)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = len(m.RematchIndex)
+	if l > 0 {
+		n += 1 + l + sovArchivedGame(uint64(l))
+	}
+	l = m.TimeControl.Size()
+	n += 1 + l + sovArchivedGame(uint64(l))
+	return n
+}
+
+func sovArchivedGame(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozArchivedGame(x uint64) (n int) {
+	return sovArchivedGame(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ArchivedGame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowArchivedGame
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedGame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedGame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Black", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Black = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Red", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Red = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wager = append(m.Wager, types.Coin{})
+			if err := m.Wager[len(m.Wager)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Board", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Board = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveCount", wireType)
+			}
+			m.MoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RematchProposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RematchProposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RematchIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RematchIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipArchivedGame(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthArchivedGame
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipArchivedGame(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowArchivedGame
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowArchivedGame
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthArchivedGame
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupArchivedGame
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthArchivedGame
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthArchivedGame        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowArchivedGame          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupArchivedGame = fmt.Errorf("proto: unexpected end of group")
+)
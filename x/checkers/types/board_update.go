@@ -0,0 +1,98 @@
+package types
+
+// NewBoardUpdateFromStoredGame sends the game as it stands, without the last move.
+func NewBoardUpdateFromStoredGame(storedGame StoredGame, height int64) (update BoardUpdate, err error) {
+	board, err := storedGame.FormatBoard()
+	if err != nil {
+		return BoardUpdate{}, err
+	}
+	return BoardUpdate{
+		Kind:         BoardUpdateKindSnapshot,
+		GameIndex:    storedGame.Index,
+		Black:        storedGame.Black,
+		Red:          storedGame.Red,
+		Board:        board,
+		Turn:         storedGame.Turn,
+		Deadline:     storedGame.Deadline,
+		Winner:       storedGame.Winner,
+		PositionHash: storedGame.PositionHash,
+		Height:       height,
+	}, nil
+}
+
+func NewBoardUpdateFromMove(event EventMovePlayed, height int64) BoardUpdate {
+	return BoardUpdate{
+		Kind:         BoardUpdateKindMove,
+		GameIndex:    event.GameIndex,
+		Black:        event.Black,
+		Red:          event.Red,
+		Positions:    event.Positions,
+		Captured:     event.Captured,
+		Board:        event.Board,
+		Turn:         event.Turn,
+		Deadline:     event.Deadline,
+		Winner:       event.Winner,
+		PositionHash: event.PositionHash,
+		Height:       height,
+	}
+}
+
+// NewBoardUpdateFromForfeit sends the last board of a game that ran out of time. There is no turn or deadline left.
+func NewBoardUpdateFromForfeit(event EventGameForfeited, height int64) BoardUpdate {
+	return BoardUpdate{
+		Kind:      BoardUpdateKindForfeit,
+		GameIndex: event.GameIndex,
+		Black:     event.Black,
+		Red:       event.Red,
+		Board:     event.Board,
+		Winner:    event.Winner,
+		Height:    height,
+	}
+}
+
+// NewBoardUpdateFromResign sends the last board of a game that a player resigned.
+func NewBoardUpdateFromResign(event EventGameResigned, height int64) BoardUpdate {
+	return BoardUpdate{
+		Kind:      BoardUpdateKindResign,
+		GameIndex: event.GameIndex,
+		Black:     event.Black,
+		Red:       event.Red,
+		Board:     event.Board,
+		Winner:    event.Winner,
+		Height:    height,
+	}
+}
+
+// NewBoardUpdateFromDraw sends the last board of a game that the players agreed to draw.
+func NewBoardUpdateFromDraw(event EventGameDrawn, height int64) BoardUpdate {
+	return BoardUpdate{
+		Kind:      BoardUpdateKindDraw,
+		GameIndex: event.GameIndex,
+		Black:     event.Black,
+		Red:       event.Red,
+		Board:     event.Board,
+		Winner:    event.Winner,
+		Height:    height,
+	}
+}
+
+// NewBoardUpdateFromReject sends the last board of a game that a player rejected, which ends without a winner.
+func NewBoardUpdateFromReject(event EventGameRejected, height int64) BoardUpdate {
+	return BoardUpdate{
+		Kind:      BoardUpdateKindReject,
+		GameIndex: event.GameIndex,
+		Black:     event.Black,
+		Red:       event.Red,
+		Board:     event.Board,
+		Winner:    event.Winner,
+		Height:    height,
+	}
+}
+
+// Watches tells whether the update is about the watched game, or about a game of the watched player.
+func (request QueryWatchRequest) Watches(update BoardUpdate) bool {
+	if request.GameIndex != "" {
+		return update.GameIndex == request.GameIndex
+	}
+	return request.Player != "" && (update.Black == request.Player || update.Red == request.Player)
+}
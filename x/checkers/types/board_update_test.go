@@ -0,0 +1,159 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoardUpdateFromStoredGame(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.PositionHash = 14274319974383232533
+	update, err := types.NewBoardUpdateFromStoredGame(storedGame, 12)
+	require.Nil(t, err)
+	require.EqualValues(t, types.BoardUpdate{
+		Kind:         types.BoardUpdateKindSnapshot,
+		GameIndex:    "1",
+		Black:        alice,
+		Red:          bob,
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Deadline:     types.DeadlineLayout,
+		Winner:       "*",
+		PositionHash: 14274319974383232533,
+		Height:       12,
+	}, update)
+}
+
+func TestBoardUpdateFromStoredGameCorrupt(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.PackedBoard = storedGame.PackedBoard[:8]
+	_, err := types.NewBoardUpdateFromStoredGame(storedGame, 12)
+	require.EqualError(t, err, "game cannot be parsed: invalid packed board length: 8")
+}
+
+func TestBoardUpdateFromMove(t *testing.T) {
+	update := types.NewBoardUpdateFromMove(types.EventMovePlayed{
+		Creator:      alice,
+		GameIndex:    "1",
+		Positions:    []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+		Winner:       "*",
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		PositionHash: 928273061913851149,
+		Black:        alice,
+		Red:          bob,
+		Turn:         "r",
+		Deadline:     types.DeadlineLayout,
+	}, 13)
+	require.EqualValues(t, types.BoardUpdate{
+		Kind:         types.BoardUpdateKindMove,
+		GameIndex:    "1",
+		Black:        alice,
+		Red:          bob,
+		Positions:    []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+		Board:        "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "r",
+		Deadline:     types.DeadlineLayout,
+		Winner:       "*",
+		PositionHash: 928273061913851149,
+		Height:       13,
+	}, update)
+}
+
+func TestBoardUpdateFromForfeit(t *testing.T) {
+	update := types.NewBoardUpdateFromForfeit(types.EventGameForfeited{
+		GameIndex: "1",
+		Winner:    "r",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Black:     alice,
+		Red:       bob,
+	}, 14)
+	require.EqualValues(t, types.BoardUpdate{
+		Kind:      types.BoardUpdateKindForfeit,
+		GameIndex: "1",
+		Black:     alice,
+		Red:       bob,
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Winner:    "r",
+		Height:    14,
+	}, update)
+}
+
+func TestBoardUpdateFromResign(t *testing.T) {
+	update := types.NewBoardUpdateFromResign(types.EventGameResigned{
+		Creator:   alice,
+		GameIndex: "1",
+		Winner:    "r",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Black:     alice,
+		Red:       bob,
+	}, 15)
+	require.EqualValues(t, types.BoardUpdate{
+		Kind:      types.BoardUpdateKindResign,
+		GameIndex: "1",
+		Black:     alice,
+		Red:       bob,
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Winner:    "r",
+		Height:    15,
+	}, update)
+}
+
+func TestBoardUpdateFromDraw(t *testing.T) {
+	update := types.NewBoardUpdateFromDraw(types.EventGameDrawn{
+		Creator:   bob,
+		GameIndex: "1",
+		Winner:    "d",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Black:     alice,
+		Red:       bob,
+	}, 16)
+	require.EqualValues(t, types.BoardUpdate{
+		Kind:      types.BoardUpdateKindDraw,
+		GameIndex: "1",
+		Black:     alice,
+		Red:       bob,
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Winner:    "d",
+		Height:    16,
+	}, update)
+}
+
+func TestBoardUpdateFromReject(t *testing.T) {
+	update := types.NewBoardUpdateFromReject(types.EventGameRejected{
+		Creator:   bob,
+		GameIndex: "1",
+		Winner:    "*",
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Black:     alice,
+		Red:       bob,
+	}, 17)
+	require.EqualValues(t, types.BoardUpdate{
+		Kind:      types.BoardUpdateKindReject,
+		GameIndex: "1",
+		Black:     alice,
+		Red:       bob,
+		Board:     "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Winner:    "*",
+		Height:    17,
+	}, update)
+}
+
+func TestWatchesGameIndex(t *testing.T) {
+	request := types.QueryWatchRequest{GameIndex: "1"}
+	require.True(t, request.Watches(types.BoardUpdate{GameIndex: "1", Black: alice, Red: bob}))
+	require.False(t, request.Watches(types.BoardUpdate{GameIndex: "2", Black: alice, Red: bob}))
+}
+
+func TestWatchesPlayer(t *testing.T) {
+	request := types.QueryWatchRequest{Player: bob}
+	require.True(t, request.Watches(types.BoardUpdate{GameIndex: "1", Black: alice, Red: bob}))
+	require.True(t, request.Watches(types.BoardUpdate{GameIndex: "2", Black: bob, Red: testutil.Carol}))
+	require.False(t, request.Watches(types.BoardUpdate{GameIndex: "3", Black: alice, Red: testutil.Carol}))
+}
+
+func TestWatchesNothing(t *testing.T) {
+	require.False(t, types.QueryWatchRequest{}.Watches(types.BoardUpdate{GameIndex: "1", Black: alice, Red: bob}))
+}
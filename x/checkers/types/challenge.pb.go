@@ -0,0 +1,768 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/challenge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Challenge struct {
+	Index       string                                   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Creator     string                                   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Color       string                                   `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	MinRating   uint64                                   `protobuf:"varint,6,opt,name=minRating,proto3" json:"minRating,omitempty"`
+	MaxRating   uint64                                   `protobuf:"varint,7,opt,name=maxRating,proto3" json:"maxRating,omitempty"`
+	Variant     string                                   `protobuf:"bytes,8,opt,name=variant,proto3" json:"variant,omitempty"`
+	Deadline    string                                   `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	BeforeIndex string                                   `protobuf:"bytes,10,opt,name=beforeIndex,proto3" json:"beforeIndex,omitempty"`
+	AfterIndex  string                                   `protobuf:"bytes,11,opt,name=afterIndex,proto3" json:"afterIndex,omitempty"`
+	Wager       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=wager,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wager"`
+}
+
+func (m *Challenge) Reset()         { *m = Challenge{} }
+func (m *Challenge) String() string { return proto.CompactTextString(m) }
+func (*Challenge) ProtoMessage()    {}
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d002922cb358a6de, []int{0}
+}
+func (m *Challenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Challenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Challenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Challenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Challenge.Merge(m, src)
+}
+func (m *Challenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Challenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Challenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Challenge proto.InternalMessageInfo
+
+func (m *Challenge) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Challenge) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Challenge) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *Challenge) GetMinRating() uint64 {
+	if m != nil {
+		return m.MinRating
+	}
+	return 0
+}
+
+func (m *Challenge) GetMaxRating() uint64 {
+	if m != nil {
+		return m.MaxRating
+	}
+	return 0
+}
+
+func (m *Challenge) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
+func (m *Challenge) GetDeadline() string {
+	if m != nil {
+		return m.Deadline
+	}
+	return ""
+}
+
+func (m *Challenge) GetBeforeIndex() string {
+	if m != nil {
+		return m.BeforeIndex
+	}
+	return ""
+}
+
+func (m *Challenge) GetAfterIndex() string {
+	if m != nil {
+		return m.AfterIndex
+	}
+	return ""
+}
+
+func (m *Challenge) GetWager() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Wager
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Challenge)(nil), "b9lab.checkers.checkers.Challenge")
+}
+
+func init() { proto.RegisterFile("checkers/challenge.proto", fileDescriptor_d002922cb358a6de) }
+
+var fileDescriptor_d002922cb358a6de = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0x6e, 0xea, 0x30,
+	0x14, 0xc6, 0x13, 0xee, 0xe5, 0x4f, 0xcc, 0x9d, 0x2c, 0xa4, 0xeb, 0x8b, 0xae, 0x4c, 0xd4, 0x29,
+	0xaa, 0xd4, 0xb8, 0xb4, 0x53, 0x57, 0xe8, 0xd2, 0x35, 0x63, 0x37, 0xc7, 0x31, 0xc1, 0x22, 0xd8,
+	0xc8, 0x71, 0x29, 0x7d, 0x8b, 0x3e, 0x47, 0x9f, 0x84, 0x91, 0xb1, 0x53, 0x5b, 0xc1, 0xd2, 0xc7,
+	0xa8, 0x62, 0x27, 0xc0, 0x94, 0xf3, 0x7d, 0xbf, 0x2f, 0xe7, 0x58, 0xe7, 0x00, 0xc4, 0xe6, 0x9c,
+	0x2d, 0xb8, 0x2e, 0x09, 0x9b, 0xd3, 0xa2, 0xe0, 0x32, 0xe7, 0xf1, 0x4a, 0x2b, 0xa3, 0xe0, 0xdf,
+	0xf4, 0xae, 0xa0, 0x69, 0xdc, 0xf0, 0x63, 0x31, 0x1c, 0xe4, 0x2a, 0x57, 0x36, 0x43, 0xaa, 0xca,
+	0xc5, 0x87, 0x98, 0xa9, 0x72, 0xa9, 0x4a, 0x92, 0xd2, 0x92, 0x93, 0xf5, 0x38, 0xe5, 0x86, 0x8e,
+	0x09, 0x53, 0x42, 0x3a, 0x7e, 0xf1, 0xdd, 0x02, 0xc1, 0xb4, 0x19, 0x01, 0x07, 0xa0, 0x2d, 0x64,
+	0xc6, 0x37, 0xc8, 0x0f, 0xfd, 0x28, 0x48, 0x9c, 0x80, 0x08, 0x74, 0x99, 0xe6, 0xd4, 0x28, 0x8d,
+	0x5a, 0xd6, 0x6f, 0x64, 0x95, 0x67, 0xaa, 0x50, 0x1a, 0xb5, 0x5d, 0xde, 0x0a, 0xf8, 0x1f, 0x04,
+	0x4b, 0x21, 0x13, 0x6a, 0x84, 0xcc, 0x51, 0x27, 0xf4, 0xa3, 0xdf, 0xc9, 0xc9, 0xb0, 0x94, 0x6e,
+	0x6a, 0xda, 0xad, 0x69, 0x63, 0x54, 0xb3, 0xd6, 0x54, 0x0b, 0x2a, 0x0d, 0xea, 0xb9, 0x59, 0xb5,
+	0x84, 0x43, 0xd0, 0xcb, 0x38, 0xcd, 0x0a, 0x21, 0x39, 0x0a, 0x2c, 0x3a, 0x6a, 0x18, 0x82, 0x7e,
+	0xca, 0x67, 0x4a, 0xf3, 0x07, 0xfb, 0x7a, 0x60, 0xf1, 0xb9, 0x05, 0x31, 0x00, 0x74, 0x66, 0xb8,
+	0x76, 0x81, 0xbe, 0x0d, 0x9c, 0x39, 0x90, 0x82, 0xf6, 0x33, 0xcd, 0xb9, 0x46, 0x7f, 0xc2, 0x5f,
+	0x51, 0xff, 0xe6, 0x5f, 0xec, 0xf6, 0x16, 0x57, 0x7b, 0x8b, 0xeb, 0xbd, 0xc5, 0x53, 0x25, 0xe4,
+	0xe4, 0x7a, 0xfb, 0x31, 0xf2, 0xde, 0x3e, 0x47, 0x51, 0x2e, 0xcc, 0xfc, 0x29, 0x8d, 0x99, 0x5a,
+	0x92, 0x7a, 0xc9, 0xee, 0x73, 0x55, 0x66, 0x0b, 0x62, 0x5e, 0x56, 0xbc, 0xb4, 0x3f, 0x94, 0x89,
+	0xeb, 0x3c, 0xb9, 0xdf, 0xee, 0xb1, 0xbf, 0xdb, 0x63, 0xff, 0x6b, 0x8f, 0xfd, 0xd7, 0x03, 0xf6,
+	0x76, 0x07, 0xec, 0xbd, 0x1f, 0xb0, 0xf7, 0x78, 0x79, 0xd6, 0xca, 0x9e, 0x97, 0x1c, 0xcf, 0xbf,
+	0x39, 0x95, 0xb6, 0x65, 0xda, 0xb1, 0x77, 0xbb, 0xfd, 0x19, 0x00, 0x84, 0x07, 0xc5, 0x7f, 0x22,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Challenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Challenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Challenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Wager) > 0 {
+		for iNdEx := len(m.Wager) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wager[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintChallenge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AfterIndex) > 0 {
+		i -= len(m.AfterIndex)
+		copy(dAtA[i:], m.AfterIndex)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.AfterIndex)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.BeforeIndex) > 0 {
+		i -= len(m.BeforeIndex)
+		copy(dAtA[i:], m.BeforeIndex)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.BeforeIndex)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Deadline) > 0 {
+		i -= len(m.Deadline)
+		copy(dAtA[i:], m.Deadline)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Deadline)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxRating != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.MaxRating))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinRating != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.MinRating))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Color) > 0 {
+		i -= len(m.Color)
+		copy(dAtA[i:], m.Color)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Color)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChallenge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChallenge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Challenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	if m.MinRating != 0 {
+		n += 1 + sovChallenge(uint64(m.MinRating))
+	}
+	if m.MaxRating != 0 {
+		n += 1 + sovChallenge(uint64(m.MaxRating))
+	}
+	l = len(m.Variant)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.Deadline)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.BeforeIndex)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.AfterIndex)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	if len(m.Wager) > 0 {
+		for _, e := range m.Wager {
+			l = e.Size()
+			n += 1 + l + sovChallenge(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovChallenge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChallenge(x uint64) (n int) {
+	return sovChallenge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Challenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChallenge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Challenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Challenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRating", wireType)
+			}
+			m.MinRating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRating", wireType)
+			}
+			m.MaxRating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadline = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wager = append(m.Wager, types.Coin{})
+			if err := m.Wager[len(m.Wager)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChallenge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChallenge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChallenge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChallenge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChallenge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChallenge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChallenge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChallenge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChallenge = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,26 +8,71 @@ import (
 
 // x/checkers module sentinel errors
 var (
-	ErrInvalidBlack            = sdkerrors.Register(ModuleName, 1100, "black address is invalid: %s")
-	ErrInvalidRed              = sdkerrors.Register(ModuleName, 1101, "red address is invalid: %s")
-	ErrGameNotParseable        = sdkerrors.Register(ModuleName, 1102, "game cannot be parsed")
-	ErrGameNotFound            = sdkerrors.Register(ModuleName, 1103, "game by id not found")
-	ErrCreatorNotPlayer        = sdkerrors.Register(ModuleName, 1104, "message creator is not a player")
-	ErrNotPlayerTurn           = sdkerrors.Register(ModuleName, 1105, "player tried to play out of turn")
-	ErrWrongMove               = sdkerrors.Register(ModuleName, 1106, "wrong move")
-	ErrBlackAlreadyPlayed      = sdkerrors.Register(ModuleName, 1107, "black player has already played")
-	ErrRedAlreadyPlayed        = sdkerrors.Register(ModuleName, 1108, "red player has already played")
-	ErrInvalidDeadline         = sdkerrors.Register(ModuleName, 1109, "deadline cannot be parsed: %s")
-	ErrGameFinished            = sdkerrors.Register(ModuleName, 1110, "game is already finished")
-	ErrCannotFindWinnerByColor = sdkerrors.Register(ModuleName, 1111, "cannot find winner by color: %s")
-	ErrBlackCannotPay          = sdkerrors.Register(ModuleName, 1112, "black cannot pay the wager")
-	ErrRedCannotPay            = sdkerrors.Register(ModuleName, 1113, "red cannot pay the wager")
-	ErrNothingToPay            = sdkerrors.Register(ModuleName, 1114, "there is nothing to pay, should not have been called")
-	ErrCannotRefundWager       = sdkerrors.Register(ModuleName, 1115, "cannot refund wager to: %s")
-	ErrCannotPayWinnings       = sdkerrors.Register(ModuleName, 1116, "cannot pay winnings to winner: %s")
-	ErrNotInRefundState        = sdkerrors.Register(ModuleName, 1117, "game is not in a state to refund, move count: %d")
-	ErrWinnerNotParseable      = sdkerrors.Register(ModuleName, 1118, "winner is not parseable: %s")
-	ErrThereIsNoWinner         = sdkerrors.Register(ModuleName, 1119, "there is no winner")
-	ErrInvalidDateAdded        = sdkerrors.Register(ModuleName, 1120, "dateAdded cannot be parsed: %s")
-	ErrCannotAddToLeaderboard  = sdkerrors.Register(ModuleName, 1121, "cannot add to leaderboard: %s")
+	ErrInvalidBlack             = sdkerrors.Register(ModuleName, 1100, "black address is invalid: %s")
+	ErrInvalidRed               = sdkerrors.Register(ModuleName, 1101, "red address is invalid: %s")
+	ErrGameNotParseable         = sdkerrors.Register(ModuleName, 1102, "game cannot be parsed")
+	ErrGameNotFound             = sdkerrors.Register(ModuleName, 1103, "game by id not found")
+	ErrCreatorNotPlayer         = sdkerrors.Register(ModuleName, 1104, "message creator is not a player")
+	ErrNotPlayerTurn            = sdkerrors.Register(ModuleName, 1105, "player tried to play out of turn")
+	ErrWrongMove                = sdkerrors.Register(ModuleName, 1106, "wrong move")
+	ErrBlackAlreadyPlayed       = sdkerrors.Register(ModuleName, 1107, "black player has already played")
+	ErrRedAlreadyPlayed         = sdkerrors.Register(ModuleName, 1108, "red player has already played")
+	ErrInvalidDeadline          = sdkerrors.Register(ModuleName, 1109, "deadline cannot be parsed: %s")
+	ErrGameFinished             = sdkerrors.Register(ModuleName, 1110, "game is already finished")
+	ErrCannotFindWinnerByColor  = sdkerrors.Register(ModuleName, 1111, "cannot find winner by color: %s")
+	ErrBlackCannotPay           = sdkerrors.Register(ModuleName, 1112, "black cannot pay the wager")
+	ErrRedCannotPay             = sdkerrors.Register(ModuleName, 1113, "red cannot pay the wager")
+	ErrNothingToPay             = sdkerrors.Register(ModuleName, 1114, "there is nothing to pay, should not have been called")
+	ErrCannotRefundWager        = sdkerrors.Register(ModuleName, 1115, "cannot refund wager to: %s")
+	ErrCannotPayWinnings        = sdkerrors.Register(ModuleName, 1116, "cannot pay winnings to winner: %s")
+	ErrNotInRefundState         = sdkerrors.Register(ModuleName, 1117, "game is not in a state to refund, move count: %d")
+	ErrWinnerNotParseable       = sdkerrors.Register(ModuleName, 1118, "winner is not parseable: %s")
+	ErrThereIsNoWinner          = sdkerrors.Register(ModuleName, 1119, "there is no winner")
+	ErrInvalidDateAdded         = sdkerrors.Register(ModuleName, 1120, "dateAdded cannot be parsed: %s")
+	ErrCannotAddToLeaderboard   = sdkerrors.Register(ModuleName, 1121, "cannot add to leaderboard: %s")
+	ErrGameNotStarted           = sdkerrors.Register(ModuleName, 1122, "game has not started, wagers are not collected yet")
+	ErrDrawAlreadyOffered       = sdkerrors.Register(ModuleName, 1123, "a draw has already been offered")
+	ErrNoDrawOffered            = sdkerrors.Register(ModuleName, 1124, "no draw has been offered")
+	ErrCannotAnswerOwnDraw      = sdkerrors.Register(ModuleName, 1125, "player cannot answer their own draw offer")
+	ErrUnknownVariant           = sdkerrors.Register(ModuleName, 1126, "unknown rule variant: %s")
+	ErrChallengeNotFound        = sdkerrors.Register(ModuleName, 1127, "challenge by id not found")
+	ErrInvalidColor             = sdkerrors.Register(ModuleName, 1128, "colour preference must be b, r or *: %s")
+	ErrInvalidRatingBand        = sdkerrors.Register(ModuleName, 1129, "rating band is invalid, min rating is above max rating")
+	ErrCannotAcceptOwnChallenge = sdkerrors.Register(ModuleName, 1130, "player cannot accept their own challenge")
+	ErrInvalidTimeControl       = sdkerrors.Register(ModuleName, 1131, "time control is invalid: %s")
+	ErrTimeControlOutOfBounds   = sdkerrors.Register(ModuleName, 1132, "time control is outside of the allowed bounds: %s")
+	ErrWagerOutOfBounds         = sdkerrors.Register(ModuleName, 1133, "wager is outside of the allowed bounds: %s")
+	ErrDenomNotAllowed          = sdkerrors.Register(ModuleName, 1134, "denom is not allowed: %s")
+	ErrTooManyActiveGames       = sdkerrors.Register(ModuleName, 1135, "player has too many active games: %s")
+	ErrRatingOutOfBand          = sdkerrors.Register(ModuleName, 1136, "player rating is outside of the challenge band")
+	ErrCannotAddToRatingBoard   = sdkerrors.Register(ModuleName, 1137, "cannot add to rating leaderboard: %s")
+	ErrCannotPayFee             = sdkerrors.Register(ModuleName, 1138, "cannot pay fee: %s")
+	ErrInvalidWager             = sdkerrors.Register(ModuleName, 1139, "wager is invalid")
+	ErrTournamentNotFound       = sdkerrors.Register(ModuleName, 1140, "tournament by id not found")
+	ErrInvalidTournament        = sdkerrors.Register(ModuleName, 1141, "tournament settings are invalid")
+	ErrTournamentNotOpen        = sdkerrors.Register(ModuleName, 1142, "tournament is not open for registration")
+	ErrTournamentFull           = sdkerrors.Register(ModuleName, 1143, "tournament is full")
+	ErrAlreadyInTournament      = sdkerrors.Register(ModuleName, 1144, "player is already registered in the tournament")
+	ErrNotTournamentCreator     = sdkerrors.Register(ModuleName, 1145, "only the tournament creator can start it")
+	ErrNotEnoughPlayers         = sdkerrors.Register(ModuleName, 1146, "tournament needs at least 2 players to start")
+	ErrCannotPayEntryFee        = sdkerrors.Register(ModuleName, 1147, "cannot pay the tournament entry fee")
+	ErrCannotPayPrize           = sdkerrors.Register(ModuleName, 1148, "cannot pay tournament prize: %s")
+	ErrTournamentGameRejected   = sdkerrors.Register(ModuleName, 1149, "a tournament game cannot be rejected")
+	ErrInvalidBet               = sdkerrors.Register(ModuleName, 1150, "bet is invalid")
+	ErrPlayerCannotBet          = sdkerrors.Register(ModuleName, 1151, "a player cannot bet on their own game")
+	ErrBettingClosed            = sdkerrors.Register(ModuleName, 1152, "betting is closed on this game")
+	ErrCannotPayBet             = sdkerrors.Register(ModuleName, 1153, "cannot pay the bet")
+	ErrCannotPayBetPayout       = sdkerrors.Register(ModuleName, 1154, "cannot pay bet payout: %s")
+	ErrInvalidArchivedGame      = sdkerrors.Register(ModuleName, 1155, "archived game is invalid")
+	ErrGameNotFinished          = sdkerrors.Register(ModuleName, 1156, "game is not finished yet")
+	ErrTournamentGameRematch    = sdkerrors.Register(ModuleName, 1157, "a tournament game cannot be rematched")
+	ErrRematchAlreadyProposed   = sdkerrors.Register(ModuleName, 1158, "a rematch has already been proposed by: %s")
+	ErrNoRematchProposed        = sdkerrors.Register(ModuleName, 1159, "no rematch has been proposed")
+	ErrCannotAcceptOwnRematch   = sdkerrors.Register(ModuleName, 1160, "player cannot accept their own rematch proposal")
+	ErrRematchAlreadyAccepted   = sdkerrors.Register(ModuleName, 1161, "the rematch has already been accepted as game: %s")
+	ErrGamePending              = sdkerrors.Register(ModuleName, 1162, "game is waiting for its players to accept it")
+	ErrGameNotPending           = sdkerrors.Register(ModuleName, 1163, "game has already been accepted by: %s")
+	ErrBetTooSmall              = sdkerrors.Register(ModuleName, 1164, "bet is below the min bet")
+	ErrTooManyBets              = sdkerrors.Register(ModuleName, 1165, "game has taken as many bets as allowed")
+	ErrNotEnoughPlayersInSwiss  = sdkerrors.Register(ModuleName, 1166, "swiss tournament needs more players than rounds to start")
 )
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Archive makes the compact record of a game that is over, which is kept after the game itself is removed.
+func (storedGame StoredGame) Archive(reason string, board string, endHeight int64) ArchivedGame {
+	return ArchivedGame{
+		Index:           storedGame.Index,
+		Black:           storedGame.Black,
+		Red:             storedGame.Red,
+		Wager:           storedGame.Wager,
+		Winner:          storedGame.Winner,
+		Reason:          reason,
+		Board:           board,
+		MoveCount:       storedGame.MoveCount,
+		EndHeight:       endHeight,
+		Variant:         storedGame.Variant,
+		TournamentIndex: storedGame.TournamentIndex,
+		PreviousIndex:   storedGame.PreviousIndex,
+		TimeControl:     storedGame.TimeControl,
+	}
+}
+
+// GetPlayerColor tells on which side the address played. A player on both sides is taken as the side that has not
+// proposed a rematch.
+func (archivedGame ArchivedGame) GetPlayerColor(address string) (color string, found bool) {
+	isBlack := archivedGame.Black == address
+	isRed := archivedGame.Red == address
+	if isBlack && isRed {
+		if archivedGame.RematchProposer == rules.PieceStrings[rules.BLACK_PLAYER] {
+			return rules.PieceStrings[rules.RED_PLAYER], true
+		}
+		return rules.PieceStrings[rules.BLACK_PLAYER], true
+	} else if isBlack {
+		return rules.PieceStrings[rules.BLACK_PLAYER], true
+	} else if isRed {
+		return rules.PieceStrings[rules.RED_PLAYER], true
+	}
+	return "", false
+}
+
+func (archivedGame ArchivedGame) Validate() (err error) {
+	if _, err = sdk.AccAddressFromBech32(archivedGame.Black); err != nil {
+		return sdkerrors.Wrapf(err, ErrInvalidBlack.Error(), archivedGame.Black)
+	}
+	if _, err = sdk.AccAddressFromBech32(archivedGame.Red); err != nil {
+		return sdkerrors.Wrapf(err, ErrInvalidRed.Error(), archivedGame.Red)
+	}
+	if err = archivedGame.Wager.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidWager, "%s", err)
+	}
+	switch archivedGame.Winner {
+	case rules.PieceStrings[rules.NO_PLAYER], rules.PieceStrings[rules.BLACK_PLAYER],
+		rules.PieceStrings[rules.RED_PLAYER], rules.PieceStrings[rules.DRAW_PLAYER]:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidArchivedGame, "winner: %s", archivedGame.Winner)
+	}
+	switch archivedGame.Reason {
+	case ArchiveReasonCheckmate, ArchiveReasonDraw, ArchiveReasonForfeit, ArchiveReasonReject, ArchiveReasonResign,
+		ArchiveReasonUnknown:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidArchivedGame, "reason: %s", archivedGame.Reason)
+	}
+	switch archivedGame.RematchProposer {
+	case "", rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidArchivedGame, "rematch proposer: %s", archivedGame.RematchProposer)
+	}
+	return nil
+}
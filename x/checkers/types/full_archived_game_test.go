@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveKeepsResult(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "r"
+	storedGame.MoveCount = 12
+	storedGame.TournamentIndex = "3"
+	require.EqualValues(t, types.ArchivedGame{
+		Index:           "1",
+		Black:           alice,
+		Red:             bob,
+		Wager:           storedGame.Wager,
+		Winner:          "r",
+		Reason:          types.ArchiveReasonResign,
+		Board:           "final",
+		MoveCount:       12,
+		EndHeight:       33,
+		TournamentIndex: "3",
+	}, storedGame.Archive(types.ArchiveReasonResign, "final", 33))
+}
+
+func GetArchivedGame1() types.ArchivedGame {
+	return types.ArchivedGame{
+		Index:  "1",
+		Black:  alice,
+		Red:    bob,
+		Wager:  sdk.NewCoins(sdk.NewInt64Coin("stake", 45)),
+		Winner: "b",
+		Reason: types.ArchiveReasonCheckmate,
+	}
+}
+
+func TestArchivedGameValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(*types.ArchivedGame)
+		err    string
+	}{
+		{
+			desc:   "valid",
+			modify: func(*types.ArchivedGame) {},
+		},
+		{
+			desc:   "rejected without winner",
+			modify: func(game *types.ArchivedGame) { game.Winner = "*"; game.Reason = types.ArchiveReasonReject },
+		},
+		{
+			desc:   "invalid black",
+			modify: func(game *types.ArchivedGame) { game.Black = "cosmos123" },
+			err:    "black address is invalid: cosmos123: decoding bech32 failed: invalid separator index 6",
+		},
+		{
+			desc:   "unknown winner",
+			modify: func(game *types.ArchivedGame) { game.Winner = "x" },
+			err:    "winner: x: archived game is invalid",
+		},
+		{
+			desc:   "unknown reason",
+			modify: func(game *types.ArchivedGame) { game.Reason = "timeout" },
+			err:    "reason: timeout: archived game is invalid",
+		},
+		{
+			desc:   "unknown rematch proposer",
+			modify: func(game *types.ArchivedGame) { game.RematchProposer = "d" },
+			err:    "rematch proposer: d: archived game is invalid",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			archivedGame := GetArchivedGame1()
+			tc.modify(&archivedGame)
+			err := archivedGame.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestArchivedGamePlayerColor(t *testing.T) {
+	archivedGame := GetArchivedGame1()
+	color, found := archivedGame.GetPlayerColor(alice)
+	require.True(t, found)
+	require.Equal(t, "b", color)
+	color, found = archivedGame.GetPlayerColor(bob)
+	require.True(t, found)
+	require.Equal(t, "r", color)
+	_, found = archivedGame.GetPlayerColor("cosmos123")
+	require.False(t, found)
+}
+
+func TestArchivedGamePlayerColorOnBothSides(t *testing.T) {
+	archivedGame := GetArchivedGame1()
+	archivedGame.Red = alice
+	color, _ := archivedGame.GetPlayerColor(alice)
+	require.Equal(t, "b", color)
+	archivedGame.RematchProposer = "b"
+	color, _ = archivedGame.GetPlayerColor(alice)
+	require.Equal(t, "r", color)
+}
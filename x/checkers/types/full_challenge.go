@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func ValidateChallengeColor(color string) error {
+	switch color {
+	case rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER], rules.PieceStrings[rules.NO_PLAYER]:
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrInvalidColor, "%s", color)
+}
+
+func (challenge Challenge) GetDeadlineAsTime() (deadline time.Time, err error) {
+	deadline, errDeadline := time.Parse(DeadlineLayout, challenge.Deadline)
+	return deadline, sdkerrors.Wrapf(errDeadline, ErrInvalidDeadline.Error(), challenge.Deadline)
+}
+
+func GetNextChallengeDeadline(ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(MaxChallengeDuration)
+}
+
+// GetPlayers places the challenge creator and the accepting player on the board, the creator taking black when
+// without colour preference.
+func (challenge Challenge) GetPlayers(accepter string) (black string, red string) {
+	if challenge.Color == rules.PieceStrings[rules.RED_PLAYER] {
+		return accepter, challenge.Creator
+	}
+	return challenge.Creator, accepter
+}
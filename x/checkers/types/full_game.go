@@ -10,6 +10,32 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// NewStoredGame sets up a game on the initial board of its variant, yet to be given its clocks and placed in the FIFO.
+func NewStoredGame(index string, black string, red string, wager sdk.Coins, variantName string,
+	timeControl TimeControl) (storedGame StoredGame, err error) {
+	variant, found := rules.ParseVariant(variantName)
+	if !found {
+		return StoredGame{}, sdkerrors.Wrapf(ErrUnknownVariant, "%s", variantName)
+	}
+	newGame := rules.NewWithVariant(variant)
+	return StoredGame{
+		Index:           index,
+		PackedBoard:     newGame.Pack(),
+		Turn:            rules.PieceStrings[newGame.Turn],
+		Black:           black,
+		Red:             red,
+		MoveCount:       0,
+		BeforeIndex:     NoFifoIndex,
+		AfterIndex:      NoFifoIndex,
+		Winner:          rules.PieceStrings[rules.NO_PLAYER],
+		Wager:           wager,
+		Variant:         variantName,
+		TimeControl:     timeControl,
+		PositionHash:    newGame.Hash(),
+		PositionHistory: newGame.History,
+	}, nil
+}
+
 func (storedGame StoredGame) GetBlackAddress() (black sdk.AccAddress, err error) {
 	black, errBlack := sdk.AccAddressFromBech32(storedGame.Black)
 	return black, sdkerrors.Wrapf(errBlack, ErrInvalidBlack.Error(), storedGame.Black)
@@ -20,8 +46,46 @@ func (storedGame StoredGame) GetRedAddress() (red sdk.AccAddress, err error) {
 	return red, sdkerrors.Wrapf(errRed, ErrInvalidRed.Error(), storedGame.Red)
 }
 
+// Invite makes the game wait for the players other than its creator to accept it.
+func (storedGame *StoredGame) Invite(creator string) {
+	storedGame.BlackPending = storedGame.Black != creator
+	storedGame.RedPending = storedGame.Red != creator
+}
+
+// IsPending tells whether the game still waits for one of its players to accept it.
+func (storedGame StoredGame) IsPending() bool {
+	return storedGame.BlackPending || storedGame.RedPending
+}
+
+// IsPendingFor tells whether the game still waits for this player to accept it.
+func (storedGame StoredGame) IsPendingFor(player string) bool {
+	return (storedGame.BlackPending && storedGame.Black == player) || (storedGame.RedPending && storedGame.Red == player)
+}
+
+// Accept records the acceptance of the player, on every side they play.
+func (storedGame *StoredGame) Accept(player string) {
+	if storedGame.Black == player {
+		storedGame.BlackPending = false
+	}
+	if storedGame.Red == player {
+		storedGame.RedPending = false
+	}
+}
+
+func (storedGame StoredGame) ParseVariant() (variant rules.Variant, err error) {
+	variant, found := rules.ParseVariant(storedGame.Variant)
+	if !found {
+		return nil, sdkerrors.Wrapf(ErrUnknownVariant, "%s", storedGame.Variant)
+	}
+	return variant, nil
+}
+
 func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
-	board, errBoard := rules.Parse(storedGame.Board)
+	variant, err := storedGame.ParseVariant()
+	if err != nil {
+		return nil, err
+	}
+	board, errBoard := rules.UnpackWithVariant(storedGame.PackedBoard, variant)
 	if errBoard != nil {
 		return nil, sdkerrors.Wrapf(errBoard, ErrGameNotParseable.Error())
 	}
@@ -29,9 +93,50 @@ func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
 	if board.Turn.Color == "" {
 		return nil, sdkerrors.Wrapf(errors.New(fmt.Sprintf("Turn: %s", storedGame.Turn)), ErrGameNotParseable.Error())
 	}
+	board.History = append(board.History, storedGame.PositionHistory...)
+	if storedGame.CapturingPiece != "" {
+		capturing, errCapturing := ParsePosition(storedGame.CapturingPiece)
+		if errCapturing != nil {
+			return nil, sdkerrors.Wrapf(errCapturing, ErrGameNotParseable.Error())
+		}
+		board.CapturingPiece = rules.Pos{X: int(capturing.X), Y: int(capturing.Y)}
+	}
+	dead := make([]rules.Pos, 0, len(storedGame.DeadPieces))
+	for _, position := range storedGame.DeadPieces {
+		dead = append(dead, rules.Pos{X: int(position.X), Y: int(position.Y)})
+	}
+	if errDead := board.SetDeadPieces(dead); errDead != nil {
+		return nil, sdkerrors.Wrapf(errDead, ErrGameNotParseable.Error())
+	}
 	return board, nil
 }
 
+// FormatBoard spells out the packed board, as in archived games and query responses.
+func (storedGame StoredGame) FormatBoard() (board string, err error) {
+	variant, err := storedGame.ParseVariant()
+	if err != nil {
+		return "", err
+	}
+	game, err := rules.UnpackWithVariant(storedGame.PackedBoard, variant)
+	if err != nil {
+		return "", sdkerrors.Wrapf(err, ErrGameNotParseable.Error())
+	}
+	return game.String(), nil
+}
+
+// DeriveBoard fills in the board string, which is not stored, for a query response.
+func (storedGame *StoredGame) DeriveBoard() (err error) {
+	storedGame.Board, err = storedGame.FormatBoard()
+	return err
+}
+
+func FormatCapturingPiece(game *rules.Game) string {
+	if game.CapturingPiece == rules.NO_POS {
+		return ""
+	}
+	return FormatPosition(Position{X: uint64(game.CapturingPiece.X), Y: uint64(game.CapturingPiece.Y)})
+}
+
 func (storedGame StoredGame) GetDeadlineAsTime() (deadline time.Time, err error) {
 	deadline, errDeadline := time.Parse(DeadlineLayout, storedGame.Deadline)
 	return deadline, sdkerrors.Wrapf(errDeadline, ErrInvalidDeadline.Error(), storedGame.Deadline)
@@ -41,8 +146,66 @@ func FormatDeadline(deadline time.Time) string {
 	return deadline.UTC().Format(DeadlineLayout)
 }
 
-func GetNextDeadline(ctx sdk.Context) time.Time {
-	return ctx.BlockTime().Add(MaxTurnDuration)
+func (storedGame StoredGame) GetTimeLeft(color string) time.Duration {
+	if color == rules.PieceStrings[rules.RED_PLAYER] {
+		return time.Duration(storedGame.RedTimeLeft)
+	}
+	return time.Duration(storedGame.BlackTimeLeft)
+}
+
+func (storedGame *StoredGame) SetTimeLeft(color string, timeLeft time.Duration) {
+	if color == rules.PieceStrings[rules.RED_PLAYER] {
+		storedGame.RedTimeLeft = uint64(timeLeft)
+	} else {
+		storedGame.BlackTimeLeft = uint64(timeLeft)
+	}
+}
+
+// GetTurnDuration gives how long the player to move has, from the start of their turn.
+func (storedGame StoredGame) GetTurnDuration(defaultTurnDuration time.Duration) time.Duration {
+	if storedGame.TimeControl.IsClock() {
+		return storedGame.GetTimeLeft(storedGame.Turn)
+	}
+	if storedGame.TimeControl.MoveTime != 0 {
+		return SecondsToDuration(storedGame.TimeControl.MoveTime)
+	}
+	return defaultTurnDuration
+}
+
+func (storedGame StoredGame) GetNextDeadline(ctx sdk.Context, defaultTurnDuration time.Duration) time.Time {
+	return ctx.BlockTime().Add(storedGame.GetTurnDuration(defaultTurnDuration))
+}
+
+// StartClocks gives both players their total time and starts the turn of the first player.
+func (storedGame *StoredGame) StartClocks(ctx sdk.Context, defaultTurnDuration time.Duration) {
+	if storedGame.TimeControl.IsClock() {
+		totalTime := SecondsToDuration(storedGame.TimeControl.TotalTime)
+		storedGame.SetTimeLeft(rules.PieceStrings[rules.BLACK_PLAYER], totalTime)
+		storedGame.SetTimeLeft(rules.PieceStrings[rules.RED_PLAYER], totalTime)
+	}
+	storedGame.Deadline = FormatDeadline(storedGame.GetNextDeadline(ctx, defaultTurnDuration))
+}
+
+// SetNextTurn stops the clock of the player who moved, adding the increment, and starts the turn of the next player.
+func (storedGame *StoredGame) SetNextTurn(ctx sdk.Context, turn string, defaultTurnDuration time.Duration) (err error) {
+	if storedGame.TimeControl.IsClock() {
+		if storedGame.Turn == turn {
+			// Still in a capture chain, the clock keeps running
+			return nil
+		}
+		deadline, err := storedGame.GetDeadlineAsTime()
+		if err != nil {
+			return err
+		}
+		timeLeft := deadline.Sub(ctx.BlockTime())
+		if timeLeft < 0 {
+			timeLeft = 0
+		}
+		storedGame.SetTimeLeft(storedGame.Turn, timeLeft+SecondsToDuration(storedGame.TimeControl.Increment))
+	}
+	storedGame.Turn = turn
+	storedGame.Deadline = FormatDeadline(storedGame.GetNextDeadline(ctx, defaultTurnDuration))
+	return nil
 }
 
 func (storedGame StoredGame) GetPlayerAddress(color string) (address sdk.AccAddress, found bool, err error) {
@@ -61,12 +224,21 @@ func (storedGame StoredGame) GetPlayerAddress(color string) (address sdk.AccAddr
 	return address, found, nil
 }
 
-func (storedGame StoredGame) GetWinnerAddress() (address sdk.AccAddress, found bool, err error) {
-	return storedGame.GetPlayerAddress(storedGame.Winner)
+func (storedGame StoredGame) GetPlayerColor(address string) (color string, found bool) {
+	isBlack := storedGame.Black == address
+	isRed := storedGame.Red == address
+	if isBlack && isRed {
+		return storedGame.Turn, true
+	} else if isBlack {
+		return rules.PieceStrings[rules.BLACK_PLAYER], true
+	} else if isRed {
+		return rules.PieceStrings[rules.RED_PLAYER], true
+	}
+	return "", false
 }
 
-func (storedGame *StoredGame) GetWagerCoin() (wager sdk.Coin) {
-	return sdk.NewCoin(storedGame.Denom, sdk.NewInt(int64(storedGame.Wager)))
+func (storedGame StoredGame) GetWinnerAddress() (address sdk.AccAddress, found bool, err error) {
+	return storedGame.GetPlayerAddress(storedGame.Winner)
 }
 
 func (storedGame StoredGame) Validate() (err error) {
@@ -83,5 +255,24 @@ func (storedGame StoredGame) Validate() (err error) {
 		return err
 	}
 	_, err = storedGame.GetDeadlineAsTime()
-	return err
+	if err != nil {
+		return err
+	}
+	err = storedGame.TimeControl.Validate()
+	if err != nil {
+		return err
+	}
+	err = storedGame.Wager.Validate()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidWager, "%s", err)
+	}
+	return storedGame.ValidateDrawOfferer()
+}
+
+func (storedGame StoredGame) ValidateDrawOfferer() (err error) {
+	switch storedGame.DrawOfferer {
+	case "", rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]:
+		return nil
+	}
+	return sdkerrors.Wrapf(errors.New(fmt.Sprintf("DrawOfferer: %s", storedGame.DrawOfferer)), ErrGameNotParseable.Error())
 }
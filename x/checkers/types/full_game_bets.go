@@ -0,0 +1,113 @@
+package types
+
+import (
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BetPayout is what a bettor receives when the bets on a game are settled.
+type BetPayout struct {
+	Bettor string
+	Amount sdk.Coins
+}
+
+// GetPool returns all that was bet on the game.
+func (gameBets GameBets) GetPool() sdk.Coins {
+	return gameBets.BlackPool.Add(gameBets.RedPool...)
+}
+
+// Validate checks that the pools add up the bets of their color.
+func (gameBets GameBets) Validate() error {
+	recount := GameBets{BlackPool: sdk.NewCoins(), RedPool: sdk.NewCoins()}
+	for _, bet := range gameBets.Bets {
+		if _, err := sdk.AccAddressFromBech32(bet.Bettor); err != nil {
+			return err
+		}
+		if bet.Color != rules.PieceStrings[rules.BLACK_PLAYER] && bet.Color != rules.PieceStrings[rules.RED_PLAYER] {
+			return sdkerrors.Wrapf(ErrInvalidBet, "color must be %s or %s: %s",
+				rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER], bet.Color)
+		}
+		if err := bet.Amount.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidBet, "%s", err)
+		}
+		recount.AddBet(bet)
+	}
+	if !sameCoins(recount.BlackPool, gameBets.BlackPool) || !sameCoins(recount.RedPool, gameBets.RedPool) {
+		return sdkerrors.Wrapf(ErrInvalidBet, "pools of game %s do not add up the bets", gameBets.GameIndex)
+	}
+	return nil
+}
+
+// sameCoins compares without the panic of Coins.IsEqual on different denoms.
+func sameCoins(left sdk.Coins, right sdk.Coins) bool {
+	return left.IsAllGTE(right) && right.IsAllGTE(left)
+}
+
+// AddBet records a bet and adds it to the pool of its color.
+func (gameBets *GameBets) AddBet(bet Bet) {
+	gameBets.Bets = append(gameBets.Bets, bet)
+	if bet.Color == rules.PieceStrings[rules.BLACK_PLAYER] {
+		gameBets.BlackPool = gameBets.BlackPool.Add(bet.Amount...)
+	} else {
+		gameBets.RedPool = gameBets.RedPool.Add(bet.Amount...)
+	}
+}
+
+// GetPayouts splits the pool pari-mutuel, denom by denom, among the bets on the winner in proportion to their
+// amount, rounded down, with the remainder going to the earliest of them. A denom that nobody bet on the winner with
+// is refunded, and so is the whole pool when the winner is neither black nor red. There is one payout per bettor, in
+// the order of their first bet.
+func (gameBets GameBets) GetPayouts(winner string) []BetPayout {
+	var winningPool sdk.Coins
+	switch winner {
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		winningPool = gameBets.BlackPool
+	case rules.PieceStrings[rules.RED_PLAYER]:
+		winningPool = gameBets.RedPool
+	}
+	bettors := []string{}
+	amounts := make(map[string]sdk.Coins)
+	for _, bet := range gameBets.Bets {
+		if _, found := amounts[bet.Bettor]; !found {
+			bettors = append(bettors, bet.Bettor)
+			amounts[bet.Bettor] = sdk.NewCoins()
+		}
+	}
+	credit := func(bettor string, denom string, amount sdk.Int) {
+		if amount.IsPositive() {
+			amounts[bettor] = amounts[bettor].Add(sdk.NewCoin(denom, amount))
+		}
+	}
+	for _, total := range gameBets.GetPool() {
+		winningAmount := winningPool.AmountOf(total.Denom)
+		distributed := sdk.ZeroInt()
+		firstWinner := ""
+		for _, bet := range gameBets.Bets {
+			betAmount := bet.Amount.AmountOf(total.Denom)
+			if winningAmount.IsZero() {
+				credit(bet.Bettor, total.Denom, betAmount)
+				continue
+			}
+			if bet.Color != winner || betAmount.IsZero() {
+				continue
+			}
+			share := betAmount.Mul(total.Amount).Quo(winningAmount)
+			credit(bet.Bettor, total.Denom, share)
+			distributed = distributed.Add(share)
+			if firstWinner == "" {
+				firstWinner = bet.Bettor
+			}
+		}
+		if firstWinner != "" {
+			credit(firstWinner, total.Denom, total.Amount.Sub(distributed))
+		}
+	}
+	payouts := make([]BetPayout, 0, len(bettors))
+	for _, bettor := range bettors {
+		if !amounts[bettor].Empty() {
+			payouts = append(payouts, BetPayout{Bettor: bettor, Amount: amounts[bettor]})
+		}
+	}
+	return payouts
+}
@@ -0,0 +1,153 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func GetGameBetsOf(bets ...types.Bet) types.GameBets {
+	gameBets := types.GameBets{
+		GameIndex: "1",
+		BlackPool: sdk.NewCoins(),
+		RedPool:   sdk.NewCoins(),
+	}
+	for _, bet := range bets {
+		gameBets.AddBet(bet)
+	}
+	return gameBets
+}
+
+func betOf(bettor string, color string, amount sdk.Coins) types.Bet {
+	return types.Bet{
+		Bettor: bettor,
+		Color:  color,
+		Amount: amount,
+	}
+}
+
+func stakeOf(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func TestAddBetFillsPools(t *testing.T) {
+	gameBets := GetGameBetsOf(
+		betOf("a", "b", stakeOf(10)),
+		betOf("b", "r", stakeOf(20)),
+		betOf("c", "b", sdk.NewCoins(sdk.NewInt64Coin("gold", 5))))
+	require.Equal(t, "5gold,10stake", gameBets.BlackPool.String())
+	require.Equal(t, "20stake", gameBets.RedPool.String())
+	require.Equal(t, "5gold,30stake", gameBets.GetPool().String())
+	require.Len(t, gameBets.Bets, 3)
+}
+
+func TestPayoutsSplitInProportion(t *testing.T) {
+	gameBets := GetGameBetsOf(
+		betOf("a", "b", stakeOf(10)),
+		betOf("b", "b", stakeOf(30)),
+		betOf("c", "r", stakeOf(60)))
+	require.EqualValues(t, []types.BetPayout{
+		{Bettor: "a", Amount: stakeOf(25)},
+		{Bettor: "b", Amount: stakeOf(75)},
+	}, gameBets.GetPayouts("b"))
+}
+
+func TestPayoutsRemainderToEarliestWinner(t *testing.T) {
+	gameBets := GetGameBetsOf(
+		betOf("a", "r", stakeOf(1)),
+		betOf("b", "r", stakeOf(1)),
+		betOf("c", "r", stakeOf(1)),
+		betOf("d", "b", stakeOf(7)))
+	require.EqualValues(t, []types.BetPayout{
+		{Bettor: "a", Amount: stakeOf(4)},
+		{Bettor: "b", Amount: stakeOf(3)},
+		{Bettor: "c", Amount: stakeOf(3)},
+	}, gameBets.GetPayouts("r"))
+}
+
+func TestPayoutsOneForRepeatBettor(t *testing.T) {
+	gameBets := GetGameBetsOf(
+		betOf("a", "b", stakeOf(10)),
+		betOf("b", "r", stakeOf(10)),
+		betOf("a", "b", stakeOf(10)))
+	require.EqualValues(t, []types.BetPayout{
+		{Bettor: "a", Amount: stakeOf(30)},
+	}, gameBets.GetPayouts("b"))
+}
+
+func TestPayoutsRefundDenomWithoutWinningBet(t *testing.T) {
+	gameBets := GetGameBetsOf(
+		betOf("a", "b", stakeOf(10)),
+		betOf("b", "r", sdk.NewCoins(sdk.NewInt64Coin("gold", 4), sdk.NewInt64Coin("stake", 10))))
+	require.EqualValues(t, []types.BetPayout{
+		{Bettor: "a", Amount: stakeOf(20)},
+		{Bettor: "b", Amount: sdk.NewCoins(sdk.NewInt64Coin("gold", 4))},
+	}, gameBets.GetPayouts("b"))
+}
+
+func TestPayoutsRefundAllOnDraw(t *testing.T) {
+	gameBets := GetGameBetsOf(
+		betOf("a", "b", stakeOf(10)),
+		betOf("b", "r", stakeOf(20)))
+	require.EqualValues(t, []types.BetPayout{
+		{Bettor: "a", Amount: stakeOf(10)},
+		{Bettor: "b", Amount: stakeOf(20)},
+	}, gameBets.GetPayouts("d"))
+	require.EqualValues(t, gameBets.GetPayouts("d"), gameBets.GetPayouts("*"))
+}
+
+func TestPayoutsNoBets(t *testing.T) {
+	require.Empty(t, GetGameBetsOf().GetPayouts("b"))
+}
+
+func TestGameBetsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		gameBets types.GameBets
+		err      string
+	}{
+		{
+			desc:     "valid",
+			gameBets: GetGameBetsOf(betOf(testutil.Alice, "b", stakeOf(10)), betOf(testutil.Bob, "r", stakeOf(5))),
+		},
+		{
+			desc:     "invalid bettor",
+			gameBets: GetGameBetsOf(betOf("a", "b", stakeOf(10))),
+			err:      "decoding bech32 failed: invalid bech32 string length 1",
+		},
+		{
+			desc:     "invalid color",
+			gameBets: GetGameBetsOf(betOf(testutil.Alice, "d", stakeOf(10))),
+			err:      "color must be b or r: d: bet is invalid",
+		},
+		{
+			desc: "invalid amount",
+			gameBets: GetGameBetsOf(betOf(testutil.Alice, "b", sdk.Coins{
+				sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)},
+			})),
+			err: "coin -1stake amount is not positive: bet is invalid",
+		},
+		{
+			desc: "pools not adding up",
+			gameBets: types.GameBets{
+				GameIndex: "1",
+				BlackPool: sdk.NewCoins(sdk.NewInt64Coin("gold", 10)),
+				RedPool:   sdk.NewCoins(),
+				Bets:      []types.Bet{betOf(testutil.Alice, "b", stakeOf(10))},
+			},
+			err: "pools of game 1 do not add up the bets: bet is invalid",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.gameBets.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -22,7 +22,7 @@ func GetStoredGame1() types.StoredGame {
 		Black:       alice,
 		Red:         bob,
 		Index:       "1",
-		Board:       rules.New().String(),
+		PackedBoard: rules.New().Pack(),
 		Turn:        "b",
 		MoveCount:   0,
 		BeforeIndex: types.NoFifoIndex,
@@ -72,24 +72,24 @@ func TestGetAddressWrongRed(t *testing.T) {
 
 func TestParseGameCorrect(t *testing.T) {
 	game, err := GetStoredGame1().ParseGame()
-	require.EqualValues(t, rules.New().Pieces, game.Pieces)
+	require.EqualValues(t, rules.New().Pieces(), game.Pieces())
 	require.Nil(t, err)
 }
 
 func TestParseGameCanIfChangedOk(t *testing.T) {
 	storedGame := GetStoredGame1()
-	storedGame.Board = strings.Replace(storedGame.Board, "b", "r", 1)
+	storedGame.PackedBoard = testutil.PackBoard(strings.Replace(rules.New().String(), "b", "r", 1))
 	game, err := storedGame.ParseGame()
-	require.NotEqualValues(t, rules.New().Pieces, game)
+	require.NotEqualValues(t, rules.New().Pieces(), game.Pieces())
 	require.Nil(t, err)
 }
 
 func TestParseGameWrongPieceColor(t *testing.T) {
 	storedGame := GetStoredGame1()
-	storedGame.Board = strings.Replace(storedGame.Board, "b", "w", 1)
+	storedGame.PackedBoard[0] |= 3
 	game, err := storedGame.ParseGame()
 	require.Nil(t, game)
-	require.EqualError(t, err, "game cannot be parsed: invalid board, invalid piece at 1, 0")
+	require.EqualError(t, err, "game cannot be parsed: invalid packed board, invalid piece at 1, 0")
 	require.EqualError(t, storedGame.Validate(), err.Error())
 }
 
@@ -102,6 +102,68 @@ func TestParseGameWrongTurnColor(t *testing.T) {
 	require.EqualError(t, storedGame.Validate(), err.Error())
 }
 
+func TestParseGameInternationalCorrect(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Variant = rules.INTERNATIONAL
+	storedGame.PackedBoard = rules.NewWithVariant(rules.INTERNATIONAL_VARIANT).Pack()
+	game, err := storedGame.ParseGame()
+	require.Nil(t, err)
+	require.Equal(t, rules.INTERNATIONAL_VARIANT, game.Variant)
+	require.Len(t, game.Pieces(), 40)
+}
+
+func TestParseGameWrongBoardForVariant(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Variant = rules.INTERNATIONAL
+	game, err := storedGame.ParseGame()
+	require.Nil(t, game)
+	require.EqualError(t, err, "game cannot be parsed: invalid packed board length: 12")
+	require.EqualError(t, storedGame.Validate(), err.Error())
+}
+
+func TestFormatBoard(t *testing.T) {
+	storedGame := GetStoredGame1()
+	board, err := storedGame.FormatBoard()
+	require.Nil(t, err)
+	require.Equal(t, rules.New().String(), board)
+	require.Nil(t, storedGame.DeriveBoard())
+	require.Equal(t, rules.New().String(), storedGame.Board)
+}
+
+func TestFormatBoardWrongBoardForVariant(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Variant = rules.INTERNATIONAL
+	board, err := storedGame.FormatBoard()
+	require.Equal(t, "", board)
+	require.EqualError(t, err, "game cannot be parsed: invalid packed board length: 12")
+}
+
+func TestParseGameUnknownVariant(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Variant = "chess"
+	game, err := storedGame.ParseGame()
+	require.Nil(t, game)
+	require.EqualError(t, err, "chess: unknown rule variant: %s")
+	require.EqualError(t, storedGame.Validate(), err.Error())
+}
+
+func TestParseGameCapturingPiece(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.CapturingPiece = "3,2"
+	game, err := storedGame.ParseGame()
+	require.Nil(t, err)
+	require.Equal(t, rules.Pos{X: 3, Y: 2}, game.CapturingPiece)
+	require.Equal(t, "3,2", types.FormatCapturingPiece(game))
+}
+
+func TestParseGameWrongCapturingPiece(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.CapturingPiece = "3"
+	game, err := storedGame.ParseGame()
+	require.Nil(t, game)
+	require.EqualError(t, err, "game cannot be parsed: invalid position: 3")
+}
+
 func TestParseDeadlineCorrect(t *testing.T) {
 	deadline, err := GetStoredGame1().GetDeadlineAsTime()
 	require.Nil(t, err)
@@ -198,3 +260,96 @@ func TestGameValidateOk(t *testing.T) {
 	storedGame := GetStoredGame1()
 	require.NoError(t, storedGame.Validate())
 }
+
+func TestTurnDurationDefault(t *testing.T) {
+	require.Equal(t, 3*time.Hour, GetStoredGame1().GetTurnDuration(3*time.Hour))
+}
+
+func TestTurnDurationMoveTime(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{MoveTime: 30}
+	require.Equal(t, 30*time.Second, storedGame.GetTurnDuration(3*time.Hour))
+}
+
+func TestStartClocks(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockTime(time.Unix(1_000, 0))
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{TotalTime: 300, Increment: 2}
+	storedGame.StartClocks(ctx, 3*time.Hour)
+	require.EqualValues(t, 300*time.Second, storedGame.BlackTimeLeft)
+	require.EqualValues(t, 300*time.Second, storedGame.RedTimeLeft)
+	require.Equal(t, types.FormatDeadline(time.Unix(1_300, 0)), storedGame.Deadline)
+}
+
+func TestSetNextTurnStopsClockWithIncrement(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockTime(time.Unix(1_000, 0))
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{TotalTime: 300, Increment: 2}
+	storedGame.StartClocks(ctx, 3*time.Hour)
+	err := storedGame.SetNextTurn(ctx.WithBlockTime(time.Unix(1_100, 0)), "r", 3*time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, "r", storedGame.Turn)
+	require.EqualValues(t, 202*time.Second, storedGame.BlackTimeLeft)
+	require.EqualValues(t, 300*time.Second, storedGame.RedTimeLeft)
+	require.Equal(t, types.FormatDeadline(time.Unix(1_400, 0)), storedGame.Deadline)
+}
+
+func TestSetNextTurnKeepsClockRunningInCaptureChain(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockTime(time.Unix(1_000, 0))
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{TotalTime: 300, Increment: 2}
+	storedGame.StartClocks(ctx, 3*time.Hour)
+	err := storedGame.SetNextTurn(ctx.WithBlockTime(time.Unix(1_100, 0)), "b", 3*time.Hour)
+	require.Nil(t, err)
+	require.EqualValues(t, 300*time.Second, storedGame.BlackTimeLeft)
+	require.Equal(t, types.FormatDeadline(time.Unix(1_300, 0)), storedGame.Deadline)
+}
+
+func TestTimeControlValidateWithParams(t *testing.T) {
+	params := types.DefaultParams()
+	require.Nil(t, types.TimeControl{}.ValidateWithParams(params))
+	require.Nil(t, types.TimeControl{MoveTime: 30}.ValidateWithParams(params))
+	require.ErrorIs(t, types.TimeControl{MoveTime: 1}.ValidateWithParams(params), types.ErrTimeControlOutOfBounds)
+	require.ErrorIs(t, types.TimeControl{TotalTime: 10}.ValidateWithParams(params), types.ErrTimeControlOutOfBounds)
+	require.ErrorIs(t, types.TimeControl{TotalTime: 300, Increment: 100_000}.ValidateWithParams(params),
+		types.ErrTimeControlOutOfBounds)
+}
+
+func TestInviteOtherPlayer(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Invite(alice)
+	require.True(t, storedGame.IsPending())
+	require.False(t, storedGame.IsPendingFor(alice))
+	require.True(t, storedGame.IsPendingFor(bob))
+	storedGame.Accept(bob)
+	require.False(t, storedGame.IsPending())
+}
+
+func TestInviteBothPlayers(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Invite(testutil.Carol)
+	require.True(t, storedGame.IsPendingFor(alice))
+	require.True(t, storedGame.IsPendingFor(bob))
+	storedGame.Accept(alice)
+	require.True(t, storedGame.IsPending())
+	require.False(t, storedGame.IsPendingFor(alice))
+	storedGame.Accept(bob)
+	require.False(t, storedGame.IsPending())
+}
+
+func TestInviteSelfIsNotPending(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Red = alice
+	storedGame.Invite(alice)
+	require.False(t, storedGame.IsPending())
+}
+
+func TestInvitePlayerOnBothSides(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Red = alice
+	storedGame.Invite(bob)
+	require.True(t, storedGame.BlackPending)
+	require.True(t, storedGame.RedPending)
+	storedGame.Accept(alice)
+	require.False(t, storedGame.IsPending())
+}
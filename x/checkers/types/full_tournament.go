@@ -0,0 +1,302 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateTournamentSettings checks the settings given at creation. Without prize shares, the winner takes all.
+func ValidateTournamentSettings(format string, maxPlayers uint64, rounds uint64, prizeSharesBps []uint64) error {
+	if maxPlayers < 2 || MaxTournamentPlayers < maxPlayers {
+		return sdkerrors.Wrapf(ErrInvalidTournament, "max players %d is not within 2 and %d", maxPlayers, MaxTournamentPlayers)
+	}
+	switch format {
+	case TournamentFormatSwiss:
+		// More rounds than opponents would have players meet again
+		if rounds < 1 || maxPlayers <= rounds {
+			return sdkerrors.Wrapf(ErrInvalidTournament, "swiss rounds %d is not within 1 and %d", rounds, maxPlayers-1)
+		}
+	case TournamentFormatElimination:
+		if rounds != 0 {
+			return sdkerrors.Wrapf(ErrInvalidTournament, "elimination cannot have a set number of rounds: %d", rounds)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidTournament, "format must be %s or %s: %s",
+			TournamentFormatSwiss, TournamentFormatElimination, format)
+	}
+	if len(prizeSharesBps) == 0 {
+		return nil
+	}
+	if maxPlayers < uint64(len(prizeSharesBps)) {
+		return sdkerrors.Wrapf(ErrInvalidTournament, "%d prize shares for %d players", len(prizeSharesBps), maxPlayers)
+	}
+	total := uint64(0)
+	for _, share := range prizeSharesBps {
+		total += share
+		if TotalPrizeSharesBps < total {
+			break
+		}
+	}
+	if total != TotalPrizeSharesBps {
+		return sdkerrors.Wrapf(ErrInvalidTournament, "prize shares must add up to %d", TotalPrizeSharesBps)
+	}
+	return nil
+}
+
+// TournamentPairing is a game to create for a round.
+type TournamentPairing struct {
+	Black string
+	Red   string
+}
+
+func (tournament Tournament) IsSwiss() bool {
+	return tournament.Format == TournamentFormatSwiss
+}
+
+func (tournament Tournament) GetPlayerIndex(address string) (index int, found bool) {
+	for index, player := range tournament.Players {
+		if player.Address == address {
+			return index, true
+		}
+	}
+	return -1, false
+}
+
+func (player TournamentPlayer) HasPlayed(address string) bool {
+	for _, opponent := range player.Opponents {
+		if opponent == address {
+			return true
+		}
+	}
+	return false
+}
+
+func (player TournamentPlayer) IsEliminated() bool {
+	return player.EliminatedInRound != 0
+}
+
+// getTiebreaks sums, for each player, the scores of the opponents met so far.
+func (tournament Tournament) getTiebreaks() []uint64 {
+	scores := make(map[string]uint64, len(tournament.Players))
+	for _, player := range tournament.Players {
+		scores[player.Address] = player.Score
+	}
+	tiebreaks := make([]uint64, len(tournament.Players))
+	for index, player := range tournament.Players {
+		for _, opponent := range player.Opponents {
+			tiebreaks[index] += scores[opponent]
+		}
+	}
+	return tiebreaks
+}
+
+// getRankedPlayerIndices orders the players from first to last. In single elimination, players still in come first,
+// then those eliminated the latest. Ties are broken by score, then in Swiss by the opponents' scores, then by
+// order of registration.
+func (tournament Tournament) getRankedPlayerIndices() []int {
+	swiss := tournament.IsSwiss()
+	tiebreaks := tournament.getTiebreaks()
+	ranked := make([]int, len(tournament.Players))
+	for index := range ranked {
+		ranked[index] = index
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		first, second := tournament.Players[ranked[i]], tournament.Players[ranked[j]]
+		if !swiss {
+			if first.IsEliminated() != second.IsEliminated() {
+				return !first.IsEliminated()
+			}
+			if first.EliminatedInRound != second.EliminatedInRound {
+				return first.EliminatedInRound > second.EliminatedInRound
+			}
+		}
+		if first.Score != second.Score {
+			return first.Score > second.Score
+		}
+		if swiss {
+			return tiebreaks[ranked[i]] > tiebreaks[ranked[j]]
+		}
+		return false
+	})
+	return ranked
+}
+
+func (tournament Tournament) GetStandings() []TournamentStanding {
+	tiebreaks := tournament.getTiebreaks()
+	ranked := tournament.getRankedPlayerIndices()
+	standings := make([]TournamentStanding, len(ranked))
+	for rank, index := range ranked {
+		player := tournament.Players[index]
+		standings[rank] = TournamentStanding{
+			Rank:              uint64(rank + 1),
+			Address:           player.Address,
+			Score:             player.Score,
+			EliminatedInRound: player.EliminatedInRound,
+			Prize:             player.Prize,
+		}
+		if tournament.IsSwiss() {
+			standings[rank].Tiebreak = tiebreaks[index]
+		}
+	}
+	return standings
+}
+
+// giveBye gives a bye, worth a win, to the first candidate who has not had one yet, going from the last candidate
+// when lastFirst, or to the first one tried when all have had one. It returns the candidates left to pair.
+func (tournament *Tournament) giveBye(candidates []int, lastFirst bool) []int {
+	byeAt, step := 0, 1
+	if lastFirst {
+		byeAt, step = len(candidates)-1, -1
+	}
+	for at := byeAt; 0 <= at && at < len(candidates); at += step {
+		if !tournament.Players[candidates[at]].HadBye {
+			byeAt = at
+			break
+		}
+	}
+	player := &tournament.Players[candidates[byeAt]]
+	player.HadBye = true
+	player.Score += TournamentWinPoints
+	return append(candidates[:byeAt:byeAt], candidates[byeAt+1:]...)
+}
+
+// pair records that the two players meet, the first one being the higher placed one, who takes black in odd rounds.
+func (tournament *Tournament) pair(first int, second int) TournamentPairing {
+	firstPlayer, secondPlayer := &tournament.Players[first], &tournament.Players[second]
+	firstPlayer.Opponents = append(firstPlayer.Opponents, secondPlayer.Address)
+	secondPlayer.Opponents = append(secondPlayer.Opponents, firstPlayer.Address)
+	if tournament.CurrentRound%2 == 1 {
+		return TournamentPairing{Black: firstPlayer.Address, Red: secondPlayer.Address}
+	}
+	return TournamentPairing{Black: secondPlayer.Address, Red: firstPlayer.Address}
+}
+
+// PairRound pairs the players for the current round. An odd player out gets a bye, and nobody gets a second bye
+// while others have had none. Swiss pairs players of similar standing who have not met yet, while single
+// elimination pairs the best seed left with the worst.
+func (tournament *Tournament) PairRound() (pairings []TournamentPairing) {
+	if tournament.IsSwiss() {
+		candidates := tournament.getRankedPlayerIndices()
+		if len(candidates)%2 == 1 {
+			candidates = tournament.giveBye(candidates, true)
+		}
+		paired := make([]bool, len(candidates))
+		for i := range candidates {
+			if paired[i] {
+				continue
+			}
+			partner := -1
+			for j := i + 1; j < len(candidates); j++ {
+				if paired[j] {
+					continue
+				}
+				if partner == -1 {
+					partner = j
+				}
+				if !tournament.Players[candidates[i]].HasPlayed(tournament.Players[candidates[j]].Address) {
+					partner = j
+					break
+				}
+			}
+			paired[i], paired[partner] = true, true
+			pairings = append(pairings, tournament.pair(candidates[i], candidates[partner]))
+		}
+		return pairings
+	}
+	candidates := make([]int, 0, len(tournament.Players))
+	for index, player := range tournament.Players {
+		if !player.IsEliminated() {
+			candidates = append(candidates, index)
+		}
+	}
+	if len(candidates)%2 == 1 {
+		// Best seeds are spared first
+		candidates = tournament.giveBye(candidates, false)
+	}
+	for i := 0; i < len(candidates)/2; i++ {
+		pairings = append(pairings, tournament.pair(candidates[i], candidates[len(candidates)-1-i]))
+	}
+	return pairings
+}
+
+func (tournament *Tournament) eliminate(index int) {
+	if !tournament.IsSwiss() {
+		tournament.Players[index].EliminatedInRound = tournament.CurrentRound
+	}
+}
+
+// RecordResult scores a finished game of the current round. In single elimination, a draw sends the higher seed
+// through.
+func (tournament *Tournament) RecordResult(archivedGame ArchivedGame) {
+	black, found := tournament.GetPlayerIndex(archivedGame.Black)
+	if !found {
+		panic("black is not in the tournament: " + archivedGame.Black)
+	}
+	red, found := tournament.GetPlayerIndex(archivedGame.Red)
+	if !found {
+		panic("red is not in the tournament: " + archivedGame.Red)
+	}
+	switch archivedGame.Winner {
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		tournament.Players[black].Score += TournamentWinPoints
+		tournament.eliminate(red)
+	case rules.PieceStrings[rules.RED_PLAYER]:
+		tournament.Players[red].Score += TournamentWinPoints
+		tournament.eliminate(black)
+	case rules.PieceStrings[rules.DRAW_PLAYER]:
+		tournament.Players[black].Score += TournamentDrawPoints
+		tournament.Players[red].Score += TournamentDrawPoints
+		if black < red {
+			tournament.eliminate(red)
+		} else {
+			tournament.eliminate(black)
+		}
+	default:
+		panic(ErrThereIsNoWinner.Error())
+	}
+}
+
+// IsOver tells whether the last round has been played.
+func (tournament Tournament) IsOver() bool {
+	if tournament.IsSwiss() {
+		return tournament.Rounds <= tournament.CurrentRound
+	}
+	left := 0
+	for _, player := range tournament.Players {
+		if !player.IsEliminated() {
+			left++
+		}
+	}
+	return left <= 1
+}
+
+// DistributePrizePool sets the prize of each rank from its share of the pool, rounded down, with the remainder going
+// to the winner.
+func (tournament *Tournament) DistributePrizePool() {
+	ranked := tournament.getRankedPlayerIndices()
+	if len(ranked) == 0 {
+		return
+	}
+	shares := tournament.PrizeSharesBps
+	if len(shares) == 0 {
+		shares = []uint64{TotalPrizeSharesBps}
+	}
+	distributed := sdk.NewCoins()
+	for rank, share := range shares {
+		if len(ranked) <= rank {
+			break
+		}
+		prize := sdk.NewCoins()
+		for _, coin := range tournament.PrizePool {
+			prize = prize.Add(sdk.NewCoin(coin.Denom,
+				coin.Amount.Mul(sdk.NewIntFromUint64(share)).Quo(sdk.NewIntFromUint64(TotalPrizeSharesBps))))
+		}
+		tournament.Players[ranked[rank]].Prize = prize
+		distributed = distributed.Add(prize...)
+	}
+	winner := &tournament.Players[ranked[0]]
+	winner.Prize = winner.Prize.Add(tournament.PrizePool.Sub(distributed)...)
+}
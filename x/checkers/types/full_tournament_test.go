@@ -0,0 +1,200 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func GetTournamentOf(format string, rounds uint64, addresses ...string) types.Tournament {
+	tournament := types.Tournament{
+		Index:      "1",
+		Format:     format,
+		MaxPlayers: 8,
+		Rounds:     rounds,
+		Status:     types.TournamentStatusRunning,
+		PrizePool:  sdk.NewCoins(),
+	}
+	for _, address := range addresses {
+		tournament.Players = append(tournament.Players, types.TournamentPlayer{Address: address})
+	}
+	return tournament
+}
+
+func finishedGame(black string, red string, winner rules.Player) types.ArchivedGame {
+	return types.ArchivedGame{
+		Black:  black,
+		Red:    red,
+		Winner: rules.PieceStrings[winner],
+	}
+}
+
+func TestSwissFirstRoundPairsInRegistrationOrder(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 3, "a", "b", "c", "d")
+	tournament.CurrentRound = 1
+	require.EqualValues(t, []types.TournamentPairing{
+		{Black: "a", Red: "b"},
+		{Black: "c", Red: "d"},
+	}, tournament.PairRound())
+	require.EqualValues(t, []string{"b"}, tournament.Players[0].Opponents)
+	require.EqualValues(t, []string{"a"}, tournament.Players[1].Opponents)
+	require.EqualValues(t, []string{"d"}, tournament.Players[2].Opponents)
+	require.EqualValues(t, []string{"c"}, tournament.Players[3].Opponents)
+}
+
+func TestSwissOddPlayerGetsBye(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 3, "a", "b", "c", "d", "e")
+	tournament.CurrentRound = 1
+	require.EqualValues(t, []types.TournamentPairing{
+		{Black: "a", Red: "b"},
+		{Black: "c", Red: "d"},
+	}, tournament.PairRound())
+	require.True(t, tournament.Players[4].HadBye)
+	require.EqualValues(t, types.TournamentWinPoints, tournament.Players[4].Score)
+	require.Empty(t, tournament.Players[4].Opponents)
+}
+
+func TestSwissNoSecondByeWhileOthersHadNone(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 2, "a", "b", "c")
+	tournament.Players[2].HadBye = true
+	tournament.CurrentRound = 2
+	require.EqualValues(t, []types.TournamentPairing{
+		{Black: "c", Red: "a"},
+	}, tournament.PairRound())
+	require.True(t, tournament.Players[1].HadBye)
+}
+
+func TestSwissSecondRoundPairsByScore(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 3, "a", "b", "c", "d")
+	tournament.CurrentRound = 1
+	tournament.PairRound()
+	tournament.RecordResult(finishedGame("a", "b", rules.RED_PLAYER))
+	tournament.RecordResult(finishedGame("c", "d", rules.RED_PLAYER))
+	tournament.CurrentRound = 2
+	require.EqualValues(t, []types.TournamentPairing{
+		{Black: "d", Red: "b"},
+		{Black: "c", Red: "a"},
+	}, tournament.PairRound())
+}
+
+func TestSwissSecondRoundAvoidsRepeats(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 3, "a", "b", "c", "d")
+	tournament.CurrentRound = 1
+	tournament.PairRound()
+	tournament.RecordResult(finishedGame("a", "b", rules.DRAW_PLAYER))
+	tournament.RecordResult(finishedGame("c", "d", rules.DRAW_PLAYER))
+	tournament.CurrentRound = 2
+	require.EqualValues(t, []types.TournamentPairing{
+		{Black: "c", Red: "a"},
+		{Black: "d", Red: "b"},
+	}, tournament.PairRound())
+}
+
+func TestSwissIsOverAfterRounds(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 2, "a", "b", "c")
+	tournament.CurrentRound = 1
+	require.False(t, tournament.IsOver())
+	tournament.CurrentRound = 2
+	require.True(t, tournament.IsOver())
+}
+
+func TestSwissStandingsUseTiebreak(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 3, "a", "b", "c", "d")
+	tournament.CurrentRound = 1
+	tournament.PairRound()
+	tournament.RecordResult(finishedGame("a", "b", rules.BLACK_PLAYER))
+	tournament.RecordResult(finishedGame("c", "d", rules.BLACK_PLAYER))
+	tournament.CurrentRound = 2
+	tournament.PairRound()
+	tournament.RecordResult(finishedGame("c", "a", rules.BLACK_PLAYER))
+	tournament.RecordResult(finishedGame("d", "b", rules.BLACK_PLAYER))
+	require.EqualValues(t, []types.TournamentStanding{
+		{Rank: 1, Address: "c", Score: 4, Tiebreak: 4},
+		{Rank: 2, Address: "a", Score: 2, Tiebreak: 4},
+		{Rank: 3, Address: "d", Score: 2, Tiebreak: 4},
+		{Rank: 4, Address: "b", Score: 0, Tiebreak: 4},
+	}, tournament.GetStandings())
+}
+
+func TestEliminationPairsBestSeedWithWorst(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatElimination, 0, "a", "b", "c", "d")
+	tournament.CurrentRound = 1
+	require.EqualValues(t, []types.TournamentPairing{
+		{Black: "a", Red: "d"},
+		{Black: "b", Red: "c"},
+	}, tournament.PairRound())
+}
+
+func TestEliminationByeGoesToBestSeed(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatElimination, 0, "a", "b", "c")
+	tournament.CurrentRound = 1
+	require.EqualValues(t, []types.TournamentPairing{
+		{Black: "b", Red: "c"},
+	}, tournament.PairRound())
+	require.True(t, tournament.Players[0].HadBye)
+}
+
+func TestEliminationDrawSendsHigherSeedThrough(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatElimination, 0, "a", "b")
+	tournament.CurrentRound = 2
+	tournament.RecordResult(finishedGame("b", "a", rules.DRAW_PLAYER))
+	require.False(t, tournament.Players[0].IsEliminated())
+	require.EqualValues(t, 2, tournament.Players[1].EliminatedInRound)
+}
+
+func TestEliminationRunsToOneWinner(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatElimination, 0, "a", "b", "c", "d")
+	tournament.CurrentRound = 1
+	tournament.PairRound()
+	tournament.RecordResult(finishedGame("a", "d", rules.RED_PLAYER))
+	tournament.RecordResult(finishedGame("b", "c", rules.BLACK_PLAYER))
+	require.False(t, tournament.IsOver())
+	tournament.CurrentRound = 2
+	require.EqualValues(t, []types.TournamentPairing{
+		{Black: "d", Red: "b"},
+	}, tournament.PairRound())
+	tournament.RecordResult(finishedGame("d", "b", rules.BLACK_PLAYER))
+	require.True(t, tournament.IsOver())
+	require.EqualValues(t, []types.TournamentStanding{
+		{Rank: 1, Address: "d", Score: 4},
+		{Rank: 2, Address: "b", Score: 2, EliminatedInRound: 2},
+		{Rank: 3, Address: "a", Score: 0, EliminatedInRound: 1},
+		{Rank: 4, Address: "c", Score: 0, EliminatedInRound: 1},
+	}, tournament.GetStandings())
+}
+
+func TestRecordResultPanicsWithoutWinner(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 1, "a", "b")
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "RecordResult did not panic")
+		require.Equal(t, "there is no winner", r)
+	}()
+	tournament.RecordResult(finishedGame("a", "b", rules.NO_PLAYER))
+}
+
+func TestDistributePrizePoolByShares(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatSwiss, 1, "a", "b", "c", "d")
+	tournament.PrizeSharesBps = []uint64{6_000, 3_000, 1_000}
+	tournament.PrizePool = sdk.NewCoins(sdk.NewInt64Coin("stake", 103), sdk.NewInt64Coin("gold", 10))
+	tournament.Players[2].Score = 2
+	tournament.Players[0].Score = 1
+	tournament.Players[1].Score = 1
+	tournament.DistributePrizePool()
+	require.Equal(t, "6gold,63stake", tournament.Players[2].Prize.String())
+	require.Equal(t, "3gold,30stake", tournament.Players[0].Prize.String())
+	require.Equal(t, "1gold,10stake", tournament.Players[1].Prize.String())
+	require.True(t, tournament.Players[3].Prize.Empty())
+}
+
+func TestDistributePrizePoolWinnerTakesAll(t *testing.T) {
+	tournament := GetTournamentOf(types.TournamentFormatElimination, 0, "a", "b")
+	tournament.PrizePool = sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	tournament.Players[0].EliminatedInRound = 1
+	tournament.DistributePrizePool()
+	require.True(t, tournament.Players[0].Prize.Empty())
+	require.Equal(t, "20stake", tournament.Players[1].Prize.String())
+}
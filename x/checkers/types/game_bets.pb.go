@@ -0,0 +1,798 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/game_bets.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Bet struct {
+	Bettor string                                   `protobuf:"bytes,1,opt,name=bettor,proto3" json:"bettor,omitempty"`
+	Color  string                                   `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Bet) Reset()         { *m = Bet{} }
+func (m *Bet) String() string { return proto.CompactTextString(m) }
+func (*Bet) ProtoMessage()    {}
+func (*Bet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10bd03cf45043c0b, []int{0}
+}
+func (m *Bet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bet.Merge(m, src)
+}
+func (m *Bet) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bet proto.InternalMessageInfo
+
+func (m *Bet) GetBettor() string {
+	if m != nil {
+		return m.Bettor
+	}
+	return ""
+}
+
+func (m *Bet) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+func (m *Bet) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type GameBets struct {
+	GameIndex string                                   `protobuf:"bytes,1,opt,name=gameIndex,proto3" json:"gameIndex,omitempty"`
+	BlackPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=blackPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"blackPool"`
+	RedPool   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=redPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"redPool"`
+	Bets      []Bet                                    `protobuf:"bytes,4,rep,name=bets,proto3" json:"bets"`
+}
+
+func (m *GameBets) Reset()         { *m = GameBets{} }
+func (m *GameBets) String() string { return proto.CompactTextString(m) }
+func (*GameBets) ProtoMessage()    {}
+func (*GameBets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10bd03cf45043c0b, []int{1}
+}
+func (m *GameBets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameBets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameBets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameBets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameBets.Merge(m, src)
+}
+func (m *GameBets) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameBets) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameBets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameBets proto.InternalMessageInfo
+
+func (m *GameBets) GetGameIndex() string {
+	if m != nil {
+		return m.GameIndex
+	}
+	return ""
+}
+
+func (m *GameBets) GetBlackPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlackPool
+	}
+	return nil
+}
+
+func (m *GameBets) GetRedPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RedPool
+	}
+	return nil
+}
+
+func (m *GameBets) GetBets() []Bet {
+	if m != nil {
+		return m.Bets
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Bet)(nil), "b9lab.checkers.checkers.Bet")
+	proto.RegisterType((*GameBets)(nil), "b9lab.checkers.checkers.GameBets")
+}
+
+func init() { proto.RegisterFile("checkers/game_bets.proto", fileDescriptor_10bd03cf45043c0b) }
+
+var fileDescriptor_10bd03cf45043c0b = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xbd, 0x4e, 0xeb, 0x30,
+	0x14, 0x4e, 0xda, 0xde, 0xde, 0x5b, 0xdf, 0xcd, 0xaa, 0x20, 0x54, 0x55, 0x5a, 0x75, 0xaa, 0x90,
+	0xb0, 0x29, 0x48, 0x48, 0xac, 0x01, 0x09, 0xb1, 0xa1, 0x8e, 0x2c, 0xc8, 0x76, 0x8f, 0xd2, 0xa8,
+	0x49, 0x4e, 0x15, 0xbb, 0xa8, 0xbc, 0x05, 0x23, 0xcf, 0xc0, 0xc8, 0x53, 0x74, 0xec, 0xc8, 0x04,
+	0xa8, 0x7d, 0x11, 0x14, 0x27, 0x6d, 0x59, 0xd8, 0x60, 0xf2, 0xf9, 0xf9, 0xfc, 0x7d, 0x9f, 0x7d,
+	0x0e, 0xf1, 0xd4, 0x18, 0xd4, 0x04, 0x32, 0xcd, 0x43, 0x91, 0xc0, 0x9d, 0x04, 0xa3, 0xd9, 0x34,
+	0x43, 0x83, 0x74, 0x5f, 0x9e, 0xc7, 0x42, 0xb2, 0x4d, 0x7f, 0x1b, 0xb4, 0x9a, 0x21, 0x86, 0x68,
+	0x31, 0x3c, 0x8f, 0x0a, 0x78, 0xcb, 0x57, 0xa8, 0x13, 0xd4, 0x5c, 0x0a, 0x0d, 0xfc, 0x7e, 0x20,
+	0xc1, 0x88, 0x01, 0x57, 0x18, 0xa5, 0x45, 0xbf, 0xf7, 0xe4, 0x92, 0x6a, 0x00, 0x86, 0xee, 0x91,
+	0xba, 0x04, 0x63, 0x30, 0xf3, 0xdc, 0xae, 0xdb, 0x6f, 0x0c, 0xcb, 0x8c, 0x36, 0xc9, 0x1f, 0x85,
+	0x31, 0x66, 0x5e, 0xc5, 0x96, 0x8b, 0x84, 0x2a, 0x52, 0x17, 0x09, 0xce, 0x52, 0xe3, 0x55, 0xbb,
+	0xd5, 0xfe, 0xff, 0x93, 0x03, 0x56, 0xc8, 0xb0, 0x5c, 0x86, 0x95, 0x32, 0xec, 0x02, 0xa3, 0x34,
+	0x38, 0x5e, 0xbc, 0x75, 0x9c, 0xe7, 0xf7, 0x4e, 0x3f, 0x8c, 0xcc, 0x78, 0x26, 0x99, 0xc2, 0x84,
+	0x97, 0x9e, 0x8a, 0xe3, 0x48, 0x8f, 0x26, 0xdc, 0x3c, 0x4c, 0x41, 0xdb, 0x0b, 0x7a, 0x58, 0x52,
+	0xf7, 0x5e, 0x2a, 0xe4, 0xdf, 0x95, 0x48, 0x20, 0x00, 0xa3, 0x69, 0x9b, 0x34, 0xf2, 0x9f, 0xb8,
+	0x4e, 0x47, 0x30, 0x2f, 0x2d, 0xee, 0x0a, 0x34, 0x22, 0x0d, 0x19, 0x0b, 0x35, 0xb9, 0x41, 0x8c,
+	0xbd, 0xca, 0xcf, 0x5b, 0xda, 0xb1, 0x53, 0x20, 0x7f, 0x33, 0x18, 0x59, 0xa1, 0x5f, 0x78, 0xfb,
+	0x86, 0x9b, 0x9e, 0x91, 0x5a, 0x3e, 0x74, 0xaf, 0x66, 0x35, 0xda, 0xec, 0x9b, 0xa9, 0xb3, 0x00,
+	0x4c, 0x50, 0xcb, 0x65, 0x86, 0x16, 0x1f, 0x5c, 0x2e, 0x56, 0xbe, 0xbb, 0x5c, 0xf9, 0xee, 0xc7,
+	0xca, 0x77, 0x1f, 0xd7, 0xbe, 0xb3, 0x5c, 0xfb, 0xce, 0xeb, 0xda, 0x77, 0x6e, 0x0f, 0xbf, 0x98,
+	0xb0, 0x6c, 0x7c, 0xbb, 0x63, 0xf3, 0x5d, 0x68, 0xcd, 0xc8, 0xba, 0x5d, 0x8e, 0xd3, 0xcf, 0x01,
+	0x00, 0xb0, 0x4d, 0x2e, 0xa1, 0x87, 0x02, 0x00, 0x00,
+}
+
+func (m *Bet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGameBets(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Color) > 0 {
+		i -= len(m.Color)
+		copy(dAtA[i:], m.Color)
+		i = encodeVarintGameBets(dAtA, i, uint64(len(m.Color)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bettor) > 0 {
+		i -= len(m.Bettor)
+		copy(dAtA[i:], m.Bettor)
+		i = encodeVarintGameBets(dAtA, i, uint64(len(m.Bettor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameBets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameBets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameBets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bets) > 0 {
+		for iNdEx := len(m.Bets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGameBets(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RedPool) > 0 {
+		for iNdEx := len(m.RedPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGameBets(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlackPool) > 0 {
+		for iNdEx := len(m.BlackPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlackPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGameBets(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.GameIndex) > 0 {
+		i -= len(m.GameIndex)
+		copy(dAtA[i:], m.GameIndex)
+		i = encodeVarintGameBets(dAtA, i, uint64(len(m.GameIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGameBets(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGameBets(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Bet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bettor)
+	if l > 0 {
+		n += 1 + l + sovGameBets(uint64(l))
+	}
+	l = len(m.Color)
+	if l > 0 {
+		n += 1 + l + sovGameBets(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGameBets(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GameBets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GameIndex)
+	if l > 0 {
+		n += 1 + l + sovGameBets(uint64(l))
+	}
+	if len(m.BlackPool) > 0 {
+		for _, e := range m.BlackPool {
+			l = e.Size()
+			n += 1 + l + sovGameBets(uint64(l))
+		}
+	}
+	if len(m.RedPool) > 0 {
+		for _, e := range m.RedPool {
+			l = e.Size()
+			n += 1 + l + sovGameBets(uint64(l))
+		}
+	}
+	if len(m.Bets) > 0 {
+		for _, e := range m.Bets {
+			l = e.Size()
+			n += 1 + l + sovGameBets(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGameBets(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGameBets(x uint64) (n int) {
+	return sovGameBets(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Bet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGameBets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bettor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bettor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Color", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Color = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGameBets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameBets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGameBets
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameBets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameBets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlackPool = append(m.BlackPool, types.Coin{})
+			if err := m.BlackPool[len(m.BlackPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedPool = append(m.RedPool, types.Coin{})
+			if err := m.RedPool[len(m.RedPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bets = append(m.Bets, Bet{})
+			if err := m.Bets[len(m.Bets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGameBets(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGameBets
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGameBets(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGameBets
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGameBets
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGameBets
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGameBets
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGameBets
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGameBets        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGameBets          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGameBets = fmt.Errorf("proto: unexpected end of group")
+)
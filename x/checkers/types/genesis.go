@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultIndex is the default capability global index
@@ -11,15 +13,29 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		SystemInfo: SystemInfo{
-			NextId:        uint64(DefaultIndex),
-			FifoHeadIndex: NoFifoIndex,
-			FifoTailIndex: NoFifoIndex,
+			NextId:                 uint64(DefaultIndex),
+			NextChallengeId:        uint64(DefaultIndex),
+			ChallengeFifoHeadIndex: NoFifoIndex,
+			ChallengeFifoTailIndex: NoFifoIndex,
+			NextTournamentId:       uint64(DefaultIndex),
 		},
 		StoredGameList: []StoredGame{},
 		PlayerInfoList: []PlayerInfo{},
 		Leaderboard: Leaderboard{
 			Winners: []WinningPlayer{},
 		},
+		GameMoveList:     []GameMove{},
+		ChallengeList:    []Challenge{},
+		PlayerRatingList: []PlayerRating{},
+		RatingLeaderboard: RatingLeaderboard{
+			Players: []RatedPlayer{},
+		},
+		CollectedFees: CollectedFees{
+			Total: sdk.NewCoins(),
+		},
+		TournamentList:   []Tournament{},
+		GameBetsList:     []GameBets{},
+		ArchivedGameList: []ArchivedGame{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -48,10 +64,90 @@ func (gs GenesisState) Validate() error {
 		}
 		playerInfoIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in gameMove
+	gameMoveIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.GameMoveList {
+		index := string(GameMoveKey(elem.GameIndex, elem.MoveNumber))
+		if _, ok := gameMoveIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for gameMove")
+		}
+		gameMoveIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in challenge
+	challengeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ChallengeList {
+		index := string(ChallengeKey(elem.Index))
+		if _, ok := challengeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for challenge")
+		}
+		challengeIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in playerRating
+	playerRatingIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PlayerRatingList {
+		index := string(PlayerRatingKey(elem.Index))
+		if _, ok := playerRatingIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for playerRating")
+		}
+		playerRatingIndexMap[index] = struct{}{}
+		if elem.RatingDeviation == 0 {
+			return fmt.Errorf("playerRating %s has no rating deviation", elem.Index)
+		}
+	}
+	// Check for duplicated index in tournament
+	tournamentIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TournamentList {
+		index := string(TournamentKey(elem.Index))
+		if _, ok := tournamentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for tournament")
+		}
+		tournamentIndexMap[index] = struct{}{}
+		if err := elem.PrizePool.Validate(); err != nil {
+			return err
+		}
+	}
+	// Check for duplicated index in gameBets
+	gameBetsIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.GameBetsList {
+		index := string(GameBetsKey(elem.GameIndex))
+		if _, ok := gameBetsIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for gameBets")
+		}
+		gameBetsIndexMap[index] = struct{}{}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
+	// Check for duplicated index in archivedGame
+	archivedGameIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ArchivedGameList {
+		index := string(ArchivedGameKey(elem.Index))
+		if _, ok := archivedGameIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for archivedGame")
+		}
+		archivedGameIndexMap[index] = struct{}{}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+	}
 	// Validate Leaderboard
 	if err := gs.Leaderboard.Validate(); err != nil {
 		return err
 	}
+	// Validate RatingLeaderboard
+	if err := gs.RatingLeaderboard.Validate(); err != nil {
+		return err
+	}
+	// Validate CollectedFees
+	if err := gs.CollectedFees.Total.Validate(); err != nil {
+		return err
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -25,11 +25,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the checkers module's genesis state.
 type GenesisState struct {
-	Params         Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SystemInfo     SystemInfo   `protobuf:"bytes,2,opt,name=systemInfo,proto3" json:"systemInfo"`
-	StoredGameList []StoredGame `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
-	PlayerInfoList []PlayerInfo `protobuf:"bytes,4,rep,name=playerInfoList,proto3" json:"playerInfoList"`
-	Leaderboard    Leaderboard  `protobuf:"bytes,5,opt,name=leaderboard,proto3" json:"leaderboard"`
+	Params            Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SystemInfo        SystemInfo        `protobuf:"bytes,2,opt,name=systemInfo,proto3" json:"systemInfo"`
+	StoredGameList    []StoredGame      `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
+	PlayerInfoList    []PlayerInfo      `protobuf:"bytes,4,rep,name=playerInfoList,proto3" json:"playerInfoList"`
+	Leaderboard       Leaderboard       `protobuf:"bytes,5,opt,name=leaderboard,proto3" json:"leaderboard"`
+	GameMoveList      []GameMove        `protobuf:"bytes,6,rep,name=gameMoveList,proto3" json:"gameMoveList"`
+	ChallengeList     []Challenge       `protobuf:"bytes,7,rep,name=challengeList,proto3" json:"challengeList"`
+	PlayerRatingList  []PlayerRating    `protobuf:"bytes,8,rep,name=playerRatingList,proto3" json:"playerRatingList"`
+	RatingLeaderboard RatingLeaderboard `protobuf:"bytes,9,opt,name=ratingLeaderboard,proto3" json:"ratingLeaderboard"`
+	CollectedFees     CollectedFees     `protobuf:"bytes,10,opt,name=collectedFees,proto3" json:"collectedFees"`
+	TournamentList    []Tournament      `protobuf:"bytes,11,rep,name=tournamentList,proto3" json:"tournamentList"`
+	GameBetsList      []GameBets        `protobuf:"bytes,12,rep,name=gameBetsList,proto3" json:"gameBetsList"`
+	ArchivedGameList  []ArchivedGame    `protobuf:"bytes,13,rep,name=archivedGameList,proto3" json:"archivedGameList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +108,62 @@ func (m *GenesisState) GetLeaderboard() Leaderboard {
 	return Leaderboard{}
 }
 
+func (m *GenesisState) GetGameMoveList() []GameMove {
+	if m != nil {
+		return m.GameMoveList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallengeList() []Challenge {
+	if m != nil {
+		return m.ChallengeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPlayerRatingList() []PlayerRating {
+	if m != nil {
+		return m.PlayerRatingList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRatingLeaderboard() RatingLeaderboard {
+	if m != nil {
+		return m.RatingLeaderboard
+	}
+	return RatingLeaderboard{}
+}
+
+func (m *GenesisState) GetCollectedFees() CollectedFees {
+	if m != nil {
+		return m.CollectedFees
+	}
+	return CollectedFees{}
+}
+
+func (m *GenesisState) GetTournamentList() []Tournament {
+	if m != nil {
+		return m.TournamentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGameBetsList() []GameBets {
+	if m != nil {
+		return m.GameBetsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedGameList() []ArchivedGame {
+	if m != nil {
+		return m.ArchivedGameList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "b9lab.checkers.checkers.GenesisState")
 }
@@ -107,28 +171,43 @@ func init() {
 func init() { proto.RegisterFile("checkers/genesis.proto", fileDescriptor_6e928243c164a8dc) }
 
 var fileDescriptor_6e928243c164a8dc = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xb2, 0xcc, 0x49, 0x4c, 0xd2, 0x83, 0xc9, 0xc2, 0x19, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x94, 0x28, 0xdc, 0x98, 0x82,
-	0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x29, 0x52, 0x52, 0x70, 0xe1, 0xe2, 0xca, 0xe2, 0x92, 0xd4, 0xdc,
-	0xf8, 0xcc, 0xbc, 0xb4, 0x7c, 0x4c, 0xb9, 0x92, 0xfc, 0xa2, 0xd4, 0x94, 0xf8, 0xf4, 0xc4, 0xdc,
-	0x54, 0x0c, 0xb9, 0x82, 0x9c, 0xc4, 0xca, 0xd4, 0x22, 0xec, 0xfa, 0x72, 0x52, 0x13, 0x53, 0x52,
-	0x8b, 0x92, 0xf2, 0x13, 0x8b, 0x52, 0x20, 0x72, 0x4a, 0x3d, 0xcc, 0x5c, 0x3c, 0xee, 0x10, 0x7f,
-	0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xd9, 0x72, 0xb1, 0x41, 0x1c, 0x24, 0xc1, 0xa8, 0xc0, 0xa8,
-	0xc1, 0x6d, 0x24, 0xaf, 0x87, 0xc3, 0x5f, 0x7a, 0x01, 0x60, 0x65, 0x4e, 0x2c, 0x27, 0xee, 0xc9,
-	0x33, 0x04, 0x41, 0x35, 0x09, 0x79, 0x72, 0x71, 0x41, 0x1c, 0xee, 0x99, 0x97, 0x96, 0x2f, 0xc1,
-	0x04, 0x36, 0x42, 0x19, 0xa7, 0x11, 0xc1, 0x70, 0xa5, 0x50, 0x63, 0x90, 0x34, 0x0b, 0x05, 0x72,
-	0xf1, 0x41, 0xfc, 0xe9, 0x9e, 0x98, 0x9b, 0xea, 0x93, 0x59, 0x5c, 0x22, 0xc1, 0xac, 0xc0, 0x8c,
-	0xdf, 0x38, 0xb8, 0x72, 0xa8, 0x71, 0x68, 0x06, 0x80, 0x8c, 0x84, 0x04, 0x0f, 0xc8, 0x02, 0xb0,
-	0x91, 0x2c, 0x04, 0x8c, 0x0c, 0x80, 0x2b, 0x87, 0x19, 0x89, 0x6a, 0x80, 0x90, 0x0f, 0x17, 0x37,
-	0x52, 0xa8, 0x4a, 0xb0, 0x82, 0x7d, 0xac, 0x82, 0xd3, 0x3c, 0x1f, 0x84, 0x5a, 0xa8, 0x81, 0xc8,
-	0xda, 0x9d, 0x5c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2b, 0x3d,
-	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6c, 0xb8, 0x3e, 0x3c, 0x56, 0x2b,
-	0x10, 0xcc, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xdc, 0x1a, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xe4, 0xf1, 0x44, 0xf0, 0xab, 0x02, 0x00, 0x00,
+	// 564 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x13, 0x5a, 0x42, 0x99, 0x24, 0x08, 0x46, 0x5c, 0x4c, 0x04, 0xee, 0x85, 0x8b, 0x50,
+	0x17, 0x89, 0x04, 0x2b, 0x16, 0x2c, 0x08, 0x88, 0xa8, 0x22, 0xa0, 0x92, 0x22, 0x21, 0xb1, 0x20,
+	0x1a, 0x3b, 0x27, 0x8e, 0x85, 0xed, 0x89, 0x66, 0xa6, 0x11, 0x79, 0x0b, 0xde, 0x84, 0xd7, 0xe8,
+	0xb2, 0x4b, 0x56, 0x08, 0x25, 0x2f, 0x82, 0x3c, 0xb7, 0xd8, 0x71, 0x4d, 0xba, 0xb3, 0xe6, 0xfc,
+	0xff, 0x37, 0x33, 0xe7, 0x9f, 0x63, 0x74, 0xd7, 0x9f, 0x80, 0xff, 0x1d, 0x18, 0xef, 0x04, 0x90,
+	0x00, 0x0f, 0x79, 0x7b, 0xca, 0xa8, 0xa0, 0xf8, 0x9e, 0xf7, 0x32, 0x22, 0x5e, 0xdb, 0x54, 0xed,
+	0x47, 0xeb, 0x76, 0x40, 0x03, 0x2a, 0x35, 0x9d, 0xf4, 0x4b, 0xc9, 0x5b, 0x77, 0x2c, 0x66, 0x4a,
+	0x18, 0x89, 0x35, 0xa5, 0xd5, 0xb2, 0xcb, 0x7c, 0xce, 0x05, 0xc4, 0xc3, 0x30, 0x19, 0xd3, 0x62,
+	0x4d, 0x50, 0x06, 0xa3, 0x61, 0x40, 0x62, 0x28, 0xd4, 0xa6, 0x11, 0x99, 0x03, 0xbb, 0xd8, 0x17,
+	0x01, 0x19, 0x01, 0xf3, 0x28, 0x61, 0x23, 0x5d, 0x73, 0x56, 0xb7, 0x21, 0x31, 0x0c, 0x63, 0x3a,
+	0x83, 0x42, 0xc5, 0x9f, 0x90, 0x28, 0x82, 0x24, 0x30, 0x95, 0x07, 0xeb, 0x7b, 0x31, 0x22, 0xc2,
+	0x24, 0xd0, 0xd5, 0x7d, 0x5b, 0x55, 0xcb, 0xc3, 0xe2, 0xa6, 0x0f, 0x57, 0x68, 0x1a, 0x45, 0xe0,
+	0x0b, 0x18, 0x0d, 0xc7, 0x00, 0xa6, 0x07, 0xf7, 0x6d, 0x59, 0xd0, 0x53, 0x96, 0x90, 0x18, 0x12,
+	0x71, 0xf1, 0x71, 0x3d, 0x10, 0xbc, 0x70, 0x28, 0xc2, 0xfc, 0x49, 0x38, 0xcb, 0xb5, 0xe7, 0xe0,
+	0xd7, 0x0e, 0x6a, 0xf4, 0x54, 0x5c, 0x27, 0x82, 0x08, 0xc0, 0xaf, 0x50, 0x4d, 0xf5, 0xdd, 0xa9,
+	0xee, 0x55, 0x9f, 0xd5, 0x9f, 0xef, 0xb6, 0x4b, 0xe2, 0x6b, 0x1f, 0x4b, 0x59, 0x77, 0xfb, 0xec,
+	0xcf, 0x6e, 0x65, 0xa0, 0x4d, 0xf8, 0x08, 0x21, 0x95, 0xcf, 0x51, 0x32, 0xa6, 0xce, 0x15, 0x89,
+	0x78, 0x54, 0x8a, 0x38, 0xb1, 0x52, 0x8d, 0xc9, 0x98, 0xf1, 0x27, 0x74, 0x43, 0xc5, 0xd9, 0x23,
+	0x31, 0xf4, 0x43, 0x2e, 0x9c, 0xad, 0xbd, 0xad, 0xff, 0xe3, 0xac, 0x5c, 0xe3, 0xd6, 0x00, 0x29,
+	0x52, 0x25, 0x93, 0x6e, 0x20, 0x91, 0xdb, 0x1b, 0x90, 0xc7, 0x56, 0x6e, 0x90, 0x79, 0x00, 0xee,
+	0xa3, 0x7a, 0x26, 0x47, 0xe7, 0xaa, 0xbc, 0xf1, 0xe3, 0x52, 0x5e, 0x7f, 0xa5, 0xd5, 0xc0, 0xac,
+	0x1d, 0xbf, 0x47, 0x8d, 0x34, 0x9c, 0x0f, 0x74, 0xa6, 0x6e, 0x5c, 0x93, 0xc7, 0xdb, 0x2f, 0xc5,
+	0xf5, 0xb4, 0x58, 0xb3, 0x72, 0x66, 0xfc, 0x11, 0x35, 0xed, 0x0b, 0x95, 0xb4, 0x6b, 0x92, 0x76,
+	0x50, 0x4a, 0x7b, 0x63, 0xd4, 0x1a, 0x97, 0xb7, 0xe3, 0x2f, 0xe8, 0xa6, 0xba, 0xfc, 0x40, 0xbe,
+	0x5f, 0x89, 0xdc, 0x91, 0xc8, 0x27, 0x1b, 0xfa, 0xa7, 0x0c, 0x9a, 0x5a, 0x80, 0xe0, 0x6f, 0xe8,
+	0x96, 0x1a, 0x89, 0x4c, 0x77, 0x9c, 0xeb, 0xb2, 0x93, 0x87, 0xa5, 0xe4, 0xc1, 0xba, 0x43, 0xe3,
+	0x8b, 0x28, 0x3c, 0x40, 0x4d, 0x3b, 0x4f, 0xef, 0x00, 0xb8, 0x83, 0x24, 0xfb, 0x69, 0x79, 0x23,
+	0xb2, 0x6a, 0xdb, 0x8c, 0xec, 0x62, 0xfa, 0x94, 0x56, 0x43, 0x28, 0x5b, 0x51, 0xdf, 0xf0, 0x94,
+	0x3e, 0x5b, 0xb9, 0x79, 0x4a, 0x79, 0x80, 0x09, 0xbf, 0x0b, 0x82, 0x4b, 0x60, 0xe3, 0x12, 0xe1,
+	0xa7, 0xe2, 0x6c, 0xf8, 0xc6, 0x9c, 0x86, 0x65, 0xe6, 0xdd, 0xce, 0x4f, 0x73, 0x43, 0x58, 0xaf,
+	0x33, 0x06, 0x13, 0xd6, 0x3a, 0xa4, 0xfb, 0xf6, 0x6c, 0xe1, 0x56, 0xcf, 0x17, 0x6e, 0xf5, 0xef,
+	0xc2, 0xad, 0xfe, 0x5c, 0xba, 0x95, 0xf3, 0xa5, 0x5b, 0xf9, 0xbd, 0x74, 0x2b, 0x5f, 0x0f, 0x83,
+	0x50, 0x4c, 0x4e, 0xbd, 0xb6, 0x4f, 0xe3, 0x8e, 0xdc, 0xa2, 0x63, 0x7f, 0x3d, 0x3f, 0x56, 0x9f,
+	0x62, 0x3e, 0x05, 0xee, 0xd5, 0xe4, 0xef, 0xe7, 0xc5, 0xbf, 0x01, 0x00, 0xbc, 0x7d, 0x6c, 0xee,
+	0x35, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +230,110 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ArchivedGameList) > 0 {
+		for iNdEx := len(m.ArchivedGameList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedGameList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.GameBetsList) > 0 {
+		for iNdEx := len(m.GameBetsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GameBetsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TournamentList) > 0 {
+		for iNdEx := len(m.TournamentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TournamentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.CollectedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.RatingLeaderboard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.PlayerRatingList) > 0 {
+		for iNdEx := len(m.PlayerRatingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerRatingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChallengeList) > 0 {
+		for iNdEx := len(m.ChallengeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GameMoveList) > 0 {
+		for iNdEx := len(m.GameMoveList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GameMoveList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size, err := m.Leaderboard.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -247,6 +430,46 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Leaderboard.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.GameMoveList) > 0 {
+		for _, e := range m.GameMoveList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChallengeList) > 0 {
+		for _, e := range m.ChallengeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PlayerRatingList) > 0 {
+		for _, e := range m.PlayerRatingList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RatingLeaderboard.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CollectedFees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TournamentList) > 0 {
+		for _, e := range m.TournamentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GameBetsList) > 0 {
+		for _, e := range m.GameBetsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedGameList) > 0 {
+		for _, e := range m.ArchivedGameList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -452,6 +675,276 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameMoveList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameMoveList = append(m.GameMoveList, GameMove{})
+			if err := m.GameMoveList[len(m.GameMoveList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeList = append(m.ChallengeList, Challenge{})
+			if err := m.ChallengeList[len(m.ChallengeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerRatingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerRatingList = append(m.PlayerRatingList, PlayerRating{})
+			if err := m.PlayerRatingList[len(m.PlayerRatingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatingLeaderboard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RatingLeaderboard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentList = append(m.TournamentList, Tournament{})
+			if err := m.TournamentList[len(m.TournamentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameBetsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameBetsList = append(m.GameBetsList, GameBets{})
+			if err := m.GameBetsList[len(m.GameBetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedGameList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedGameList = append(m.ArchivedGameList, ArchivedGame{})
+			if err := m.ArchivedGameList[len(m.ArchivedGameList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
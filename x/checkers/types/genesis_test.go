@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,7 +22,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
 				SystemInfo: types.SystemInfo{
 					NextId: 39,
 				},
@@ -51,6 +52,81 @@ func TestGenesisState_Validate(t *testing.T) {
 						},
 					},
 				},
+				GameMoveList: []types.GameMove{
+					{
+						GameIndex:  "0",
+						MoveNumber: 0,
+					},
+					{
+						GameIndex:  "0",
+						MoveNumber: 1,
+					},
+				},
+				ChallengeList: []types.Challenge{
+					{
+						Index: "0",
+					},
+					{
+						Index: "1",
+					},
+				},
+				PlayerRatingList: []types.PlayerRating{
+					{
+						Index:           "0",
+						RatingDeviation: 350,
+					},
+					{
+						Index:           "1",
+						RatingDeviation: 350,
+					},
+				},
+				RatingLeaderboard: types.RatingLeaderboard{
+					Players: []types.RatedPlayer{
+						{
+							PlayerAddress: "cosmos123",
+						},
+						{
+							PlayerAddress: "cosmos456",
+						},
+					},
+				},
+				CollectedFees: types.CollectedFees{
+					Total: sdk.NewCoins(sdk.NewInt64Coin("stake", 12)),
+				},
+				TournamentList: []types.Tournament{
+					{
+						Index: "1",
+					},
+					{
+						Index: "2",
+					},
+				},
+				GameBetsList: []types.GameBets{
+					{
+						GameIndex: "1",
+						BlackPool: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+						RedPool:   sdk.NewCoins(),
+						Bets: []types.Bet{
+							{
+								Bettor: "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+								Color:  "b",
+								Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+							},
+						},
+					},
+					{
+						GameIndex: "2",
+					},
+				},
+				ArchivedGameList: []types.ArchivedGame{
+					{
+						Index:  "3",
+						Black:  "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Red:    "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Winner: "b",
+						Reason: "resign",
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -99,6 +175,172 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated gameMove",
+			genState: &types.GenesisState{
+				GameMoveList: []types.GameMove{
+					{
+						GameIndex:  "0",
+						MoveNumber: 1,
+					},
+					{
+						GameIndex:  "0",
+						MoveNumber: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated challenge",
+			genState: &types.GenesisState{
+				ChallengeList: []types.Challenge{
+					{
+						Index: "0",
+					},
+					{
+						Index: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated playerRating",
+			genState: &types.GenesisState{
+				PlayerRatingList: []types.PlayerRating{
+					{
+						Index:           "0",
+						RatingDeviation: 350,
+					},
+					{
+						Index:           "0",
+						RatingDeviation: 350,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "playerRating without deviation",
+			genState: &types.GenesisState{
+				PlayerRatingList: []types.PlayerRating{
+					{
+						Index: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ratedPlayer",
+			genState: &types.GenesisState{
+				RatingLeaderboard: types.RatingLeaderboard{
+					Players: []types.RatedPlayer{
+						{
+							PlayerAddress: "0",
+						},
+						{
+							PlayerAddress: "0",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unsorted collectedFees",
+			genState: &types.GenesisState{
+				CollectedFees: types.CollectedFees{
+					Total: sdk.Coins{sdk.NewInt64Coin("token", 1), sdk.NewInt64Coin("stake", 2)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated tournament",
+			genState: &types.GenesisState{
+				TournamentList: []types.Tournament{
+					{
+						Index: "1",
+					},
+					{
+						Index: "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated gameBets",
+			genState: &types.GenesisState{
+				GameBetsList: []types.GameBets{
+					{
+						GameIndex: "1",
+					},
+					{
+						GameIndex: "1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "gameBets pools not adding up",
+			genState: &types.GenesisState{
+				GameBetsList: []types.GameBets{
+					{
+						GameIndex: "1",
+						BlackPool: sdk.NewCoins(sdk.NewInt64Coin("stake", 6)),
+						Bets: []types.Bet{
+							{
+								Bettor: "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+								Color:  "b",
+								Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated archivedGame",
+			genState: &types.GenesisState{
+				ArchivedGameList: []types.ArchivedGame{
+					{
+						Index:  "1",
+						Black:  "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Red:    "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Winner: "b",
+						Reason: "resign",
+					},
+					{
+						Index:  "1",
+						Black:  "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Red:    "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Winner: "b",
+						Reason: "resign",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "archivedGame with unknown reason",
+			genState: &types.GenesisState{
+				ArchivedGameList: []types.ArchivedGame{
+					{
+						Index:  "1",
+						Black:  "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Red:    "cosmos1jmjfq0tplp9tmx4v9uemw72y4d2wa5nr3xn9d3",
+						Winner: "b",
+						Reason: "timeout",
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -115,16 +357,31 @@ func TestGenesisState_Validate(t *testing.T) {
 func TestDefaultGenesisState_ExpectedInitialNextId(t *testing.T) {
 	require.EqualValues(t,
 		&types.GenesisState{
+			Params:         types.DefaultParams(),
 			StoredGameList: []types.StoredGame{},
 			SystemInfo: types.SystemInfo{
-				NextId:        uint64(1),
-				FifoHeadIndex: "-1",
-				FifoTailIndex: "-1",
+				NextId:                 uint64(1),
+				NextChallengeId:        1,
+				ChallengeFifoHeadIndex: "-1",
+				ChallengeFifoTailIndex: "-1",
+				NextTournamentId:       1,
 			},
 			PlayerInfoList: []types.PlayerInfo{},
 			Leaderboard: types.Leaderboard{
 				Winners: []types.WinningPlayer{},
 			},
+			GameMoveList:     []types.GameMove{},
+			ChallengeList:    []types.Challenge{},
+			PlayerRatingList: []types.PlayerRating{},
+			RatingLeaderboard: types.RatingLeaderboard{
+				Players: []types.RatedPlayer{},
+			},
+			CollectedFees: types.CollectedFees{
+				Total: sdk.NewCoins(),
+			},
+			TournamentList:   []types.Tournament{},
+			GameBetsList:     []types.GameBets{},
+			ArchivedGameList: []types.ArchivedGame{},
 		},
 		types.DefaultGenesis())
 }
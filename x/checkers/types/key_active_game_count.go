@@ -0,0 +1,19 @@
+package types
+
+const (
+	// ActiveGameCountKeyPrefix is the prefix to retrieve all active game counts
+	ActiveGameCountKeyPrefix = "ActiveGameCount/value/"
+)
+
+// ActiveGameCountKey returns the store key to retrieve the active game count of a player
+func ActiveGameCountKey(
+	player string,
+) []byte {
+	var key []byte
+
+	playerBytes := []byte(player)
+	key = append(key, playerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,49 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ArchivedGameKeyPrefix is the prefix to retrieve all ArchivedGame
+	ArchivedGameKeyPrefix = "ArchivedGame/value/"
+	// ArchivedGameByHeightKeyPrefix is the prefix to retrieve the indices of archived games in the order they ended
+	ArchivedGameByHeightKeyPrefix = "ArchivedGame/height/"
+	// MaxArchivedGamesPrunedPerBlock bounds how many archived games are looked at for pruning in one block
+	MaxArchivedGamesPrunedPerBlock = 100
+)
+
+// ArchivedGameKey returns the store key to retrieve an ArchivedGame from the index fields
+func ArchivedGameKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ArchivedGameByHeightEndKey returns the store key that comes after all the games that ended at the height
+func ArchivedGameByHeightEndKey(
+	endHeight int64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(endHeight)+1)
+	return key
+}
+
+// ArchivedGameByHeightKey returns the store key that orders an archived game by the height at which it ended
+func ArchivedGameByHeightKey(
+	endHeight int64,
+	index string,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(endHeight))
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// GameBetsKeyPrefix is the prefix to retrieve all GameBets
+	GameBetsKeyPrefix = "GameBets/value/"
+)
+
+// GameBetsKey returns the store key to retrieve a GameBets from the index fields
+func GameBetsKey(
+	gameIndex string,
+) []byte {
+	var key []byte
+
+	gameIndexBytes := []byte(gameIndex)
+	key = append(key, gameIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
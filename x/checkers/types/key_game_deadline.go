@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GameDeadlineKeyPrefix is the prefix to retrieve the active games by deadline
+	GameDeadlineKeyPrefix = "GameDeadline/value/"
+	// InvitationDeadlineKeyPrefix is the prefix to retrieve the pending games by invitation deadline, with the same
+	// keys as the active games
+	InvitationDeadlineKeyPrefix = "InvitationDeadline/value/"
+)
+
+// GameDeadlinesKey returns the store key prefix of the games expiring at deadline. It sorts like the deadline, so
+// that the games expiring before deadline are the keys below it.
+func GameDeadlinesKey(
+	deadline time.Time,
+) []byte {
+	var key []byte
+
+	deadlineBytes := sdk.FormatTimeBytes(deadline)
+	key = append(key, deadlineBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GameDeadlineKey returns the store key of the game in the queue of active games by deadline
+func GameDeadlineKey(
+	deadline time.Time,
+	gameIndex string,
+) []byte {
+	key := GameDeadlinesKey(deadline)
+
+	gameIndexBytes := []byte(gameIndex)
+	key = append(key, gameIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
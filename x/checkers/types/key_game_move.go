@@ -0,0 +1,38 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// GameMoveKeyPrefix is the prefix to retrieve all GameMove
+	GameMoveKeyPrefix = "GameMove/value/"
+)
+
+// GameMovesKey returns the store key prefix to retrieve all the GameMove of a game
+func GameMovesKey(
+	gameIndex string,
+) []byte {
+	var key []byte
+
+	gameIndexBytes := []byte(gameIndex)
+	key = append(key, gameIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GameMoveKey returns the store key to retrieve a GameMove from the index fields
+func GameMoveKey(
+	gameIndex string,
+	moveNumber uint64,
+) []byte {
+	key := GameMovesKey(gameIndex)
+
+	moveNumberBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(moveNumberBytes, moveNumber)
+	key = append(key, moveNumberBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,33 @@
+package types
+
+const (
+	// PlayerGameKeyPrefix is the prefix to retrieve all the game indices of players
+	PlayerGameKeyPrefix = "PlayerGame/value/"
+)
+
+// PlayerGamesKey returns the store key prefix to retrieve all the game indices of a player
+func PlayerGamesKey(
+	player string,
+) []byte {
+	var key []byte
+
+	playerBytes := []byte(player)
+	key = append(key, playerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PlayerGameKey returns the store key to retrieve the status of a game of a player
+func PlayerGameKey(
+	player string,
+	gameIndex string,
+) []byte {
+	key := PlayerGamesKey(player)
+
+	gameIndexBytes := []byte(gameIndex)
+	key = append(key, gameIndexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// PlayerRatingKeyPrefix is the prefix to retrieve all PlayerRating
+	PlayerRatingKeyPrefix = "PlayerRating/value/"
+)
+
+// PlayerRatingKey returns the store key to retrieve a PlayerRating from the index fields
+func PlayerRatingKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -17,6 +17,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_checkers"
+
+	// BetEscrowName is the module account that holds the spectator bets apart from the wagers
+	BetEscrowName = "checkers_bets"
 )
 
 func KeyPrefix(p string) []byte {
@@ -27,6 +30,7 @@ const (
 	SystemInfoKey = "SystemInfo-value-"
 )
 
+// Deprecated: the typed EventGameCreated carries the same information. These attributes are still emitted for a while.
 const (
 	GameCreatedEventType      = "new-game-created" // Indicates what event type to listen to
 	GameCreatedEventCreator   = "creator"          // Subsidiary information
@@ -34,19 +38,27 @@ const (
 	GameCreatedEventBlack     = "black"            // Is it relevant to me?
 	GameCreatedEventRed       = "red"              // Is it relevant to me?
 	GameCreatedEventWager     = "wager"
-	GameCreatedEventDenom     = "denom"
 )
 
+// Deprecated: the typed EventMovePlayed carries the same information. These attributes are still emitted for a while.
 const (
-	MovePlayedEventType      = "move-played"
-	MovePlayedEventCreator   = "creator"
-	MovePlayedEventGameIndex = "game-index"
-	MovePlayedEventCapturedX = "captured-x"
-	MovePlayedEventCapturedY = "captured-y"
-	MovePlayedEventWinner    = "winner"
-	MovePlayedEventBoard     = "board"
+	MovePlayedEventType         = "move-played"
+	MovePlayedEventCreator      = "creator"
+	MovePlayedEventGameIndex    = "game-index"
+	MovePlayedEventCapturedX    = "captured-x"
+	MovePlayedEventCapturedY    = "captured-y"
+	MovePlayedEventCaptured     = "captured"
+	MovePlayedEventWinner       = "winner"
+	MovePlayedEventBoard        = "board"
+	MovePlayedEventPositionHash = "position-hash"
 )
 
+const (
+	PositionSeparator  = ","
+	PositionsSeparator = "|"
+)
+
+// Deprecated: the typed EventGameRejected carries the same information. These attributes are still emitted for a while.
 const (
 	GameRejectedEventType      = "game-rejected"
 	GameRejectedEventCreator   = "creator"
@@ -58,10 +70,11 @@ const (
 )
 
 const (
-	MaxTurnDuration = time.Duration(24 * 3_600 * 1000_000_000) // 1 day
-	DeadlineLayout  = "2006-01-02 15:04:05.999999999 +0000 UTC"
+	MaxChallengeDuration = time.Duration(24 * 3_600 * 1000_000_000) // 1 day
+	DeadlineLayout       = "2006-01-02 15:04:05.999999999 +0000 UTC"
 )
 
+// Deprecated: the typed EventGameForfeited carries the same information. These attributes are still emitted for a while.
 const (
 	GameForfeitedEventType      = "game-forfeited"
 	GameForfeitedEventGameIndex = "game-index"
@@ -70,16 +83,179 @@ const (
 )
 
 const (
-	CreateGameGas       = 15000
-	PlayMoveGas         = 1000
-	RejectGameRefundGas = 14000
+	DrawOfferedEventType      = "draw-offered"
+	DrawOfferedEventCreator   = "creator"
+	DrawOfferedEventGameIndex = "game-index"
+)
+
+const (
+	DrawDeclinedEventType      = "draw-declined"
+	DrawDeclinedEventCreator   = "creator"
+	DrawDeclinedEventGameIndex = "game-index"
+)
+
+// Deprecated: the typed EventGameDrawn carries the same information. These attributes are still emitted for a while.
+const (
+	GameDrawnEventType      = "game-drawn"
+	GameDrawnEventCreator   = "creator"
+	GameDrawnEventGameIndex = "game-index"
+	GameDrawnEventBoard     = "board"
+)
+
+const (
+	RematchProposedEventType      = "rematch-proposed"
+	RematchProposedEventCreator   = "creator"
+	RematchProposedEventGameIndex = "game-index"
+)
+
+const (
+	RematchAcceptedEventType         = "rematch-accepted"
+	RematchAcceptedEventCreator      = "creator"
+	RematchAcceptedEventGameIndex    = "game-index"
+	RematchAcceptedEventNewGameIndex = "new-game-index"
+)
+
+// Deprecated: the typed EventGameResigned carries the same information. These attributes are still emitted for a while.
+const (
+	GameResignedEventType      = "game-resigned"
+	GameResignedEventCreator   = "creator"
+	GameResignedEventGameIndex = "game-index"
+	GameResignedEventWinner    = "winner"
+	GameResignedEventBoard     = "board"
+)
+
+const (
+	GameAcceptedEventType      = "game-accepted"
+	GameAcceptedEventCreator   = "creator"
+	GameAcceptedEventGameIndex = "game-index"
+	GameAcceptedEventLive      = "live"
+)
+
+const (
+	InvitationExpiredEventType      = "invitation-expired"
+	InvitationExpiredEventGameIndex = "game-index"
+)
+
+const (
+	ChallengeOpenedEventType           = "challenge-opened"
+	ChallengeOpenedEventCreator        = "creator"
+	ChallengeOpenedEventChallengeIndex = "challenge-index"
+	ChallengeOpenedEventWager          = "wager"
+	ChallengeOpenedEventColor          = "color"
+	ChallengeOpenedEventVariant        = "variant"
+)
+
+const (
+	ChallengeAcceptedEventType           = "challenge-accepted"
+	ChallengeAcceptedEventCreator        = "creator"
+	ChallengeAcceptedEventChallengeIndex = "challenge-index"
+	ChallengeAcceptedEventGameIndex      = "game-index"
+)
+
+const (
+	ChallengeExpiredEventType           = "challenge-expired"
+	ChallengeExpiredEventChallengeIndex = "challenge-index"
+)
+
+const (
+	WinningsPaidEventType         = "winnings-paid"
+	WinningsPaidEventGameIndex    = "game-index"
+	WinningsPaidEventWinner       = "winner"
+	WinningsPaidEventPayout       = "payout"
+	WinningsPaidEventFee          = "fee"
+	WinningsPaidEventFeeCollector = "fee-collector"
+)
+
+const (
+	TournamentCreatedEventType            = "tournament-created"
+	TournamentCreatedEventCreator         = "creator"
+	TournamentCreatedEventTournamentIndex = "tournament-index"
+	TournamentCreatedEventFormat          = "format"
+	TournamentCreatedEventEntryFee        = "entry-fee"
+)
+
+const (
+	TournamentJoinedEventType            = "tournament-joined"
+	TournamentJoinedEventCreator         = "creator"
+	TournamentJoinedEventTournamentIndex = "tournament-index"
+)
+
+const (
+	TournamentRoundStartedEventType            = "tournament-round-started"
+	TournamentRoundStartedEventTournamentIndex = "tournament-index"
+	TournamentRoundStartedEventRound           = "round"
+	TournamentRoundStartedEventGameIndices     = "game-indices"
+)
+
+const (
+	TournamentFinishedEventType            = "tournament-finished"
+	TournamentFinishedEventTournamentIndex = "tournament-index"
+	TournamentFinishedEventWinner          = "winner"
+)
+
+const (
+	BetPlacedEventType      = "bet-placed"
+	BetPlacedEventCreator   = "creator"
+	BetPlacedEventGameIndex = "game-index"
+	BetPlacedEventColor     = "color"
+	BetPlacedEventAmount    = "amount"
+)
+
+const (
+	BetsSettledEventType      = "bets-settled"
+	BetsSettledEventGameIndex = "game-index"
+	BetsSettledEventWinner    = "winner"
+	BetsSettledEventPool      = "pool"
+)
+
+const (
+	TournamentFormatSwiss       = "swiss"
+	TournamentFormatElimination = "elimination"
+	TournamentStatusRegistering = "registering"
+	TournamentStatusRunning     = "running"
+	TournamentStatusFinished    = "finished"
+	MaxTournamentPlayers        = uint64(256)
+	TotalPrizeSharesBps         = uint64(10_000)
+	TournamentWinPoints         = uint64(2)
+	TournamentDrawPoints        = uint64(1)
+)
+
+const (
+	ArchivedGamePrunedEventType      = "archived-game-pruned"
+	ArchivedGamePrunedEventGameIndex = "game-index"
+)
+
+const (
+	ArchiveReasonCheckmate = "checkmate" // The loser has no piece or no move left
+	ArchiveReasonDraw      = "draw"      // Agreed on or by a draw rule
+	ArchiveReasonForfeit   = "forfeit"
+	ArchiveReasonReject    = "reject"
+	ArchiveReasonResign    = "resign"
+	ArchiveReasonUnknown   = "unknown" // Finished before the reason was recorded
+)
+
+const (
+	BoardUpdateKindSnapshot = "snapshot" // The state of the game when the watch starts
+	BoardUpdateKindMove     = "move"
+	BoardUpdateKindForfeit  = "forfeit"
+	BoardUpdateKindResign   = "resign"
+	BoardUpdateKindDraw     = "draw"
+	BoardUpdateKindReject   = "reject"
+)
+
+const (
+	PlayerGameStatusPending  = "pending"
+	PlayerGameStatusActive   = "active"
+	PlayerGameStatusFinished = "finished"
+	PlayerGameStatusAll      = "all"
 )
 
 const (
-	LeaderboardKey = "Leaderboard-value-"
+	LeaderboardKey       = "Leaderboard-value-"
+	RatingLeaderboardKey = "RatingLeaderboard-value-"
+	CollectedFeesKey     = "CollectedFees-value-"
 )
 
 const (
-	LeaderboardWinnerLength = uint64(100)
-	DateAddedLayout         = DeadlineLayout
+	DateAddedLayout = DeadlineLayout
 )
@@ -109,7 +109,7 @@ func SortWinners(winners []WinningPlayerParsed) {
 	})
 }
 
-func UpdatePlayerInfoAtNow(winners []WinningPlayerParsed, now time.Time, candidate PlayerInfo) (updated []WinningPlayerParsed) {
+func UpdatePlayerInfoAtNow(winners []WinningPlayerParsed, now time.Time, candidate PlayerInfo, length uint64) (updated []WinningPlayerParsed) {
 	if candidate.WonCount < 1 {
 		return winners
 	}
@@ -137,18 +137,18 @@ func UpdatePlayerInfoAtNow(winners []WinningPlayerParsed, now time.Time, candida
 		updated = winners
 	}
 	SortWinners(updated)
-	if LeaderboardWinnerLength < uint64(len(updated)) {
-		updated = updated[:LeaderboardWinnerLength]
+	if length < uint64(len(updated)) {
+		updated = updated[:length]
 	}
 	return updated
 }
 
-func (leaderboard *Leaderboard) UpdatePlayerInfoAtNow(now time.Time, candidate PlayerInfo) error {
+func (leaderboard *Leaderboard) UpdatePlayerInfoAtNow(now time.Time, candidate PlayerInfo, length uint64) error {
 	winners, err := leaderboard.ParseWinners()
 	if err != nil {
 		return err
 	}
-	updated := UpdatePlayerInfoAtNow(winners, now, candidate)
+	updated := UpdatePlayerInfoAtNow(winners, now, candidate, length)
 	leaderboard.Winners = StringifyWinners(updated)
 	candidate.Index = "fake"
 	return nil
@@ -377,7 +377,7 @@ func TestUpdatePlayerInfoAtNow(t *testing.T) {
 			leaderboard := types.Leaderboard{
 				Winners: tt.sorted,
 			}
-			err = leaderboard.UpdatePlayerInfoAtNow(now, tt.candidate)
+			err = leaderboard.UpdatePlayerInfoAtNow(now, tt.candidate, types.DefaultLeaderboardWinnerLength)
 			require.NoError(t, err)
 			require.Equal(t, len(tt.expected), len(leaderboard.Winners))
 			require.EqualValues(t, tt.expected, leaderboard.Winners)
@@ -408,7 +408,7 @@ func TestUpdatePlayerInfoAtNowTooLongNoAdd(t *testing.T) {
 	err = leaderboard.UpdatePlayerInfoAtNow(now, types.PlayerInfo{
 		Index:    "100",
 		WonCount: 1,
-	})
+	}, types.DefaultLeaderboardWinnerLength)
 	require.NoError(t, err)
 	require.Equal(t, len(beforeWinners), len(leaderboard.Winners))
 	require.EqualValues(t, beforeWinners, leaderboard.Winners)
@@ -425,7 +425,7 @@ func TestUpdatePlayerInfoAtNowLongDropSmallest(t *testing.T) {
 	err = leaderboard.UpdatePlayerInfoAtNow(now, types.PlayerInfo{
 		Index:    "100",
 		WonCount: 2,
-	})
+	}, types.DefaultLeaderboardWinnerLength)
 	require.NoError(t, err)
 	beforeWinners[99] = types.WinningPlayer{
 		PlayerAddress: "100",
@@ -447,7 +447,7 @@ func TestUpdatePlayerInfoAtNowLongUpdateWithin(t *testing.T) {
 	err = leaderboard.UpdatePlayerInfoAtNow(now, types.PlayerInfo{
 		Index:    "9",
 		WonCount: 93,
-	})
+	}, types.DefaultLeaderboardWinnerLength)
 	require.NoError(t, err)
 	beforeWinners[8] = types.WinningPlayer{
 		PlayerAddress: "9",
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/b9lab/checkers/x/checkers/rules"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,13 +10,15 @@ const TypeMsgCreateGame = "create_game"
 
 var _ sdk.Msg = &MsgCreateGame{}
 
-func NewMsgCreateGame(creator string, black string, red string, wager uint64, denom string) *MsgCreateGame {
+func NewMsgCreateGame(creator string, black string, red string, wager sdk.Coins, variant string,
+	timeControl TimeControl) *MsgCreateGame {
 	return &MsgCreateGame{
-		Creator: creator,
-		Black:   black,
-		Red:     red,
-		Wager:   wager,
-		Denom:   denom,
+		Creator:     creator,
+		Black:       black,
+		Red:         red,
+		Wager:       wager,
+		Variant:     variant,
+		TimeControl: timeControl,
 	}
 }
 
@@ -45,5 +48,11 @@ func (msg *MsgCreateGame) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	if err := msg.Wager.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidWager, "%s", err)
+	}
+	if _, found := rules.ParseVariant(msg.Variant); !found {
+		return sdkerrors.Wrapf(ErrUnknownVariant, "%s", msg.Variant)
+	}
+	return msg.TimeControl.Validate()
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/b9lab/checkers/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
@@ -25,6 +26,65 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 			msg: MsgCreateGame{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "valid variant",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Variant: "international",
+			},
+		}, {
+			name: "unknown variant",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Variant: "chess",
+			},
+			err: ErrUnknownVariant,
+		}, {
+			name: "valid move time",
+			msg: MsgCreateGame{
+				Creator:     sample.AccAddress(),
+				TimeControl: TimeControl{MoveTime: 30},
+			},
+		}, {
+			name: "valid clock with increment",
+			msg: MsgCreateGame{
+				Creator:     sample.AccAddress(),
+				TimeControl: TimeControl{TotalTime: 300, Increment: 2},
+			},
+		}, {
+			name: "move time and clock",
+			msg: MsgCreateGame{
+				Creator:     sample.AccAddress(),
+				TimeControl: TimeControl{MoveTime: 30, TotalTime: 300},
+			},
+			err: ErrInvalidTimeControl,
+		}, {
+			name: "increment without clock",
+			msg: MsgCreateGame{
+				Creator:     sample.AccAddress(),
+				TimeControl: TimeControl{MoveTime: 30, Increment: 2},
+			},
+			err: ErrInvalidTimeControl,
+		}, {
+			name: "valid multi-coin wager",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Wager:   sdk.NewCoins(sdk.NewInt64Coin("gold", 10), sdk.NewInt64Coin("stake", 45)),
+			},
+		}, {
+			name: "unsorted wager",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Wager:   sdk.Coins{sdk.NewInt64Coin("stake", 45), sdk.NewInt64Coin("gold", 10)},
+			},
+			err: ErrInvalidWager,
+		}, {
+			name: "zero coin in wager",
+			msg: MsgCreateGame{
+				Creator: sample.AccAddress(),
+				Wager:   sdk.Coins{sdk.NewInt64Coin("stake", 0)},
+			},
+			err: ErrInvalidWager,
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateTournament = "create_tournament"
+
+var _ sdk.Msg = &MsgCreateTournament{}
+
+func NewMsgCreateTournament(creator string, format string, entryFee sdk.Coins, maxPlayers uint64, rounds uint64,
+	variant string, prizeSharesBps []uint64) *MsgCreateTournament {
+	return &MsgCreateTournament{
+		Creator:        creator,
+		Format:         format,
+		EntryFee:       entryFee,
+		MaxPlayers:     maxPlayers,
+		Rounds:         rounds,
+		Variant:        variant,
+		PrizeSharesBps: prizeSharesBps,
+	}
+}
+
+func (msg *MsgCreateTournament) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateTournament) Type() string {
+	return TypeMsgCreateTournament
+}
+
+func (msg *MsgCreateTournament) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateTournament) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateTournament) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.EntryFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidWager, "%s", err)
+	}
+	if err := ValidateTournamentSettings(msg.Format, msg.MaxPlayers, msg.Rounds, msg.PrizeSharesBps); err != nil {
+		return err
+	}
+	if _, found := rules.ParseVariant(msg.Variant); !found {
+		return sdkerrors.Wrapf(ErrUnknownVariant, "%s", msg.Variant)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateTournament_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateTournament
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateTournament{
+				Creator:    "invalid_address",
+				Format:     TournamentFormatSwiss,
+				MaxPlayers: 8,
+				Rounds:     3,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid swiss",
+			msg: MsgCreateTournament{
+				Creator:        sample.AccAddress(),
+				Format:         TournamentFormatSwiss,
+				EntryFee:       sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+				MaxPlayers:     8,
+				Rounds:         3,
+				PrizeSharesBps: []uint64{6_000, 3_000, 1_000},
+			},
+		}, {
+			name: "valid elimination",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatElimination,
+				MaxPlayers: 16,
+				Variant:    "pool",
+			},
+		}, {
+			name: "invalid entry fee",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatSwiss,
+				EntryFee:   sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}},
+				MaxPlayers: 8,
+				Rounds:     3,
+			},
+			err: ErrInvalidWager,
+		}, {
+			name: "unknown format",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     "round-robin",
+				MaxPlayers: 8,
+			},
+			err: ErrInvalidTournament,
+		}, {
+			name: "too few players",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatElimination,
+				MaxPlayers: 1,
+			},
+			err: ErrInvalidTournament,
+		}, {
+			name: "too many players",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatElimination,
+				MaxPlayers: MaxTournamentPlayers + 1,
+			},
+			err: ErrInvalidTournament,
+		}, {
+			name: "swiss without rounds",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatSwiss,
+				MaxPlayers: 8,
+			},
+			err: ErrInvalidTournament,
+		}, {
+			name: "swiss with as many rounds as players",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatSwiss,
+				MaxPlayers: 4,
+				Rounds:     4,
+			},
+			err: ErrInvalidTournament,
+		}, {
+			name: "elimination with rounds",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatElimination,
+				MaxPlayers: 8,
+				Rounds:     3,
+			},
+			err: ErrInvalidTournament,
+		}, {
+			name: "prize shares not adding up",
+			msg: MsgCreateTournament{
+				Creator:        sample.AccAddress(),
+				Format:         TournamentFormatElimination,
+				MaxPlayers:     8,
+				PrizeSharesBps: []uint64{6_000, 3_000},
+			},
+			err: ErrInvalidTournament,
+		}, {
+			name: "more prize shares than players",
+			msg: MsgCreateTournament{
+				Creator:        sample.AccAddress(),
+				Format:         TournamentFormatElimination,
+				MaxPlayers:     2,
+				PrizeSharesBps: []uint64{5_000, 3_000, 2_000},
+			},
+			err: ErrInvalidTournament,
+		}, {
+			name: "unknown variant",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatElimination,
+				MaxPlayers: 8,
+				Variant:    "chess",
+			},
+			err: ErrUnknownVariant,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgJoinTournament = "join_tournament"
+
+var _ sdk.Msg = &MsgJoinTournament{}
+
+func NewMsgJoinTournament(creator string, tournamentIndex string) *MsgJoinTournament {
+	return &MsgJoinTournament{
+		Creator:         creator,
+		TournamentIndex: tournamentIndex,
+	}
+}
+
+func (msg *MsgJoinTournament) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgJoinTournament) Type() string {
+	return TypeMsgJoinTournament
+}
+
+func (msg *MsgJoinTournament) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgJoinTournament) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgJoinTournament) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgOpenChallenge = "open_challenge"
+
+var _ sdk.Msg = &MsgOpenChallenge{}
+
+func NewMsgOpenChallenge(creator string, wager sdk.Coins, color string, minRating uint64, maxRating uint64, variant string) *MsgOpenChallenge {
+	return &MsgOpenChallenge{
+		Creator:   creator,
+		Wager:     wager,
+		Color:     color,
+		MinRating: minRating,
+		MaxRating: maxRating,
+		Variant:   variant,
+	}
+}
+
+func (msg *MsgOpenChallenge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOpenChallenge) Type() string {
+	return TypeMsgOpenChallenge
+}
+
+func (msg *MsgOpenChallenge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgOpenChallenge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgOpenChallenge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Wager.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidWager, "%s", err)
+	}
+	if err := ValidateChallengeColor(msg.Color); err != nil {
+		return err
+	}
+	if msg.MaxRating != 0 && msg.MaxRating < msg.MinRating {
+		return sdkerrors.Wrapf(ErrInvalidRatingBand, "%d > %d", msg.MinRating, msg.MaxRating)
+	}
+	if _, found := rules.ParseVariant(msg.Variant); !found {
+		return sdkerrors.Wrapf(ErrUnknownVariant, "%s", msg.Variant)
+	}
+	return nil
+}
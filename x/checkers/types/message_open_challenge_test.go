@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgOpenChallenge_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgOpenChallenge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgOpenChallenge{
+				Creator: "invalid_address",
+				Color:   "*",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgOpenChallenge{
+				Creator: sample.AccAddress(),
+				Color:   "*",
+			},
+		}, {
+			name: "invalid color",
+			msg: MsgOpenChallenge{
+				Creator: sample.AccAddress(),
+				Color:   "w",
+			},
+			err: ErrInvalidColor,
+		}, {
+			name: "valid rating band",
+			msg: MsgOpenChallenge{
+				Creator:   sample.AccAddress(),
+				Color:     "b",
+				MinRating: 1200,
+				MaxRating: 1400,
+			},
+		}, {
+			name: "inverted rating band",
+			msg: MsgOpenChallenge{
+				Creator:   sample.AccAddress(),
+				Color:     "r",
+				MinRating: 1400,
+				MaxRating: 1200,
+			},
+			err: ErrInvalidRatingBand,
+		}, {
+			name: "unknown variant",
+			msg: MsgOpenChallenge{
+				Creator: sample.AccAddress(),
+				Color:   "*",
+				Variant: "chess",
+			},
+			err: ErrUnknownVariant,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
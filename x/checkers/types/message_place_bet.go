@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlaceBet = "place_bet"
+
+var _ sdk.Msg = &MsgPlaceBet{}
+
+func NewMsgPlaceBet(creator string, gameIndex string, color string, amount sdk.Coins) *MsgPlaceBet {
+	return &MsgPlaceBet{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Color:     color,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgPlaceBet) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceBet) Type() string {
+	return TypeMsgPlaceBet
+}
+
+func (msg *MsgPlaceBet) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceBet) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceBet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Color != rules.PieceStrings[rules.BLACK_PLAYER] && msg.Color != rules.PieceStrings[rules.RED_PLAYER] {
+		return sdkerrors.Wrapf(ErrInvalidBet, "color must be %s or %s: %s",
+			rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER], msg.Color)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBet, "%s", err)
+	}
+	if msg.Amount.Empty() {
+		return sdkerrors.Wrapf(ErrInvalidBet, "amount cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPlaceBet_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPlaceBet
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPlaceBet{
+				Creator:   "invalid_address",
+				GameIndex: "1",
+				Color:     "b",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgPlaceBet{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Color:     "r",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+		}, {
+			name: "invalid color",
+			msg: MsgPlaceBet{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Color:     "*",
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			},
+			err: ErrInvalidBet,
+		}, {
+			name: "empty amount",
+			msg: MsgPlaceBet{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Color:     "b",
+			},
+			err: ErrInvalidBet,
+		}, {
+			name: "invalid amount",
+			msg: MsgPlaceBet{
+				Creator:   sample.AccAddress(),
+				GameIndex: "1",
+				Color:     "b",
+				Amount:    sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(0)}},
+			},
+			err: ErrInvalidBet,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlayMoves = "play_moves"
+
+var _ sdk.Msg = &MsgPlayMoves{}
+
+func NewMsgPlayMoves(creator string, gameIndex string, positions []Position) *MsgPlayMoves {
+	return &MsgPlayMoves{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Positions: positions,
+	}
+}
+
+func (msg *MsgPlayMoves) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlayMoves) Type() string {
+	return TypeMsgPlayMoves
+}
+
+func (msg *MsgPlayMoves) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlayMoves) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlayMoves) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Positions) < 2 {
+		return sdkerrors.Wrapf(ErrWrongMove, "at least 2 positions are needed, got %d", len(msg.Positions))
+	}
+	if rules.MaxChainPositions() < len(msg.Positions) {
+		return sdkerrors.Wrapf(ErrWrongMove, "at most %d positions are possible, got %d", rules.MaxChainPositions(), len(msg.Positions))
+	}
+	return nil
+}
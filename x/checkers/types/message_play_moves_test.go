@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgPlayMoves_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPlayMoves
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPlayMoves{
+				Creator: "invalid_address",
+				Positions: []Position{
+					{X: 1, Y: 2},
+					{X: 2, Y: 3},
+				},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "single position",
+			msg: MsgPlayMoves{
+				Creator: sample.AccAddress(),
+				Positions: []Position{
+					{X: 1, Y: 2},
+				},
+			},
+			err: ErrWrongMove,
+		}, {
+			name: "longer than any capture chain",
+			msg: MsgPlayMoves{
+				Creator:   sample.AccAddress(),
+				Positions: make([]Position, 22),
+			},
+			err: ErrWrongMove,
+		}, {
+			name: "valid address",
+			msg: MsgPlayMoves{
+				Creator: sample.AccAddress(),
+				Positions: []Position{
+					{X: 1, Y: 2},
+					{X: 2, Y: 3},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
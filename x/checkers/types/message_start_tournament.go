@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgStartTournament = "start_tournament"
+
+var _ sdk.Msg = &MsgStartTournament{}
+
+func NewMsgStartTournament(creator string, tournamentIndex string) *MsgStartTournament {
+	return &MsgStartTournament{
+		Creator:         creator,
+		TournamentIndex: tournamentIndex,
+	}
+}
+
+func (msg *MsgStartTournament) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStartTournament) Type() string {
+	return TypeMsgStartTournament
+}
+
+func (msg *MsgStartTournament) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgStartTournament) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgStartTournament) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -1,34 +1,301 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyMinMoveTime            = []byte("MinMoveTime")
+	DefaultMinMoveTime uint64 = 10 // 10 seconds
+)
+
+var (
+	KeyMaxMoveTime            = []byte("MaxMoveTime")
+	DefaultMaxMoveTime uint64 = 7 * 24 * 3_600 // 1 week
+)
+
+var (
+	KeyMinTotalTime            = []byte("MinTotalTime")
+	DefaultMinTotalTime uint64 = 60 // 1 minute
+)
+
+var (
+	KeyMaxTotalTime            = []byte("MaxTotalTime")
+	DefaultMaxTotalTime uint64 = 30 * 24 * 3_600 // 30 days
+)
+
+var (
+	KeyMaxIncrement            = []byte("MaxIncrement")
+	DefaultMaxIncrement uint64 = 24 * 3_600 // 1 day
+)
+
+var (
+	KeyCreateGameGas            = []byte("CreateGameGas")
+	DefaultCreateGameGas uint64 = 45000
+)
+
+var (
+	KeyPlayMoveGas            = []byte("PlayMoveGas")
+	DefaultPlayMoveGas uint64 = 1000
+)
+
+var (
+	KeyRejectGameRefundGas            = []byte("RejectGameRefundGas")
+	DefaultRejectGameRefundGas uint64 = 44000 // Also gives back the active game counts, player game indices and archive record booked at creation
+)
+
+var (
+	KeyMaxTurnDuration            = []byte("MaxTurnDuration")
+	DefaultMaxTurnDuration uint64 = 24 * 3_600 // 1 day
+)
+
+var (
+	KeyLeaderboardWinnerLength            = []byte("LeaderboardWinnerLength")
+	DefaultLeaderboardWinnerLength uint64 = 100
+)
+
+var (
+	KeyMinWager     = []byte("MinWager")
+	DefaultMinWager = sdk.ZeroInt()
+)
+
+var (
+	KeyMaxWager     = []byte("MaxWager")
+	DefaultMaxWager = sdk.ZeroInt() // No maximum
+)
+
+var (
+	KeyAllowedDenoms              = []byte("AllowedDenoms")
+	DefaultAllowedDenoms []string = nil // Any denom
+)
+
+var (
+	KeyMaxGamesPerPlayer            = []byte("MaxGamesPerPlayer")
+	DefaultMaxGamesPerPlayer uint64 = 100
+)
+
+var (
+	KeyFeeBps            = []byte("FeeBps")
+	DefaultFeeBps uint64 = 0
+)
+
+var (
+	KeyFeeCollector            = []byte("FeeCollector")
+	DefaultFeeCollector string = "" // The fee collector module
+)
+
+var (
+	KeyMaxBetMoveCount            = []byte("MaxBetMoveCount")
+	DefaultMaxBetMoveCount uint64 = 10
+)
+
+var (
+	KeyArchiveRetentionBlocks            = []byte("ArchiveRetentionBlocks")
+	DefaultArchiveRetentionBlocks uint64 = 100_800 // About a week of 6-second blocks
+)
+
+var (
+	KeyInvitationTimeout            = []byte("InvitationTimeout")
+	DefaultInvitationTimeout uint64 = 24 * 3_600 // 1 day
+)
+
+var (
+	KeyMinBet     = []byte("MinBet")
+	DefaultMinBet = sdk.ZeroInt()
+)
+
+var (
+	KeyMaxBetsPerGame            = []byte("MaxBetsPerGame")
+	DefaultMaxBetsPerGame uint64 = 100
+)
+
+// FeeCollectorCommunityPool sends the fees to the community pool of the distribution module.
+const FeeCollectorCommunityPool = "community-pool"
+
+// feeCollectorModules are the module accounts that cannot take the fees directly, as the bank refuses to send to
+// them or they account for their balance on their own.
+var feeCollectorModules = []string{
+	authtypes.FeeCollectorName,
+	distrtypes.ModuleName,
+	minttypes.ModuleName,
+	stakingtypes.BondedPoolName,
+	stakingtypes.NotBondedPoolName,
+	govtypes.ModuleName,
+	ibctransfertypes.ModuleName,
+	ModuleName,
+	BetEscrowName,
+}
+
+// MaxFeeBps is the whole of the winnings.
+const MaxFeeBps uint64 = 10_000
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	minMoveTime uint64,
+	maxMoveTime uint64,
+	minTotalTime uint64,
+	maxTotalTime uint64,
+	maxIncrement uint64,
+	createGameGas uint64,
+	playMoveGas uint64,
+	rejectGameRefundGas uint64,
+	maxTurnDuration uint64,
+	leaderboardWinnerLength uint64,
+	minWager sdk.Int,
+	maxWager sdk.Int,
+	allowedDenoms []string,
+	maxGamesPerPlayer uint64,
+	feeBps uint64,
+	feeCollector string,
+	maxBetMoveCount uint64,
+	archiveRetentionBlocks uint64,
+	invitationTimeout uint64,
+	minBet sdk.Int,
+	maxBetsPerGame uint64,
+) Params {
+	return Params{
+		MinMoveTime:             minMoveTime,
+		MaxMoveTime:             maxMoveTime,
+		MinTotalTime:            minTotalTime,
+		MaxTotalTime:            maxTotalTime,
+		MaxIncrement:            maxIncrement,
+		CreateGameGas:           createGameGas,
+		PlayMoveGas:             playMoveGas,
+		RejectGameRefundGas:     rejectGameRefundGas,
+		MaxTurnDuration:         maxTurnDuration,
+		LeaderboardWinnerLength: leaderboardWinnerLength,
+		MinWager:                minWager,
+		MaxWager:                maxWager,
+		AllowedDenoms:           allowedDenoms,
+		MaxGamesPerPlayer:       maxGamesPerPlayer,
+		FeeBps:                  feeBps,
+		FeeCollector:            feeCollector,
+		MaxBetMoveCount:         maxBetMoveCount,
+		ArchiveRetentionBlocks:  archiveRetentionBlocks,
+		InvitationTimeout:       invitationTimeout,
+		MinBet:                  minBet,
+		MaxBetsPerGame:          maxBetsPerGame,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMinMoveTime,
+		DefaultMaxMoveTime,
+		DefaultMinTotalTime,
+		DefaultMaxTotalTime,
+		DefaultMaxIncrement,
+		DefaultCreateGameGas,
+		DefaultPlayMoveGas,
+		DefaultRejectGameRefundGas,
+		DefaultMaxTurnDuration,
+		DefaultLeaderboardWinnerLength,
+		DefaultMinWager,
+		DefaultMaxWager,
+		DefaultAllowedDenoms,
+		DefaultMaxGamesPerPlayer,
+		DefaultFeeBps,
+		DefaultFeeCollector,
+		DefaultMaxBetMoveCount,
+		DefaultArchiveRetentionBlocks,
+		DefaultInvitationTimeout,
+		DefaultMinBet,
+		DefaultMaxBetsPerGame,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinMoveTime, &p.MinMoveTime, validateDuration),
+		paramtypes.NewParamSetPair(KeyMaxMoveTime, &p.MaxMoveTime, validateDuration),
+		paramtypes.NewParamSetPair(KeyMinTotalTime, &p.MinTotalTime, validateDuration),
+		paramtypes.NewParamSetPair(KeyMaxTotalTime, &p.MaxTotalTime, validateDuration),
+		paramtypes.NewParamSetPair(KeyMaxIncrement, &p.MaxIncrement, validateUint64),
+		paramtypes.NewParamSetPair(KeyCreateGameGas, &p.CreateGameGas, validateUint64),
+		paramtypes.NewParamSetPair(KeyPlayMoveGas, &p.PlayMoveGas, validateUint64),
+		paramtypes.NewParamSetPair(KeyRejectGameRefundGas, &p.RejectGameRefundGas, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxTurnDuration, &p.MaxTurnDuration, validateDuration),
+		paramtypes.NewParamSetPair(KeyLeaderboardWinnerLength, &p.LeaderboardWinnerLength, validatePositive),
+		paramtypes.NewParamSetPair(KeyMinWager, &p.MinWager, validateWagerBound),
+		paramtypes.NewParamSetPair(KeyMaxWager, &p.MaxWager, validateWagerBound),
+		paramtypes.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateDenoms),
+		paramtypes.NewParamSetPair(KeyMaxGamesPerPlayer, &p.MaxGamesPerPlayer, validateUint64),
+		paramtypes.NewParamSetPair(KeyFeeBps, &p.FeeBps, validateFeeBps),
+		paramtypes.NewParamSetPair(KeyFeeCollector, &p.FeeCollector, validateFeeCollector),
+		paramtypes.NewParamSetPair(KeyMaxBetMoveCount, &p.MaxBetMoveCount, validateUint64),
+		paramtypes.NewParamSetPair(KeyArchiveRetentionBlocks, &p.ArchiveRetentionBlocks, validateUint64),
+		paramtypes.NewParamSetPair(KeyInvitationTimeout, &p.InvitationTimeout, validateDuration),
+		paramtypes.NewParamSetPair(KeyMinBet, &p.MinBet, validateBetBound),
+		paramtypes.NewParamSetPair(KeyMaxBetsPerGame, &p.MaxBetsPerGame, validatePositive),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	for _, duration := range []uint64{p.MinMoveTime, p.MaxMoveTime, p.MinTotalTime, p.MaxTotalTime, p.MaxTurnDuration, p.InvitationTimeout} {
+		if err := validateDuration(duration); err != nil {
+			return err
+		}
+	}
+	for _, positive := range []uint64{p.LeaderboardWinnerLength, p.MaxBetsPerGame} {
+		if err := validatePositive(positive); err != nil {
+			return err
+		}
+	}
+	if err := validateWagerBound(p.MinWager); err != nil {
+		return err
+	}
+	if err := validateWagerBound(p.MaxWager); err != nil {
+		return err
+	}
+	if err := validateBetBound(p.MinBet); err != nil {
+		return err
+	}
+	if err := validateDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateFeeBps(p.FeeBps); err != nil {
+		return err
+	}
+	if err := validateFeeCollector(p.FeeCollector); err != nil {
+		return err
+	}
+	if p.MaxMoveTime < p.MinMoveTime {
+		return fmt.Errorf("max move time %d is below min move time %d", p.MaxMoveTime, p.MinMoveTime)
+	}
+	if p.MaxTotalTime < p.MinTotalTime {
+		return fmt.Errorf("max total time %d is below min total time %d", p.MaxTotalTime, p.MinTotalTime)
+	}
+	if p.MaxTurnDuration < p.MinMoveTime || p.MaxMoveTime < p.MaxTurnDuration {
+		return fmt.Errorf("max turn duration %d is not within %d and %d", p.MaxTurnDuration, p.MinMoveTime, p.MaxMoveTime)
+	}
+	if p.CreateGameGas < p.RejectGameRefundGas {
+		return fmt.Errorf("reject game refund gas %d is above create game gas %d", p.RejectGameRefundGas, p.CreateGameGas)
+	}
+	if !p.MaxWager.IsZero() && p.MaxWager.LT(p.MinWager) {
+		return fmt.Errorf("max wager %s is below min wager %s", p.MaxWager, p.MinWager)
+	}
 	return nil
 }
 
@@ -37,3 +304,166 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateUint64(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+func validatePositive(v interface{}) error {
+	value, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if value == 0 {
+		return fmt.Errorf("value must be positive")
+	}
+	return nil
+}
+
+func validateDuration(v interface{}) error {
+	duration, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if duration == 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+	return nil
+}
+
+func validateWagerBound(v interface{}) error {
+	bound, ok := v.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if bound.IsNil() || bound.IsNegative() {
+		return fmt.Errorf("wager bound must not be negative: %s", bound)
+	}
+	return nil
+}
+
+func validateBetBound(v interface{}) error {
+	bound, ok := v.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if bound.IsNil() || bound.IsNegative() {
+		return fmt.Errorf("bet bound must not be negative: %s", bound)
+	}
+	return nil
+}
+
+func validateDenoms(v interface{}) error {
+	denoms, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := ibctransfertypes.ValidateIBCDenom(denom); err != nil {
+			return err
+		}
+		if _, found := seen[denom]; found {
+			return fmt.Errorf("duplicated denom: %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
+func validateFeeBps(v interface{}) error {
+	feeBps, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if MaxFeeBps < feeBps {
+		return fmt.Errorf("fee %d bps is above %d", feeBps, MaxFeeBps)
+	}
+	return nil
+}
+
+func validateFeeCollector(v interface{}) error {
+	feeCollector, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if feeCollector == "" || feeCollector == FeeCollectorCommunityPool {
+		return nil
+	}
+	treasury, err := sdk.AccAddressFromBech32(feeCollector)
+	if err != nil {
+		return err
+	}
+	for _, module := range feeCollectorModules {
+		if treasury.Equals(authtypes.NewModuleAddress(module)) {
+			return fmt.Errorf("fee collector %s is the %s module account", feeCollector, module)
+		}
+	}
+	return nil
+}
+
+// TurnDuration is the time given for each move when the game has no time control. Governance sets each param on its
+// own, so the max turn duration is kept within the move time bounds here too.
+func (p Params) TurnDuration() time.Duration {
+	turnDuration := p.MaxTurnDuration
+	if turnDuration < p.MinMoveTime {
+		turnDuration = p.MinMoveTime
+	}
+	if p.MaxMoveTime < turnDuration {
+		turnDuration = p.MaxMoveTime
+	}
+	return SecondsToDuration(turnDuration)
+}
+
+// InvitationDuration is the time given to the players of a pending game to accept it.
+func (p Params) InvitationDuration() time.Duration {
+	return SecondsToDuration(p.InvitationTimeout)
+}
+
+func (p Params) IsDenomAllowed(denom string) bool {
+	if len(p.AllowedDenoms) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateWager checks the amount of each coin of the wager against the bounds, and that its denom is allowed. A game
+// without wager is only possible when there is no min wager.
+func (p Params) ValidateWager(wager sdk.Coins) error {
+	if err := wager.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidWager, "%s", err)
+	}
+	if !p.MaxWager.IsZero() && p.MaxWager.LT(p.MinWager) {
+		return sdkerrors.Wrapf(ErrWagerOutOfBounds, "max wager %s is below min wager %s", p.MaxWager, p.MinWager)
+	}
+	if wager.Empty() && p.MinWager.IsPositive() {
+		return sdkerrors.Wrapf(ErrWagerOutOfBounds, "no wager is below %s", p.MinWager)
+	}
+	for _, coin := range wager {
+		if coin.Amount.LT(p.MinWager) || (!p.MaxWager.IsZero() && p.MaxWager.LT(coin.Amount)) {
+			return sdkerrors.Wrapf(ErrWagerOutOfBounds, "%s is not within %s and %s", coin, p.MinWager, p.MaxWager)
+		}
+		if !p.IsDenomAllowed(coin.Denom) {
+			return sdkerrors.Wrapf(ErrDenomNotAllowed, "%s", coin.Denom)
+		}
+	}
+	return nil
+}
+
+// SplitWinnings takes the fee, rounded down, out of each coin of the winnings.
+func (p Params) SplitWinnings(winnings sdk.Coins) (payout sdk.Coins, fee sdk.Coins) {
+	fee = sdk.NewCoins()
+	for _, coin := range winnings {
+		fee = fee.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(p.FeeBps)).Quo(sdk.NewIntFromUint64(MaxFeeBps))))
+	}
+	return winnings.Sub(fee), fee
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,6 +26,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	MinMoveTime             uint64                                 `protobuf:"varint,1,opt,name=minMoveTime,proto3" json:"minMoveTime,omitempty" yaml:"min_move_time"`
+	MaxMoveTime             uint64                                 `protobuf:"varint,2,opt,name=maxMoveTime,proto3" json:"maxMoveTime,omitempty" yaml:"max_move_time"`
+	MinTotalTime            uint64                                 `protobuf:"varint,3,opt,name=minTotalTime,proto3" json:"minTotalTime,omitempty" yaml:"min_total_time"`
+	MaxTotalTime            uint64                                 `protobuf:"varint,4,opt,name=maxTotalTime,proto3" json:"maxTotalTime,omitempty" yaml:"max_total_time"`
+	MaxIncrement            uint64                                 `protobuf:"varint,5,opt,name=maxIncrement,proto3" json:"maxIncrement,omitempty" yaml:"max_increment"`
+	CreateGameGas           uint64                                 `protobuf:"varint,6,opt,name=createGameGas,proto3" json:"createGameGas,omitempty" yaml:"create_game_gas"`
+	PlayMoveGas             uint64                                 `protobuf:"varint,7,opt,name=playMoveGas,proto3" json:"playMoveGas,omitempty" yaml:"play_move_gas"`
+	RejectGameRefundGas     uint64                                 `protobuf:"varint,8,opt,name=rejectGameRefundGas,proto3" json:"rejectGameRefundGas,omitempty" yaml:"reject_game_refund_gas"`
+	MaxTurnDuration         uint64                                 `protobuf:"varint,9,opt,name=maxTurnDuration,proto3" json:"maxTurnDuration,omitempty" yaml:"max_turn_duration"`
+	LeaderboardWinnerLength uint64                                 `protobuf:"varint,10,opt,name=leaderboardWinnerLength,proto3" json:"leaderboardWinnerLength,omitempty" yaml:"leaderboard_winner_length"`
+	MinWager                github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=minWager,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minWager" yaml:"min_wager"`
+	MaxWager                github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=maxWager,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"maxWager" yaml:"max_wager"`
+	AllowedDenoms           []string                               `protobuf:"bytes,13,rep,name=allowedDenoms,proto3" json:"allowedDenoms,omitempty" yaml:"allowed_denoms"`
+	MaxGamesPerPlayer       uint64                                 `protobuf:"varint,14,opt,name=maxGamesPerPlayer,proto3" json:"maxGamesPerPlayer,omitempty" yaml:"max_games_per_player"`
+	FeeBps                  uint64                                 `protobuf:"varint,15,opt,name=feeBps,proto3" json:"feeBps,omitempty" yaml:"fee_bps"`
+	FeeCollector            string                                 `protobuf:"bytes,16,opt,name=feeCollector,proto3" json:"feeCollector,omitempty" yaml:"fee_collector"`
+	MaxBetMoveCount         uint64                                 `protobuf:"varint,17,opt,name=maxBetMoveCount,proto3" json:"maxBetMoveCount,omitempty" yaml:"max_bet_move_count"`
+	ArchiveRetentionBlocks  uint64                                 `protobuf:"varint,18,opt,name=archiveRetentionBlocks,proto3" json:"archiveRetentionBlocks,omitempty" yaml:"archive_retention_blocks"`
+	InvitationTimeout       uint64                                 `protobuf:"varint,19,opt,name=invitationTimeout,proto3" json:"invitationTimeout,omitempty" yaml:"invitation_timeout"`
+	MinBet                  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=minBet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minBet" yaml:"min_bet"`
+	MaxBetsPerGame          uint64                                 `protobuf:"varint,21,opt,name=maxBetsPerGame,proto3" json:"maxBetsPerGame,omitempty" yaml:"max_bets_per_game"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +81,132 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMinMoveTime() uint64 {
+	if m != nil {
+		return m.MinMoveTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMoveTime() uint64 {
+	if m != nil {
+		return m.MaxMoveTime
+	}
+	return 0
+}
+
+func (m *Params) GetMinTotalTime() uint64 {
+	if m != nil {
+		return m.MinTotalTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTotalTime() uint64 {
+	if m != nil {
+		return m.MaxTotalTime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIncrement() uint64 {
+	if m != nil {
+		return m.MaxIncrement
+	}
+	return 0
+}
+
+func (m *Params) GetCreateGameGas() uint64 {
+	if m != nil {
+		return m.CreateGameGas
+	}
+	return 0
+}
+
+func (m *Params) GetPlayMoveGas() uint64 {
+	if m != nil {
+		return m.PlayMoveGas
+	}
+	return 0
+}
+
+func (m *Params) GetRejectGameRefundGas() uint64 {
+	if m != nil {
+		return m.RejectGameRefundGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTurnDuration() uint64 {
+	if m != nil {
+		return m.MaxTurnDuration
+	}
+	return 0
+}
+
+func (m *Params) GetLeaderboardWinnerLength() uint64 {
+	if m != nil {
+		return m.LeaderboardWinnerLength
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGamesPerPlayer() uint64 {
+	if m != nil {
+		return m.MaxGamesPerPlayer
+	}
+	return 0
+}
+
+func (m *Params) GetFeeBps() uint64 {
+	if m != nil {
+		return m.FeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetFeeCollector() string {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return ""
+}
+
+func (m *Params) GetMaxBetMoveCount() uint64 {
+	if m != nil {
+		return m.MaxBetMoveCount
+	}
+	return 0
+}
+
+func (m *Params) GetArchiveRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ArchiveRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetInvitationTimeout() uint64 {
+	if m != nil {
+		return m.InvitationTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBetsPerGame() uint64 {
+	if m != nil {
+		return m.MaxBetsPerGame
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "b9lab.checkers.checkers.Params")
 }
@@ -66,16 +214,57 @@ func init() {
 func init() { proto.RegisterFile("checkers/params.proto", fileDescriptor_ec14988318ba9aaa) }
 
 var fileDescriptor_ec14988318ba9aaa = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x4f, 0xb2, 0xcc, 0x49, 0x4c, 0xd2, 0x83, 0x49, 0xc2, 0x19, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x12, 0x1f, 0x17, 0x5b, 0x00, 0x58,
-	0xbb, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f,
-	0xb6, 0x43, 0x1f, 0xee, 0x80, 0x0a, 0x04, 0xb3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c,
-	0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x37, 0x7a, 0xa7, 0x8b, 0xa4, 0x00, 0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xc1, 0x6e, 0xdc, 0x44,
+	0x18, 0xc7, 0x77, 0x69, 0x58, 0x9a, 0x69, 0x92, 0x36, 0xd3, 0xa4, 0x9d, 0x16, 0xba, 0x13, 0x06,
+	0x84, 0xa2, 0x4a, 0x64, 0x0f, 0x9c, 0x88, 0x40, 0x54, 0xdb, 0x88, 0xa8, 0x82, 0x4a, 0xd1, 0x50,
+	0xa9, 0x08, 0xa4, 0x5a, 0x63, 0xef, 0x97, 0x8d, 0x89, 0x67, 0xc6, 0x1a, 0x8f, 0x13, 0xe7, 0x15,
+	0x38, 0x71, 0xe4, 0xc8, 0xe3, 0xf4, 0xd8, 0x23, 0xe2, 0x60, 0xa1, 0xe4, 0x0d, 0xfc, 0x04, 0x68,
+	0xc6, 0x4e, 0xec, 0xdd, 0x4d, 0x0e, 0xa8, 0x97, 0x5d, 0x4b, 0xdf, 0xef, 0xf7, 0x9f, 0xd1, 0x37,
+	0x9f, 0x3d, 0x68, 0x33, 0x3a, 0x82, 0xe8, 0x18, 0x4c, 0x36, 0x4a, 0x85, 0x11, 0x32, 0xdb, 0x49,
+	0x8d, 0xb6, 0x1a, 0x3f, 0x0c, 0xbf, 0x4e, 0x44, 0xb8, 0x73, 0x59, 0xbc, 0x7a, 0x78, 0xbc, 0x31,
+	0xd5, 0x53, 0xed, 0x99, 0x91, 0x7b, 0xaa, 0x71, 0xf6, 0xfb, 0x0a, 0x1a, 0x1c, 0x78, 0x1f, 0xef,
+	0xa2, 0x3b, 0x32, 0x56, 0x2f, 0xf5, 0x09, 0xbc, 0x8a, 0x25, 0x90, 0xfe, 0x56, 0x7f, 0x7b, 0x69,
+	0x4c, 0xaa, 0x92, 0x6e, 0x9c, 0x09, 0x99, 0xec, 0x32, 0x19, 0xab, 0x40, 0xea, 0x13, 0x08, 0x6c,
+	0x2c, 0x81, 0xf1, 0x2e, 0xec, 0x5d, 0x51, 0x5c, 0xb9, 0x1f, 0x2c, 0xb8, 0xa2, 0x98, 0x75, 0x5b,
+	0x18, 0x7f, 0x8b, 0x56, 0x64, 0xac, 0x5e, 0x69, 0x2b, 0x12, 0x2f, 0xdf, 0xf2, 0xf2, 0xa3, 0xaa,
+	0xa4, 0x9b, 0xed, 0xc2, 0xd6, 0x95, 0x1b, 0x7b, 0x06, 0xf7, 0xba, 0x28, 0x5a, 0x7d, 0x69, 0x41,
+	0x17, 0xc5, 0x9c, 0xde, 0xc1, 0xf1, 0x37, 0x5e, 0x7f, 0xa1, 0x22, 0x03, 0x12, 0x94, 0x25, 0x1f,
+	0x5e, 0xb7, 0xf5, 0xf8, 0xb2, 0xcc, 0xf8, 0x0c, 0x8d, 0x9f, 0xa1, 0xd5, 0xc8, 0x80, 0xb0, 0xb0,
+	0x2f, 0x24, 0xec, 0x8b, 0x8c, 0x0c, 0xbc, 0xfe, 0xb8, 0x2a, 0xe9, 0x83, 0x5a, 0xaf, 0xcb, 0xc1,
+	0x54, 0x48, 0xf7, 0x93, 0x31, 0x3e, 0x2b, 0xb8, 0xce, 0xa5, 0x89, 0x38, 0x73, 0xdd, 0x70, 0xfe,
+	0x47, 0xf3, 0xcb, 0xbb, 0x62, 0xdd, 0x3a, 0x6f, 0x77, 0x61, 0xfc, 0x13, 0xba, 0x6f, 0xe0, 0x37,
+	0x88, 0xac, 0x0b, 0xe3, 0x70, 0x98, 0xab, 0x89, 0xcb, 0xb8, 0xed, 0x33, 0x3e, 0xad, 0x4a, 0xfa,
+	0xa4, 0xce, 0xa8, 0xa1, 0x7a, 0x0f, 0xc6, 0x63, 0x75, 0xd8, 0x75, 0x36, 0xfe, 0x1e, 0xdd, 0x75,
+	0x0d, 0xca, 0x8d, 0xda, 0xcb, 0x8d, 0xb0, 0xb1, 0x56, 0x64, 0xd9, 0x07, 0x7e, 0x52, 0x95, 0x94,
+	0x74, 0x5a, 0x9a, 0x1b, 0x15, 0x4c, 0x1a, 0x84, 0xf1, 0x79, 0x09, 0xbf, 0x41, 0x0f, 0x13, 0x10,
+	0x13, 0x30, 0xa1, 0x16, 0x66, 0xf2, 0x3a, 0x56, 0x0a, 0xcc, 0x8f, 0xa0, 0xa6, 0xf6, 0x88, 0x20,
+	0x9f, 0xf7, 0x79, 0x55, 0xd2, 0xad, 0x3a, 0xaf, 0x03, 0x06, 0xa7, 0x9e, 0x0c, 0x12, 0x8f, 0x32,
+	0x7e, 0x53, 0x08, 0x7e, 0x83, 0x6e, 0xcb, 0x58, 0xbd, 0x16, 0x53, 0x30, 0xe4, 0xce, 0x56, 0x7f,
+	0x7b, 0x79, 0x3c, 0x7e, 0x5b, 0xd2, 0xde, 0x3f, 0x25, 0xfd, 0x62, 0x1a, 0xdb, 0xa3, 0x3c, 0xdc,
+	0x89, 0xb4, 0x1c, 0x45, 0x3a, 0x93, 0x3a, 0x6b, 0xfe, 0xbe, 0xcc, 0x26, 0xc7, 0x23, 0x7b, 0x96,
+	0x42, 0xb6, 0xf3, 0x42, 0xd9, 0xaa, 0xa4, 0xf7, 0xda, 0x01, 0x3b, 0x75, 0x41, 0x8c, 0x5f, 0x65,
+	0xfa, 0x7c, 0x51, 0xd4, 0xf9, 0x2b, 0xef, 0x99, 0x2f, 0x8a, 0x36, 0xbf, 0xc9, 0xc4, 0xdf, 0xa1,
+	0x55, 0x91, 0x24, 0xfa, 0x14, 0x26, 0x7b, 0xa0, 0xb4, 0xcc, 0xc8, 0xea, 0xd6, 0xad, 0xed, 0xe5,
+	0xee, 0xe0, 0x36, 0xe5, 0x60, 0xe2, 0xeb, 0x8c, 0xcf, 0xf2, 0xf8, 0x25, 0x5a, 0x97, 0xa2, 0x70,
+	0x87, 0x97, 0x1d, 0x80, 0x39, 0x48, 0xc4, 0x19, 0x18, 0xb2, 0xe6, 0x5b, 0x4b, 0xab, 0x92, 0x7e,
+	0xdc, 0xae, 0xed, 0x0e, 0x3e, 0x0b, 0x52, 0x30, 0x41, 0xea, 0x29, 0xc6, 0x17, 0x4d, 0xfc, 0x14,
+	0x0d, 0x0e, 0x01, 0xc6, 0x69, 0x46, 0xee, 0xfa, 0x0c, 0x5c, 0x95, 0x74, 0xad, 0xce, 0x38, 0x04,
+	0x08, 0xc2, 0x34, 0x63, 0xbc, 0x21, 0xdc, 0x4b, 0x73, 0x08, 0xf0, 0x5c, 0x27, 0x09, 0x44, 0x56,
+	0x1b, 0x72, 0xcf, 0xf7, 0xa7, 0x33, 0xb5, 0xce, 0x88, 0x2e, 0xcb, 0x8c, 0xcf, 0xd0, 0x78, 0xdf,
+	0x4f, 0xd8, 0x18, 0xac, 0x9b, 0xe3, 0xe7, 0x3a, 0x57, 0x96, 0xac, 0xfb, 0x25, 0x9f, 0x54, 0x25,
+	0x7d, 0xd4, 0x6e, 0x3b, 0x04, 0x5b, 0x4f, 0x7e, 0xe4, 0x18, 0xc6, 0xe7, 0x2d, 0xfc, 0x2b, 0x7a,
+	0x20, 0x4c, 0x74, 0x14, 0x9f, 0x00, 0x07, 0x0b, 0xca, 0x8d, 0xdd, 0x38, 0xd1, 0xd1, 0x71, 0x46,
+	0xb0, 0xcf, 0xfb, 0xac, 0x2a, 0x29, 0x6d, 0x7a, 0x59, 0x73, 0x81, 0xb9, 0x04, 0x83, 0xd0, 0x93,
+	0x8c, 0xdf, 0x10, 0x81, 0x7f, 0x40, 0xeb, 0xb1, 0x3a, 0x89, 0xad, 0x9f, 0x66, 0xf7, 0xa9, 0xd0,
+	0xb9, 0x25, 0xf7, 0xe7, 0xf7, 0xd9, 0x22, 0xfe, 0xeb, 0xa2, 0x73, 0xcb, 0xf8, 0xa2, 0x87, 0x7f,
+	0x46, 0x03, 0x19, 0xab, 0x31, 0x58, 0xb2, 0xe1, 0x5b, 0xf5, 0xec, 0x7f, 0x8f, 0xd2, 0x5a, 0x3b,
+	0xaa, 0x21, 0x58, 0xc6, 0x9b, 0x3c, 0xbc, 0x87, 0xd6, 0xea, 0xb6, 0xb8, 0xa3, 0x74, 0x47, 0x4a,
+	0x36, 0xaf, 0x7b, 0x5b, 0x43, 0xb0, 0xf5, 0x04, 0xb8, 0x59, 0x60, 0x7c, 0xce, 0xd9, 0x5d, 0xfa,
+	0xf3, 0x2f, 0xda, 0x1b, 0xef, 0xbd, 0x3d, 0x1f, 0xf6, 0xdf, 0x9d, 0x0f, 0xfb, 0xff, 0x9e, 0x0f,
+	0xfb, 0x7f, 0x5c, 0x0c, 0x7b, 0xef, 0x2e, 0x86, 0xbd, 0xbf, 0x2f, 0x86, 0xbd, 0x5f, 0x9e, 0x76,
+	0xf6, 0xe9, 0x2f, 0x98, 0xd1, 0xd5, 0xed, 0x53, 0xb4, 0x8f, 0x7e, 0xbf, 0xe1, 0xc0, 0xdf, 0x2c,
+	0x5f, 0xfd, 0x37, 0x00, 0x3d, 0x60, 0x05, 0xca, 0xa1, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +287,144 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBetsPerGame != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBetsPerGame))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size := m.MinBet.Size()
+		i -= size
+		if _, err := m.MinBet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.InvitationTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InvitationTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.ArchiveRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ArchiveRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxBetMoveCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBetMoveCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.FeeCollector) > 0 {
+		i -= len(m.FeeCollector)
+		copy(dAtA[i:], m.FeeCollector)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeCollector)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.FeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeBps))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxGamesPerPlayer != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGamesPerPlayer))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.MaxWager.Size()
+		i -= size
+		if _, err := m.MaxWager.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MinWager.Size()
+		i -= size
+		if _, err := m.MinWager.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.LeaderboardWinnerLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LeaderboardWinnerLength))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxTurnDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTurnDuration))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RejectGameRefundGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RejectGameRefundGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PlayMoveGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PlayMoveGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CreateGameGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CreateGameGas))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxIncrement != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIncrement))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTotalTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTotalTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinTotalTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTotalTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMoveTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMoveTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinMoveTime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinMoveTime))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +445,70 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MinMoveTime != 0 {
+		n += 1 + sovParams(uint64(m.MinMoveTime))
+	}
+	if m.MaxMoveTime != 0 {
+		n += 1 + sovParams(uint64(m.MaxMoveTime))
+	}
+	if m.MinTotalTime != 0 {
+		n += 1 + sovParams(uint64(m.MinTotalTime))
+	}
+	if m.MaxTotalTime != 0 {
+		n += 1 + sovParams(uint64(m.MaxTotalTime))
+	}
+	if m.MaxIncrement != 0 {
+		n += 1 + sovParams(uint64(m.MaxIncrement))
+	}
+	if m.CreateGameGas != 0 {
+		n += 1 + sovParams(uint64(m.CreateGameGas))
+	}
+	if m.PlayMoveGas != 0 {
+		n += 1 + sovParams(uint64(m.PlayMoveGas))
+	}
+	if m.RejectGameRefundGas != 0 {
+		n += 1 + sovParams(uint64(m.RejectGameRefundGas))
+	}
+	if m.MaxTurnDuration != 0 {
+		n += 1 + sovParams(uint64(m.MaxTurnDuration))
+	}
+	if m.LeaderboardWinnerLength != 0 {
+		n += 1 + sovParams(uint64(m.LeaderboardWinnerLength))
+	}
+	l = m.MinWager.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxWager.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxGamesPerPlayer != 0 {
+		n += 1 + sovParams(uint64(m.MaxGamesPerPlayer))
+	}
+	if m.FeeBps != 0 {
+		n += 1 + sovParams(uint64(m.FeeBps))
+	}
+	l = len(m.FeeCollector)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MaxBetMoveCount != 0 {
+		n += 2 + sovParams(uint64(m.MaxBetMoveCount))
+	}
+	if m.ArchiveRetentionBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ArchiveRetentionBlocks))
+	}
+	if m.InvitationTimeout != 0 {
+		n += 2 + sovParams(uint64(m.InvitationTimeout))
+	}
+	l = m.MinBet.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxBetsPerGame != 0 {
+		n += 2 + sovParams(uint64(m.MaxBetsPerGame))
+	}
 	return n
 }
 
@@ -156,6 +547,476 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMoveTime", wireType)
+			}
+			m.MinMoveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMoveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMoveTime", wireType)
+			}
+			m.MaxMoveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMoveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTotalTime", wireType)
+			}
+			m.MinTotalTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTotalTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTime", wireType)
+			}
+			m.MaxTotalTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIncrement", wireType)
+			}
+			m.MaxIncrement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIncrement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateGameGas", wireType)
+			}
+			m.CreateGameGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateGameGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayMoveGas", wireType)
+			}
+			m.PlayMoveGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlayMoveGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectGameRefundGas", wireType)
+			}
+			m.RejectGameRefundGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectGameRefundGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTurnDuration", wireType)
+			}
+			m.MaxTurnDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTurnDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderboardWinnerLength", wireType)
+			}
+			m.LeaderboardWinnerLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderboardWinnerLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinWager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxWager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGamesPerPlayer", wireType)
+			}
+			m.MaxGamesPerPlayer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGamesPerPlayer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBps", wireType)
+			}
+			m.FeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBetMoveCount", wireType)
+			}
+			m.MaxBetMoveCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBetMoveCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveRetentionBlocks", wireType)
+			}
+			m.ArchiveRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArchiveRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationTimeout", wireType)
+			}
+			m.InvitationTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvitationTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBetsPerGame", wireType)
+			}
+			m.MaxBetsPerGame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBetsPerGame |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
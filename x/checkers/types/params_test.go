@@ -0,0 +1,204 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(params *types.Params)
+		valid  bool
+	}{
+		{
+			desc:   "default is valid",
+			modify: func(params *types.Params) {},
+			valid:  true,
+		},
+		{
+			desc:   "zero turn duration",
+			modify: func(params *types.Params) { params.MaxTurnDuration = 0 },
+			valid:  false,
+		},
+		{
+			desc:   "turn duration above max move time",
+			modify: func(params *types.Params) { params.MaxTurnDuration = params.MaxMoveTime + 1 },
+			valid:  false,
+		},
+		{
+			desc:   "refund above create gas",
+			modify: func(params *types.Params) { params.RejectGameRefundGas = params.CreateGameGas + 1 },
+			valid:  false,
+		},
+		{
+			desc:   "zero leaderboard length",
+			modify: func(params *types.Params) { params.LeaderboardWinnerLength = 0 },
+			valid:  false,
+		},
+		{
+			desc:   "max wager below min wager",
+			modify: func(params *types.Params) { params.MinWager, params.MaxWager = sdk.NewInt(10), sdk.NewInt(5) },
+			valid:  false,
+		},
+		{
+			desc:   "min wager without max wager",
+			modify: func(params *types.Params) { params.MinWager = sdk.NewInt(10) },
+			valid:  true,
+		},
+		{
+			desc: "allowed denoms",
+			modify: func(params *types.Params) {
+				params.AllowedDenoms = []string{"stake", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}
+			},
+			valid: true,
+		},
+		{
+			desc:   "ibc denom without hash",
+			modify: func(params *types.Params) { params.AllowedDenoms = []string{"ibc/xyz"} },
+			valid:  false,
+		},
+		{
+			desc:   "negative min wager",
+			modify: func(params *types.Params) { params.MinWager = sdk.NewInt(-1) },
+			valid:  false,
+		},
+		{
+			desc:   "invalid denom",
+			modify: func(params *types.Params) { params.AllowedDenoms = []string{"1stake"} },
+			valid:  false,
+		},
+		{
+			desc:   "duplicated denom",
+			modify: func(params *types.Params) { params.AllowedDenoms = []string{"stake", "stake"} },
+			valid:  false,
+		},
+		{
+			desc:   "whole winnings as fee",
+			modify: func(params *types.Params) { params.FeeBps = 10_000 },
+			valid:  true,
+		},
+		{
+			desc:   "fee above winnings",
+			modify: func(params *types.Params) { params.FeeBps = 10_001 },
+			valid:  false,
+		},
+		{
+			desc:   "treasury fee collector",
+			modify: func(params *types.Params) { params.FeeCollector = testutil.Alice },
+			valid:  true,
+		},
+		{
+			desc:   "community pool fee collector",
+			modify: func(params *types.Params) { params.FeeCollector = types.FeeCollectorCommunityPool },
+			valid:  true,
+		},
+		{
+			desc: "module account fee collector",
+			modify: func(params *types.Params) {
+				params.FeeCollector = authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+			},
+			valid: false,
+		},
+		{
+			desc: "escrow fee collector",
+			modify: func(params *types.Params) {
+				params.FeeCollector = authtypes.NewModuleAddress(types.ModuleName).String()
+			},
+			valid: false,
+		},
+		{
+			desc:   "invalid fee collector",
+			modify: func(params *types.Params) { params.FeeCollector = "cosmos1" },
+			valid:  false,
+		},
+		{
+			desc:   "negative min bet",
+			modify: func(params *types.Params) { params.MinBet = sdk.NewInt(-1) },
+			valid:  false,
+		},
+		{
+			desc:   "zero max bets per game",
+			modify: func(params *types.Params) { params.MaxBetsPerGame = 0 },
+			valid:  false,
+		},
+		{
+			desc:   "zero invitation timeout",
+			modify: func(params *types.Params) { params.InvitationTimeout = 0 },
+			valid:  false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsValidateWager(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinWager = sdk.NewInt(10)
+	params.MaxWager = sdk.NewInt(100)
+	params.AllowedDenoms = []string{"stake", "gold"}
+	require.NoError(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.NoError(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("gold", 100))))
+	require.ErrorIs(t, params.ValidateWager(sdk.NewCoins()), types.ErrWagerOutOfBounds)
+	require.ErrorIs(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("stake", 9))), types.ErrWagerOutOfBounds)
+	require.ErrorIs(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("stake", 101))), types.ErrWagerOutOfBounds)
+	require.ErrorIs(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("gold", 101))), types.ErrWagerOutOfBounds)
+	require.ErrorIs(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("coin", 50))), types.ErrDenomNotAllowed)
+	require.ErrorIs(t, params.ValidateWager(sdk.Coins{sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("gold", 50)}), types.ErrInvalidWager)
+}
+
+func TestParamsValidateWagerNoMin(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.ValidateWager(sdk.NewCoins()))
+	require.NoError(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 50))))
+}
+
+func TestParamsValidateWagerInvertedBounds(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinWager = sdk.NewInt(100)
+	params.MaxWager = sdk.NewInt(10)
+	require.ErrorIs(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("stake", 50))), types.ErrWagerOutOfBounds)
+	params.MinWager = sdk.ZeroInt()
+	params.MaxWager = sdk.ZeroInt()
+	require.NoError(t, params.ValidateWager(sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+}
+
+func TestParamsTurnDuration(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, 24*time.Hour, params.TurnDuration())
+	params.MaxTurnDuration = 1
+	require.Equal(t, 10*time.Second, params.TurnDuration())
+	params.MaxTurnDuration = 8 * 24 * 3_600
+	require.Equal(t, 7*24*time.Hour, params.TurnDuration())
+}
+
+func TestParamsSplitWinnings(t *testing.T) {
+	params := types.DefaultParams()
+	payout, fee := params.SplitWinnings(sdk.NewCoins(sdk.NewInt64Coin("stake", 90)))
+	require.Equal(t, "90stake", payout.String())
+	require.Equal(t, "", fee.String())
+	params.FeeBps = 250
+	payout, fee = params.SplitWinnings(sdk.NewCoins(sdk.NewInt64Coin("stake", 90), sdk.NewInt64Coin("gold", 400)))
+	require.Equal(t, "390gold,88stake", payout.String())
+	require.Equal(t, "10gold,2stake", fee.String())
+	params.FeeBps = 10_000
+	payout, fee = params.SplitWinnings(sdk.NewCoins(sdk.NewInt64Coin("stake", 90)))
+	require.Equal(t, "", payout.String())
+	require.Equal(t, "90stake", fee.String())
+}
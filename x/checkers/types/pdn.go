@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	PdnCaptureSeparator = "x"
+	PdnMoveSeparator    = "-"
+	PdnUnknownResult    = "*"
+)
+
+// PdnGameTypes maps the rule variants to their PDN GameType tag.
+var PdnGameTypes = map[string]string{
+	rules.AMERICAN:      "21",
+	rules.INTERNATIONAL: "20",
+	rules.RUSSIAN:       "25",
+	rules.POOL:          "23",
+}
+
+// PdnResults gives the score of Black, which moves first, then that of Red.
+var PdnResults = map[string]string{
+	rules.PieceStrings[rules.BLACK_PLAYER]: "1-0",
+	rules.PieceStrings[rules.RED_PLAYER]:   "0-1",
+	rules.PieceStrings[rules.DRAW_PLAYER]:  "1/2-1/2",
+}
+
+// PdnSquare numbers the playable squares from 1, row by row, starting from Black's side.
+func PdnSquare(position Position, boardDim int) uint64 {
+	return position.Y*uint64(boardDim/2) + position.X/2 + 1
+}
+
+func formatPdnMove(gameMove GameMove, boardDim int) string {
+	separator := PdnMoveSeparator
+	if len(gameMove.Captured) > 0 {
+		separator = PdnCaptureSeparator
+	}
+	squares := make([]string, 0, len(gameMove.Positions))
+	for _, position := range gameMove.Positions {
+		squares = append(squares, strconv.FormatUint(PdnSquare(position, boardDim), 10))
+	}
+	return strings.Join(squares, separator)
+}
+
+// joinCaptureChains merges the hops of a capture chain played in separate messages into a single move.
+func joinCaptureChains(gameMoves []GameMove) []GameMove {
+	joined := make([]GameMove, 0, len(gameMoves))
+	for _, gameMove := range gameMoves {
+		last := len(joined) - 1
+		if 0 <= last && joined[last].Player == gameMove.Player && len(gameMove.Positions) > 0 {
+			joined[last].Positions = append(joined[last].Positions, gameMove.Positions[1:]...)
+			joined[last].Captured = append(joined[last].Captured, gameMove.Captured...)
+			joined[last].Board = gameMove.Board
+		} else {
+			gameMove.Positions = append([]Position{}, gameMove.Positions...)
+			gameMove.Captured = append([]Position{}, gameMove.Captured...)
+			joined = append(joined, gameMove)
+		}
+	}
+	return joined
+}
+
+// PdnGame is what the PDN headers need to know of a game, be it stored or archived.
+type PdnGame interface {
+	GetIndex() string
+	GetBlack() string
+	GetRed() string
+	GetWinner() string
+	GetVariant() string
+}
+
+// FormatPdn writes the game in Portable Draughts Notation, with Red playing as White.
+func FormatPdn(pdnGame PdnGame, gameMoves []GameMove) (pdn string, err error) {
+	variant, found := rules.ParseVariant(pdnGame.GetVariant())
+	if !found {
+		return "", sdkerrors.Wrapf(ErrUnknownVariant, "%s", pdnGame.GetVariant())
+	}
+	result, found := PdnResults[pdnGame.GetWinner()]
+	if !found {
+		result = PdnUnknownResult
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "[Event \"Game %s\"]\n", pdnGame.GetIndex())
+	fmt.Fprintf(&builder, "[Black \"%s\"]\n", pdnGame.GetBlack())
+	fmt.Fprintf(&builder, "[White \"%s\"]\n", pdnGame.GetRed())
+	fmt.Fprintf(&builder, "[Result \"%s\"]\n", result)
+	fmt.Fprintf(&builder, "[GameType \"%s\"]\n\n", PdnGameTypes[variant.Name()])
+
+	turn := 0
+	for _, gameMove := range joinCaptureChains(gameMoves) {
+		if gameMove.Player == rules.PieceStrings[rules.BLACK_PLAYER] {
+			turn++
+			fmt.Fprintf(&builder, "%d. ", turn)
+		}
+		builder.WriteString(formatPdnMove(gameMove, variant.BoardDim()))
+		builder.WriteString(" ")
+	}
+	builder.WriteString(result)
+	return builder.String(), nil
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPdnSquareAmerican(t *testing.T) {
+	require.EqualValues(t, 1, types.PdnSquare(types.Position{X: 1, Y: 0}, 8))
+	require.EqualValues(t, 11, types.PdnSquare(types.Position{X: 5, Y: 2}, 8))
+	require.EqualValues(t, 15, types.PdnSquare(types.Position{X: 4, Y: 3}, 8))
+	require.EqualValues(t, 32, types.PdnSquare(types.Position{X: 6, Y: 7}, 8))
+}
+
+func TestPdnSquareInternational(t *testing.T) {
+	require.EqualValues(t, 1, types.PdnSquare(types.Position{X: 1, Y: 0}, 10))
+	require.EqualValues(t, 50, types.PdnSquare(types.Position{X: 8, Y: 9}, 10))
+}
+
+func TestFormatPdnNoMoves(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Variant = "international"
+	pdn, err := types.FormatPdn(&storedGame, []types.GameMove{})
+	require.Nil(t, err)
+	require.Equal(t, "[Event \"Game 1\"]\n[Black \""+alice+"\"]\n[White \""+bob+"\"]\n[Result \"*\"]\n[GameType \"20\"]\n\n*", pdn)
+}
+
+func TestFormatPdnJoinsCaptureChain(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Winner = "d"
+	pdn, err := types.FormatPdn(&storedGame, []types.GameMove{
+		{Player: "b", Positions: []types.Position{{X: 5, Y: 2}, {X: 4, Y: 3}}},
+		{Player: "r", Positions: []types.Position{{X: 2, Y: 5}, {X: 3, Y: 4}}},
+		{Player: "b", Positions: []types.Position{{X: 4, Y: 3}, {X: 2, Y: 5}}, Captured: []types.Position{{X: 3, Y: 4}}},
+		{Player: "b", Positions: []types.Position{{X: 2, Y: 5}, {X: 0, Y: 3}}, Captured: []types.Position{{X: 1, Y: 4}}},
+		{Player: "r", Positions: []types.Position{{X: 1, Y: 6}, {X: 2, Y: 5}}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "[Event \"Game 1\"]\n[Black \""+alice+"\"]\n[White \""+bob+"\"]\n[Result \"1/2-1/2\"]\n[GameType \"21\"]\n\n"+
+		"1. 11-15 22-18 2. 15x22x13 25-22 1/2-1/2", pdn)
+}
+
+func TestFormatPdnUnknownVariant(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Variant = "chess"
+	_, err := types.FormatPdn(&storedGame, []types.GameMove{})
+	require.EqualError(t, err, "chess: unknown rule variant: %s")
+}
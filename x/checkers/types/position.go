@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+)
+
+func NewPositions(positions []rules.Pos) []Position {
+	converted := make([]Position, 0, len(positions))
+	for _, position := range positions {
+		converted = append(converted, Position{
+			X: uint64(position.X),
+			Y: uint64(position.Y),
+		})
+	}
+	return converted
+}
+
+func FormatPosition(position Position) string {
+	return strconv.FormatUint(position.X, 10) + PositionSeparator + strconv.FormatUint(position.Y, 10)
+}
+
+func FormatPositions(positions []Position) string {
+	formatted := make([]string, 0, len(positions))
+	for _, position := range positions {
+		formatted = append(formatted, FormatPosition(position))
+	}
+	return strings.Join(formatted, PositionsSeparator)
+}
+
+func ParsePosition(s string) (position Position, err error) {
+	coordinates := strings.Split(s, PositionSeparator)
+	if len(coordinates) != 2 {
+		return Position{}, errors.New(fmt.Sprintf("invalid position: %s", s))
+	}
+	position.X, err = strconv.ParseUint(coordinates[0], 10, 64)
+	if err != nil {
+		return Position{}, err
+	}
+	position.Y, err = strconv.ParseUint(coordinates[1], 10, 64)
+	if err != nil {
+		return Position{}, err
+	}
+	return position, nil
+}
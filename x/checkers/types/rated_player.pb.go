@@ -0,0 +1,402 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/rated_player.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type RatedPlayer struct {
+	PlayerAddress string `protobuf:"bytes,1,opt,name=playerAddress,proto3" json:"playerAddress,omitempty"`
+	Rating        uint64 `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	DateAdded     string `protobuf:"bytes,3,opt,name=dateAdded,proto3" json:"dateAdded,omitempty"`
+}
+
+func (m *RatedPlayer) Reset()         { *m = RatedPlayer{} }
+func (m *RatedPlayer) String() string { return proto.CompactTextString(m) }
+func (*RatedPlayer) ProtoMessage()    {}
+func (*RatedPlayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d2a1e036fd9aa884, []int{0}
+}
+func (m *RatedPlayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RatedPlayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RatedPlayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RatedPlayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatedPlayer.Merge(m, src)
+}
+func (m *RatedPlayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *RatedPlayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatedPlayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatedPlayer proto.InternalMessageInfo
+
+func (m *RatedPlayer) GetPlayerAddress() string {
+	if m != nil {
+		return m.PlayerAddress
+	}
+	return ""
+}
+
+func (m *RatedPlayer) GetRating() uint64 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *RatedPlayer) GetDateAdded() string {
+	if m != nil {
+		return m.DateAdded
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RatedPlayer)(nil), "b9lab.checkers.checkers.RatedPlayer")
+}
+
+func init() { proto.RegisterFile("checkers/rated_player.proto", fileDescriptor_d2a1e036fd9aa884) }
+
+var fileDescriptor_d2a1e036fd9aa884 = []byte{
+	// 190 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0xce, 0x48, 0x4d,
+	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x4a, 0x2c, 0x49, 0x4d, 0x89, 0x2f, 0xc8, 0x49, 0xac, 0x4c,
+	0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xb2, 0xcc, 0x49, 0x4c, 0xd2, 0x83,
+	0x29, 0x81, 0x33, 0x94, 0x32, 0xb9, 0xb8, 0x83, 0x40, 0xca, 0x03, 0xc0, 0xaa, 0x85, 0x54, 0xb8,
+	0x78, 0x21, 0xfa, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38,
+	0x83, 0x50, 0x05, 0x85, 0xc4, 0xb8, 0xd8, 0x8a, 0x12, 0x4b, 0x32, 0xf3, 0xd2, 0x25, 0x98, 0x14,
+	0x18, 0x35, 0x58, 0x82, 0xa0, 0x3c, 0x21, 0x19, 0x2e, 0xce, 0x94, 0xc4, 0x92, 0x54, 0xc7, 0x94,
+	0x94, 0xd4, 0x14, 0x09, 0x66, 0xb0, 0x4e, 0x84, 0x80, 0x93, 0xcb, 0x89, 0x47, 0x72, 0x8c, 0x17,
+	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
+	0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7,
+	0xea, 0x83, 0x1d, 0xaa, 0x0f, 0xf7, 0x4b, 0x05, 0x82, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
+	0x06, 0xf6, 0x90, 0x31, 0x60, 0x00, 0x42, 0x55, 0x5b, 0x6f, 0xef, 0x00, 0x00, 0x00,
+}
+
+func (m *RatedPlayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RatedPlayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RatedPlayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DateAdded) > 0 {
+		i -= len(m.DateAdded)
+		copy(dAtA[i:], m.DateAdded)
+		i = encodeVarintRatedPlayer(dAtA, i, uint64(len(m.DateAdded)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Rating != 0 {
+		i = encodeVarintRatedPlayer(dAtA, i, uint64(m.Rating))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PlayerAddress) > 0 {
+		i -= len(m.PlayerAddress)
+		copy(dAtA[i:], m.PlayerAddress)
+		i = encodeVarintRatedPlayer(dAtA, i, uint64(len(m.PlayerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRatedPlayer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRatedPlayer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RatedPlayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlayerAddress)
+	if l > 0 {
+		n += 1 + l + sovRatedPlayer(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 1 + sovRatedPlayer(uint64(m.Rating))
+	}
+	l = len(m.DateAdded)
+	if l > 0 {
+		n += 1 + l + sovRatedPlayer(uint64(l))
+	}
+	return n
+}
+
+func sovRatedPlayer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRatedPlayer(x uint64) (n int) {
+	return sovRatedPlayer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RatedPlayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRatedPlayer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RatedPlayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RatedPlayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatedPlayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatedPlayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatedPlayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			m.Rating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatedPlayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rating |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateAdded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRatedPlayer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRatedPlayer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRatedPlayer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateAdded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRatedPlayer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRatedPlayer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRatedPlayer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRatedPlayer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRatedPlayer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRatedPlayer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRatedPlayer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRatedPlayer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRatedPlayer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRatedPlayer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRatedPlayer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRatedPlayer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,137 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ratings follow the Glicko system. The math is done with sdk.Dec so that all validators reach the same result,
+// and the outcome is rounded to whole points before being stored.
+const (
+	DefaultRating          = uint64(1500)
+	DefaultRatingDeviation = uint64(350)
+	// MinRatingDeviation keeps a settled player's rating able to move.
+	MinRatingDeviation = uint64(30)
+	// maxRatingExponent caps the power of 10 in the expected score, beyond which the expectation is a certainty anyway.
+	maxRatingExponent = int64(10)
+	expTaylorTerms    = 40
+)
+
+var (
+	ratingScale = sdk.NewDec(400)
+	ln10        = sdk.MustNewDecFromStr("2.302585092994045684")
+	piSquared   = sdk.MustNewDecFromStr("9.869604401089358619")
+	// glickoQ is ln(10) / 400.
+	glickoQ = ln10.Quo(ratingScale)
+
+	WinScore  = sdk.OneDec()
+	DrawScore = sdk.NewDecWithPrec(5, 1)
+	LossScore = sdk.ZeroDec()
+)
+
+func NewDefaultPlayerRating(index string) PlayerRating {
+	return PlayerRating{
+		Index:           index,
+		Rating:          DefaultRating,
+		RatingDeviation: DefaultRatingDeviation,
+	}
+}
+
+// IsInBand tells whether the rating is within [min, max], where a 0 bound means no bound.
+func (playerRating PlayerRating) IsInBand(min uint64, max uint64) bool {
+	if min != 0 && playerRating.Rating < min {
+		return false
+	}
+	if max != 0 && max < playerRating.Rating {
+		return false
+	}
+	return true
+}
+
+// expDec computes e^x by halving x until the Taylor series converges quickly, then squaring back.
+func expDec(x sdk.Dec) sdk.Dec {
+	if x.IsNegative() {
+		return sdk.OneDec().Quo(expDec(x.Neg()))
+	}
+	halvings := 0
+	half := sdk.NewDecWithPrec(5, 1)
+	for x.GT(half) {
+		x = x.QuoInt64(2)
+		halvings++
+	}
+	sum := sdk.OneDec()
+	term := sdk.OneDec()
+	for n := int64(1); n <= expTaylorTerms; n++ {
+		term = term.Mul(x).QuoInt64(n)
+		if term.IsZero() {
+			break
+		}
+		sum = sum.Add(term)
+	}
+	for ; halvings > 0; halvings-- {
+		sum = sum.Mul(sum)
+	}
+	return sum
+}
+
+func pow10Dec(x sdk.Dec) sdk.Dec {
+	return expDec(x.Mul(ln10))
+}
+
+func mustSqrtDec(x sdk.Dec) sdk.Dec {
+	root, err := x.ApproxSqrt()
+	if err != nil {
+		panic(err.Error())
+	}
+	return root
+}
+
+// glickoG dampens the weight of a game against an opponent whose rating is uncertain.
+func glickoG(deviation sdk.Dec) sdk.Dec {
+	inner := sdk.NewDec(3).Mul(glickoQ).Mul(glickoQ).Mul(deviation).Mul(deviation).Quo(piSquared)
+	return sdk.OneDec().Quo(mustSqrtDec(sdk.OneDec().Add(inner)))
+}
+
+// ExpectedScore is the probability, between 0 and 1, that the player beats the opponent.
+func ExpectedScore(player PlayerRating, opponent PlayerRating) sdk.Dec {
+	return expectedScore(
+		sdk.NewDecFromInt(sdk.NewIntFromUint64(player.Rating)),
+		sdk.NewDecFromInt(sdk.NewIntFromUint64(opponent.Rating)),
+		glickoG(sdk.NewDecFromInt(sdk.NewIntFromUint64(opponent.RatingDeviation))))
+}
+
+func expectedScore(rating sdk.Dec, opponentRating sdk.Dec, opponentG sdk.Dec) sdk.Dec {
+	exponent := opponentG.Mul(opponentRating.Sub(rating)).Quo(ratingScale)
+	maxExponent := sdk.NewDec(maxRatingExponent)
+	if exponent.GT(maxExponent) {
+		exponent = maxExponent
+	} else if exponent.LT(maxExponent.Neg()) {
+		exponent = maxExponent.Neg()
+	}
+	return sdk.OneDec().Quo(sdk.OneDec().Add(pow10Dec(exponent)))
+}
+
+// UpdateRating returns the player's new rating after a single game against the opponent, where score is 1 for a
+// win, 0.5 for a draw and 0 for a loss. Both players are expected to be updated from their ratings before the game.
+func UpdateRating(player PlayerRating, opponent PlayerRating, score sdk.Dec) PlayerRating {
+	rating := sdk.NewDecFromInt(sdk.NewIntFromUint64(player.Rating))
+	deviation := sdk.NewDecFromInt(sdk.NewIntFromUint64(player.RatingDeviation))
+	opponentG := glickoG(sdk.NewDecFromInt(sdk.NewIntFromUint64(opponent.RatingDeviation)))
+	expected := expectedScore(rating, sdk.NewDecFromInt(sdk.NewIntFromUint64(opponent.Rating)), opponentG)
+
+	// 1 / d^2, the information gained from the game
+	information := glickoQ.Mul(glickoQ).Mul(opponentG).Mul(opponentG).Mul(expected).Mul(sdk.OneDec().Sub(expected))
+	precision := sdk.OneDec().Quo(deviation.Mul(deviation)).Add(information)
+
+	newRating := rating.Add(glickoQ.Quo(precision).Mul(opponentG).Mul(score.Sub(expected)))
+	newDeviation := mustSqrtDec(sdk.OneDec().Quo(precision))
+
+	player.Rating = 0
+	if newRating.IsPositive() {
+		player.Rating = newRating.RoundInt().Uint64()
+	}
+	player.RatingDeviation = newDeviation.RoundInt().Uint64()
+	if player.RatingDeviation < MinRatingDeviation {
+		player.RatingDeviation = MinRatingDeviation
+	}
+	return player
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+func (leaderboard RatingLeaderboard) Validate() error {
+	// Check for duplicated player address in players
+	playerIndexMap := make(map[string]struct{})
+
+	for _, elem := range leaderboard.Players {
+		index := string(PlayerRatingKey(elem.PlayerAddress))
+		if _, ok := playerIndexMap[index]; ok {
+			return fmt.Errorf("duplicated playerAddress for rated player")
+		}
+		playerIndexMap[index] = struct{}{}
+	}
+	return nil
+}
+
+type RatedPlayerParsed struct {
+	PlayerAddress string
+	Rating        uint64
+	DateAdded     time.Time
+}
+
+func (ratedPlayer RatedPlayer) Parse() (parsed RatedPlayerParsed, err error) {
+	dateAdded, err := ParseDateAddedAsTime(ratedPlayer.DateAdded)
+	if err != nil {
+		return RatedPlayerParsed{}, err
+	}
+	return RatedPlayerParsed{
+		PlayerAddress: ratedPlayer.PlayerAddress,
+		Rating:        ratedPlayer.Rating,
+		DateAdded:     dateAdded,
+	}, nil
+}
+
+func (parsed RatedPlayerParsed) Stringify() RatedPlayer {
+	return RatedPlayer{
+		PlayerAddress: parsed.PlayerAddress,
+		Rating:        parsed.Rating,
+		DateAdded:     FormatDateAdded(parsed.DateAdded),
+	}
+}
+
+func (leaderboard RatingLeaderboard) ParsePlayers() (parsedPlayers []RatedPlayerParsed, err error) {
+	parsedPlayers = make([]RatedPlayerParsed, len(leaderboard.Players))
+	var parsed RatedPlayerParsed
+	for index, ratedPlayer := range leaderboard.Players {
+		parsed, err = ratedPlayer.Parse()
+		if err != nil {
+			return nil, err
+		}
+		parsedPlayers[index] = parsed
+	}
+	return parsedPlayers, nil
+}
+
+func StringifyRatedPlayers(players []RatedPlayerParsed) []RatedPlayer {
+	stringified := make([]RatedPlayer, len(players))
+	for index, player := range players {
+		stringified[index] = player.Stringify()
+	}
+	return stringified
+}
+
+func SortRatedPlayers(players []RatedPlayerParsed) {
+	sort.SliceStable(players[:], func(i, j int) bool {
+		if players[i].Rating > players[j].Rating {
+			return true
+		}
+		if players[i].Rating < players[j].Rating {
+			return false
+		}
+		return players[i].DateAdded.After(players[j].DateAdded)
+	})
+}
+
+// UpdatePlayerRatingAtNow replaces the candidate's entry whether the rating went up or down, since a rating
+// leaderboard must also reflect losses.
+func UpdatePlayerRatingAtNow(players []RatedPlayerParsed, now time.Time, candidate PlayerRating, length uint64) (updated []RatedPlayerParsed) {
+	entry := RatedPlayerParsed{
+		PlayerAddress: candidate.Index,
+		Rating:        candidate.Rating,
+		DateAdded:     now,
+	}
+	found := false
+	for index, player := range players {
+		if player.PlayerAddress == candidate.Index {
+			players[index] = entry
+			found = true
+			break
+		}
+	}
+	if !found {
+		updated = append(players, entry)
+	} else {
+		updated = players
+	}
+	SortRatedPlayers(updated)
+	if length < uint64(len(updated)) {
+		updated = updated[:length]
+	}
+	return updated
+}
+
+func (leaderboard *RatingLeaderboard) UpdatePlayerRatingAtNow(now time.Time, candidate PlayerRating, length uint64) error {
+	players, err := leaderboard.ParsePlayers()
+	if err != nil {
+		return err
+	}
+	updated := UpdatePlayerRatingAtNow(players, now, candidate, length)
+	leaderboard.Players = StringifyRatedPlayers(updated)
+	return nil
+}
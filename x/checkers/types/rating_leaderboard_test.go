@@ -0,0 +1,164 @@
+package types_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatePlayerRatingAtNow(t *testing.T) {
+	tests := []struct {
+		name      string
+		sorted    []types.RatedPlayer
+		candidate types.PlayerRating
+		now       string
+		expected  []types.RatedPlayer
+	}{
+		{
+			name:   "add to empty",
+			sorted: []types.RatedPlayer{},
+			candidate: types.PlayerRating{
+				Index:  "alice",
+				Rating: 1662,
+			},
+			now: "2006-01-02 15:05:05.999999999 +0000 UTC",
+			expected: []types.RatedPlayer{
+				{
+					PlayerAddress: "alice",
+					Rating:        1662,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+			},
+		},
+		{
+			name: "bob ahead by rating",
+			sorted: []types.RatedPlayer{
+				{
+					PlayerAddress: "alice",
+					Rating:        1662,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+			},
+			candidate: types.PlayerRating{
+				Index:  "bob",
+				Rating: 1700,
+			},
+			now: "2006-01-02 15:05:05.999999999 +0000 UTC",
+			expected: []types.RatedPlayer{
+				{
+					PlayerAddress: "bob",
+					Rating:        1700,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+				{
+					PlayerAddress: "alice",
+					Rating:        1662,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+			},
+		},
+		{
+			name: "bob ahead by time",
+			sorted: []types.RatedPlayer{
+				{
+					PlayerAddress: "alice",
+					Rating:        1662,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+			},
+			candidate: types.PlayerRating{
+				Index:  "bob",
+				Rating: 1662,
+			},
+			now: "2006-01-02 15:05:06.999999999 +0000 UTC",
+			expected: []types.RatedPlayer{
+				{
+					PlayerAddress: "bob",
+					Rating:        1662,
+					DateAdded:     "2006-01-02 15:05:06.999999999 +0000 UTC",
+				},
+				{
+					PlayerAddress: "alice",
+					Rating:        1662,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+			},
+		},
+		{
+			name: "alice drops behind bob",
+			sorted: []types.RatedPlayer{
+				{
+					PlayerAddress: "alice",
+					Rating:        1700,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+				{
+					PlayerAddress: "bob",
+					Rating:        1600,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+			},
+			candidate: types.PlayerRating{
+				Index:  "alice",
+				Rating: 1550,
+			},
+			now: "2006-01-02 15:05:06.999999999 +0000 UTC",
+			expected: []types.RatedPlayer{
+				{
+					PlayerAddress: "bob",
+					Rating:        1600,
+					DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+				},
+				{
+					PlayerAddress: "alice",
+					Rating:        1550,
+					DateAdded:     "2006-01-02 15:05:06.999999999 +0000 UTC",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now, err := types.ParseDateAddedAsTime(tt.now)
+			require.NoError(t, err)
+			leaderboard := types.RatingLeaderboard{
+				Players: tt.sorted,
+			}
+			err = leaderboard.UpdatePlayerRatingAtNow(now, tt.candidate, types.DefaultLeaderboardWinnerLength)
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expected, leaderboard.Players)
+			require.NoError(t, leaderboard.Validate())
+		})
+	}
+}
+
+func TestUpdatePlayerRatingAtNowLongDropLowest(t *testing.T) {
+	beforePlayers := make([]types.RatedPlayer, 100)
+	for i := uint64(0); i < 100; i++ {
+		beforePlayers[i] = types.RatedPlayer{
+			PlayerAddress: strconv.FormatUint(i, 10),
+			Rating:        2000 - i,
+			DateAdded:     "2006-01-02 15:05:05.999999999 +0000 UTC",
+		}
+	}
+	now, err := types.ParseDateAddedAsTime("2006-01-02 15:05:06.999999999 +0000 UTC")
+	require.NoError(t, err)
+	leaderboard := types.RatingLeaderboard{
+		Players: beforePlayers,
+	}
+	err = leaderboard.UpdatePlayerRatingAtNow(now, types.PlayerRating{
+		Index:  "100",
+		Rating: 1950,
+	}, types.DefaultLeaderboardWinnerLength)
+	require.NoError(t, err)
+	require.Equal(t, 100, len(leaderboard.Players))
+	require.EqualValues(t, types.RatedPlayer{
+		PlayerAddress: "100",
+		Rating:        1950,
+		DateAdded:     "2006-01-02 15:05:06.999999999 +0000 UTC",
+	}, leaderboard.Players[50])
+	require.EqualValues(t, "98", leaderboard.Players[99].PlayerAddress)
+	require.NoError(t, leaderboard.Validate())
+}
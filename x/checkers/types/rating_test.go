@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateRating(t *testing.T) {
+	tests := []struct {
+		name     string
+		player   types.PlayerRating
+		opponent types.PlayerRating
+		score    sdk.Dec
+		expected types.PlayerRating
+	}{
+		{
+			name:     "newcomer beats newcomer",
+			player:   types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			opponent: types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			score:    types.WinScore,
+			expected: types.PlayerRating{Rating: 1662, RatingDeviation: 290},
+		},
+		{
+			name:     "newcomer loses to newcomer",
+			player:   types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			opponent: types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			score:    types.LossScore,
+			expected: types.PlayerRating{Rating: 1338, RatingDeviation: 290},
+		},
+		{
+			name:     "newcomers draw",
+			player:   types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			opponent: types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			score:    types.DrawScore,
+			expected: types.PlayerRating{Rating: 1500, RatingDeviation: 290},
+		},
+		{
+			name:     "favourite beats newcomer",
+			player:   types.PlayerRating{Rating: 1700, RatingDeviation: 100},
+			opponent: types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			score:    types.WinScore,
+			expected: types.PlayerRating{Rating: 1712, RatingDeviation: 98},
+		},
+		{
+			name:     "newcomer loses to favourite",
+			player:   types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			opponent: types.PlayerRating{Rating: 1700, RatingDeviation: 100},
+			score:    types.LossScore,
+			expected: types.PlayerRating{Rating: 1401, RatingDeviation: 269},
+		},
+		{
+			name:     "newcomer beats favourite",
+			player:   types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			opponent: types.PlayerRating{Rating: 1700, RatingDeviation: 100},
+			score:    types.WinScore,
+			expected: types.PlayerRating{Rating: 1798, RatingDeviation: 269},
+		},
+		{
+			name:     "farming a newcomer barely pays",
+			player:   types.PlayerRating{Rating: 2000, RatingDeviation: 50},
+			opponent: types.PlayerRating{Rating: 1500, RatingDeviation: 350},
+			score:    types.WinScore,
+			expected: types.PlayerRating{Rating: 2001, RatingDeviation: 50},
+		},
+		{
+			name:     "deviation does not go below minimum",
+			player:   types.PlayerRating{Rating: 1400, RatingDeviation: 30},
+			opponent: types.PlayerRating{Rating: 1550, RatingDeviation: 100},
+			score:    types.WinScore,
+			expected: types.PlayerRating{Rating: 1403, RatingDeviation: 30},
+		},
+		{
+			name:     "rating does not go below zero",
+			player:   types.PlayerRating{Rating: 10, RatingDeviation: 350},
+			opponent: types.PlayerRating{Rating: 10, RatingDeviation: 350},
+			score:    types.LossScore,
+			expected: types.PlayerRating{Rating: 0, RatingDeviation: 290},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.EqualValues(t, tt.expected, types.UpdateRating(tt.player, tt.opponent, tt.score))
+		})
+	}
+}
+
+func TestExpectedScore(t *testing.T) {
+	newcomer := types.NewDefaultPlayerRating("alice")
+	require.EqualValues(t, sdk.NewDecWithPrec(5, 1), types.ExpectedScore(newcomer, newcomer))
+	favourite := types.PlayerRating{Rating: 1700, RatingDeviation: 100}
+	require.EqualValues(t, "0.683584620363603",
+		types.ExpectedScore(favourite, newcomer).String()[:17])
+	require.EqualValues(t, "0.250236132839855",
+		types.ExpectedScore(newcomer, favourite).String()[:17])
+}
+
+func TestExpectedScoreIsBoundedForLargeGap(t *testing.T) {
+	strong := types.PlayerRating{Rating: 100000, RatingDeviation: 30}
+	weak := types.PlayerRating{Rating: 0, RatingDeviation: 30}
+	expected := types.ExpectedScore(strong, weak)
+	require.True(t, expected.LT(sdk.OneDec()))
+	require.True(t, expected.GT(sdk.MustNewDecFromStr("0.999999999")))
+	require.True(t, types.ExpectedScore(weak, strong).IsPositive())
+}
+
+func TestPlayerRatingIsInBand(t *testing.T) {
+	rating := types.PlayerRating{Rating: 1500, RatingDeviation: 350}
+	require.True(t, rating.IsInBand(0, 0))
+	require.True(t, rating.IsInBand(1500, 1500))
+	require.True(t, rating.IsInBand(1400, 0))
+	require.True(t, rating.IsInBand(0, 1600))
+	require.False(t, rating.IsInBand(1501, 0))
+	require.False(t, rating.IsInBand(0, 1499))
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,18 +26,33 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StoredGame struct {
-	Index       string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Board       string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
-	Turn        string `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
-	Black       string `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
-	Red         string `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
-	MoveCount   uint64 `protobuf:"varint,6,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
-	BeforeIndex string `protobuf:"bytes,7,opt,name=beforeIndex,proto3" json:"beforeIndex,omitempty"`
-	AfterIndex  string `protobuf:"bytes,8,opt,name=afterIndex,proto3" json:"afterIndex,omitempty"`
-	Deadline    string `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Winner      string `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
-	Wager       uint64 `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
-	Denom       string `protobuf:"bytes,12,opt,name=denom,proto3" json:"denom,omitempty"`
+	Index           string                                   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Board           string                                   `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Turn            string                                   `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	Black           string                                   `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
+	Red             string                                   `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
+	MoveCount       uint64                                   `protobuf:"varint,6,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	BeforeIndex     string                                   `protobuf:"bytes,7,opt,name=beforeIndex,proto3" json:"beforeIndex,omitempty"`
+	AfterIndex      string                                   `protobuf:"bytes,8,opt,name=afterIndex,proto3" json:"afterIndex,omitempty"`
+	Deadline        string                                   `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Winner          string                                   `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
+	LegacyWager     uint64                                   `protobuf:"varint,11,opt,name=legacyWager,proto3" json:"legacyWager,omitempty"` // Deprecated: Do not use.
+	LegacyDenom     string                                   `protobuf:"bytes,12,opt,name=legacyDenom,proto3" json:"legacyDenom,omitempty"`  // Deprecated: Do not use.
+	DrawOfferer     string                                   `protobuf:"bytes,13,opt,name=drawOfferer,proto3" json:"drawOfferer,omitempty"`
+	PositionHistory []uint64                                 `protobuf:"varint,14,rep,packed,name=positionHistory,proto3" json:"positionHistory,omitempty"`
+	Variant         string                                   `protobuf:"bytes,15,opt,name=variant,proto3" json:"variant,omitempty"`
+	CapturingPiece  string                                   `protobuf:"bytes,16,opt,name=capturingPiece,proto3" json:"capturingPiece,omitempty"`
+	TimeControl     TimeControl                              `protobuf:"bytes,17,opt,name=timeControl,proto3" json:"timeControl"`
+	BlackTimeLeft   uint64                                   `protobuf:"varint,18,opt,name=blackTimeLeft,proto3" json:"blackTimeLeft,omitempty"`
+	RedTimeLeft     uint64                                   `protobuf:"varint,19,opt,name=redTimeLeft,proto3" json:"redTimeLeft,omitempty"`
+	Wager           github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=wager,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wager"`
+	TournamentIndex string                                   `protobuf:"bytes,21,opt,name=tournamentIndex,proto3" json:"tournamentIndex,omitempty"`
+	PreviousIndex   string                                   `protobuf:"bytes,22,opt,name=previousIndex,proto3" json:"previousIndex,omitempty"`
+	BlackPending    bool                                     `protobuf:"varint,23,opt,name=blackPending,proto3" json:"blackPending,omitempty"`
+	RedPending      bool                                     `protobuf:"varint,24,opt,name=redPending,proto3" json:"redPending,omitempty"`
+	PositionHash    uint64                                   `protobuf:"varint,25,opt,name=positionHash,proto3" json:"positionHash,omitempty"`
+	PackedBoard     []byte                                   `protobuf:"bytes,26,opt,name=packedBoard,proto3" json:"packedBoard,omitempty"`
+	DeadPieces      []Position                               `protobuf:"bytes,27,rep,name=deadPieces,proto3" json:"deadPieces"`
 }
 
 func (m *StoredGame) Reset()         { *m = StoredGame{} }
@@ -140,20 +158,127 @@ func (m *StoredGame) GetWinner() string {
 	return ""
 }
 
-func (m *StoredGame) GetWager() uint64 {
+// Deprecated: Do not use.
+func (m *StoredGame) GetLegacyWager() uint64 {
 	if m != nil {
-		return m.Wager
+		return m.LegacyWager
 	}
 	return 0
 }
 
-func (m *StoredGame) GetDenom() string {
+// Deprecated: Do not use.
+func (m *StoredGame) GetLegacyDenom() string {
+	if m != nil {
+		return m.LegacyDenom
+	}
+	return ""
+}
+
+func (m *StoredGame) GetDrawOfferer() string {
+	if m != nil {
+		return m.DrawOfferer
+	}
+	return ""
+}
+
+func (m *StoredGame) GetPositionHistory() []uint64 {
+	if m != nil {
+		return m.PositionHistory
+	}
+	return nil
+}
+
+func (m *StoredGame) GetVariant() string {
 	if m != nil {
-		return m.Denom
+		return m.Variant
 	}
 	return ""
 }
 
+func (m *StoredGame) GetCapturingPiece() string {
+	if m != nil {
+		return m.CapturingPiece
+	}
+	return ""
+}
+
+func (m *StoredGame) GetTimeControl() TimeControl {
+	if m != nil {
+		return m.TimeControl
+	}
+	return TimeControl{}
+}
+
+func (m *StoredGame) GetBlackTimeLeft() uint64 {
+	if m != nil {
+		return m.BlackTimeLeft
+	}
+	return 0
+}
+
+func (m *StoredGame) GetRedTimeLeft() uint64 {
+	if m != nil {
+		return m.RedTimeLeft
+	}
+	return 0
+}
+
+func (m *StoredGame) GetWager() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Wager
+	}
+	return nil
+}
+
+func (m *StoredGame) GetTournamentIndex() string {
+	if m != nil {
+		return m.TournamentIndex
+	}
+	return ""
+}
+
+func (m *StoredGame) GetPreviousIndex() string {
+	if m != nil {
+		return m.PreviousIndex
+	}
+	return ""
+}
+
+func (m *StoredGame) GetBlackPending() bool {
+	if m != nil {
+		return m.BlackPending
+	}
+	return false
+}
+
+func (m *StoredGame) GetRedPending() bool {
+	if m != nil {
+		return m.RedPending
+	}
+	return false
+}
+
+func (m *StoredGame) GetPositionHash() uint64 {
+	if m != nil {
+		return m.PositionHash
+	}
+	return 0
+}
+
+func (m *StoredGame) GetPackedBoard() []byte {
+	if m != nil {
+		return m.PackedBoard
+	}
+	return nil
+}
+
+func (m *StoredGame) GetDeadPieces() []Position {
+	if m != nil {
+		return m.DeadPieces
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StoredGame)(nil), "b9lab.checkers.checkers.StoredGame")
 }
@@ -161,26 +286,49 @@ func init() {
 func init() { proto.RegisterFile("checkers/stored_game.proto", fileDescriptor_8439c9c90688ff75) }
 
 var fileDescriptor_8439c9c90688ff75 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0x4e, 0x02, 0x41,
-	0x10, 0xc6, 0x59, 0xfe, 0x09, 0x83, 0x85, 0xd9, 0x18, 0xdd, 0x10, 0xb3, 0x21, 0x56, 0xc4, 0x02,
-	0x0a, 0x2b, 0x5b, 0x35, 0x31, 0xb6, 0xd8, 0xd9, 0x98, 0xdd, 0xdb, 0x01, 0x2e, 0x70, 0xbb, 0x64,
-	0xd9, 0x13, 0x7c, 0x0b, 0x1f, 0xc6, 0x87, 0xb0, 0xa4, 0xb4, 0x34, 0x77, 0x2f, 0x62, 0x6e, 0x0e,
-	0x0f, 0xba, 0xef, 0xf7, 0x9b, 0xef, 0x92, 0xb9, 0x1d, 0xe8, 0x47, 0x73, 0x8c, 0x16, 0xe8, 0xd7,
-	0xe3, 0x75, 0x70, 0x1e, 0xcd, 0xdb, 0x4c, 0x25, 0x38, 0x5a, 0x79, 0x17, 0x1c, 0xbf, 0xd4, 0x77,
-	0x4b, 0xa5, 0x47, 0xff, 0x8d, 0x2a, 0x5c, 0x7f, 0xd5, 0x01, 0x5e, 0xa8, 0xfe, 0xa4, 0x12, 0xe4,
-	0xe7, 0xd0, 0x8a, 0xad, 0xc1, 0xad, 0x60, 0x03, 0x36, 0xec, 0x4e, 0x4a, 0x28, 0xac, 0x76, 0xca,
-	0x1b, 0x51, 0x2f, 0x2d, 0x01, 0xe7, 0xd0, 0x0c, 0xa9, 0xb7, 0xa2, 0x41, 0x92, 0x32, 0x35, 0x97,
-	0x2a, 0x5a, 0x88, 0xe6, 0xbe, 0x59, 0x00, 0x3f, 0x83, 0x86, 0x47, 0x23, 0x5a, 0xe4, 0x8a, 0xc8,
-	0xaf, 0xa0, 0x9b, 0xb8, 0x77, 0x7c, 0x70, 0xa9, 0x0d, 0xa2, 0x3d, 0x60, 0xc3, 0xe6, 0xe4, 0x20,
-	0xf8, 0x00, 0x7a, 0x1a, 0xa7, 0xce, 0xe3, 0x33, 0xed, 0x72, 0x42, 0xdf, 0x1d, 0x2b, 0x2e, 0x01,
-	0xd4, 0x34, 0xa0, 0x2f, 0x0b, 0x1d, 0x2a, 0x1c, 0x19, 0xde, 0x87, 0x8e, 0x41, 0x65, 0x96, 0xb1,
-	0x45, 0xd1, 0xa5, 0x69, 0xc5, 0xfc, 0x02, 0xda, 0x9b, 0xd8, 0x5a, 0xf4, 0x02, 0x68, 0xb2, 0xa7,
-	0x62, 0xf7, 0x8d, 0x9a, 0xa1, 0x17, 0x3d, 0xda, 0xa7, 0x84, 0xc2, 0x1a, 0xb4, 0x2e, 0x11, 0xa7,
-	0xe5, 0x1f, 0x11, 0xdc, 0x3f, 0x7e, 0x67, 0x92, 0xed, 0x32, 0xc9, 0x7e, 0x33, 0xc9, 0x3e, 0x73,
-	0x59, 0xdb, 0xe5, 0xb2, 0xf6, 0x93, 0xcb, 0xda, 0xeb, 0xcd, 0x2c, 0x0e, 0xf3, 0x54, 0x8f, 0x22,
-	0x97, 0x8c, 0xe9, 0xd1, 0xc7, 0xd5, 0x59, 0xb6, 0x87, 0x18, 0x3e, 0x56, 0xb8, 0xd6, 0x6d, 0x3a,
-	0xce, 0xed, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0x33, 0xf4, 0xb3, 0xba, 0x01, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xdd, 0x4e, 0xdb, 0x48,
+	0x14, 0xc7, 0x63, 0x12, 0xbe, 0x26, 0x7c, 0xed, 0x2c, 0x0b, 0x43, 0x58, 0x19, 0x2f, 0x42, 0x2b,
+	0x6b, 0xa5, 0xb5, 0x0b, 0xbd, 0xea, 0x6d, 0x40, 0xa2, 0x95, 0x90, 0x8a, 0xdc, 0x4a, 0x95, 0x7a,
+	0x83, 0xc6, 0xf6, 0x89, 0x19, 0x25, 0x9e, 0x89, 0xc6, 0x93, 0x00, 0x6f, 0xd1, 0xe7, 0xe8, 0x93,
+	0x70, 0xc9, 0x65, 0xaf, 0xda, 0x0a, 0xde, 0xa3, 0xaa, 0xe6, 0xd8, 0x49, 0x1c, 0x24, 0xae, 0x32,
+	0xe7, 0x37, 0xff, 0x73, 0x32, 0x73, 0xce, 0xdf, 0x43, 0x3a, 0xc9, 0x35, 0x24, 0x7d, 0xd0, 0x45,
+	0x58, 0x18, 0xa5, 0x21, 0xbd, 0xca, 0x78, 0x0e, 0xc1, 0x50, 0x2b, 0xa3, 0xe8, 0x6e, 0xfc, 0x66,
+	0xc0, 0xe3, 0x60, 0xa2, 0x98, 0x2e, 0x3a, 0xdb, 0x99, 0xca, 0x14, 0x6a, 0x42, 0xbb, 0x2a, 0xe5,
+	0x1d, 0x37, 0x51, 0x45, 0xae, 0x8a, 0x30, 0xe6, 0x05, 0x84, 0xe3, 0xe3, 0x18, 0x0c, 0x3f, 0x0e,
+	0x13, 0x25, 0x64, 0xb5, 0xbf, 0x3f, 0xfd, 0x2b, 0x23, 0x72, 0xb8, 0x4a, 0x94, 0x34, 0x5a, 0x0d,
+	0xaa, 0xcd, 0xdd, 0xe9, 0xe6, 0x50, 0x15, 0xc2, 0x08, 0x55, 0x65, 0x1d, 0xfe, 0x5a, 0x26, 0xe4,
+	0x03, 0x1e, 0xed, 0x9c, 0xe7, 0x40, 0xb7, 0xc9, 0xa2, 0x90, 0x29, 0xdc, 0x32, 0xc7, 0x73, 0xfc,
+	0xd5, 0xa8, 0x0c, 0x2c, 0x8d, 0x15, 0xd7, 0x29, 0x5b, 0x28, 0x29, 0x06, 0x94, 0x92, 0x96, 0x19,
+	0x69, 0xc9, 0x9a, 0x08, 0x71, 0x8d, 0xca, 0x01, 0x4f, 0xfa, 0xac, 0x55, 0x29, 0x6d, 0x40, 0xb7,
+	0x48, 0x53, 0x43, 0xca, 0x16, 0x91, 0xd9, 0x25, 0xfd, 0x9b, 0xac, 0xe6, 0x6a, 0x0c, 0xa7, 0x6a,
+	0x24, 0x0d, 0x5b, 0xf2, 0x1c, 0xbf, 0x15, 0xcd, 0x00, 0xf5, 0x48, 0x3b, 0x86, 0x9e, 0xd2, 0xf0,
+	0x0e, 0xcf, 0xb2, 0x8c, 0x79, 0x75, 0x44, 0x5d, 0x42, 0x78, 0xcf, 0x80, 0x2e, 0x05, 0x2b, 0x28,
+	0xa8, 0x11, 0xda, 0x21, 0x2b, 0x29, 0xf0, 0x74, 0x20, 0x24, 0xb0, 0x55, 0xdc, 0x9d, 0xc6, 0x74,
+	0x87, 0x2c, 0xdd, 0x08, 0x29, 0x41, 0x33, 0x82, 0x3b, 0x55, 0x44, 0x8f, 0x48, 0x7b, 0x00, 0x19,
+	0x4f, 0xee, 0x3e, 0xf1, 0x0c, 0x34, 0x6b, 0xdb, 0x53, 0x75, 0x17, 0x98, 0x13, 0xd5, 0xf1, 0x4c,
+	0x75, 0x06, 0x52, 0xe5, 0x6c, 0xcd, 0x96, 0xa8, 0xab, 0x10, 0xdb, 0x1b, 0xa4, 0x9a, 0xdf, 0xbc,
+	0xef, 0xf5, 0x40, 0x83, 0x66, 0xeb, 0xe5, 0x0d, 0x6a, 0x88, 0xfa, 0x64, 0x73, 0x32, 0x8a, 0xb7,
+	0xc2, 0x9a, 0xe3, 0x8e, 0x6d, 0x78, 0x4d, 0xbf, 0x15, 0x3d, 0xc7, 0x94, 0x91, 0xe5, 0x31, 0xd7,
+	0x82, 0x4b, 0xc3, 0x36, 0xb1, 0xce, 0x24, 0xa4, 0xff, 0x92, 0x8d, 0x84, 0x0f, 0xcd, 0x48, 0x0b,
+	0x99, 0x5d, 0x0a, 0x48, 0x80, 0x6d, 0xa1, 0xe0, 0x19, 0xa5, 0x17, 0xa4, 0x6d, 0x3d, 0x71, 0x5a,
+	0x5a, 0x82, 0xfd, 0xe1, 0x39, 0x7e, 0xfb, 0xe4, 0x28, 0x78, 0xc1, 0x7f, 0xc1, 0xc7, 0x99, 0xb6,
+	0xdb, 0xba, 0xff, 0x7e, 0xd0, 0x88, 0xea, 0xe9, 0xf4, 0x88, 0xac, 0xe3, 0x58, 0xad, 0xec, 0x02,
+	0x7a, 0x86, 0x51, 0x9c, 0xdf, 0x3c, 0xb4, 0x1d, 0xd0, 0x90, 0x4e, 0x35, 0x7f, 0xa2, 0xa6, 0x8e,
+	0x28, 0x27, 0x8b, 0x37, 0xd8, 0xe9, 0x6d, 0xaf, 0xe9, 0xb7, 0x4f, 0xf6, 0x82, 0xd2, 0xe0, 0x81,
+	0x35, 0x78, 0x50, 0x19, 0x3c, 0x38, 0x55, 0x42, 0x76, 0x5f, 0xd9, 0x43, 0x7c, 0xfd, 0x71, 0xe0,
+	0x67, 0xc2, 0x5c, 0x8f, 0xe2, 0x20, 0x51, 0x79, 0x58, 0x7d, 0x0d, 0xe5, 0xcf, 0xff, 0x45, 0xda,
+	0x0f, 0xcd, 0xdd, 0x10, 0x0a, 0x4c, 0x28, 0xa2, 0xb2, 0xb2, 0x6d, 0xb2, 0x51, 0x23, 0x2d, 0x79,
+	0x0e, 0xd2, 0x94, 0x5e, 0xf9, 0x0b, 0x3b, 0xf4, 0x1c, 0xdb, 0x4b, 0x0d, 0x35, 0x8c, 0x85, 0x1a,
+	0x15, 0xa5, 0x6e, 0x07, 0x75, 0xf3, 0x90, 0x1e, 0x92, 0x35, 0xbc, 0xe5, 0x25, 0xc8, 0x54, 0xc8,
+	0x8c, 0xed, 0x7a, 0x8e, 0xbf, 0x12, 0xcd, 0x31, 0x6b, 0x4d, 0x0d, 0xe9, 0x44, 0xc1, 0x50, 0x51,
+	0x23, 0xb6, 0xc6, 0x74, 0xc2, 0xbc, 0xb8, 0x66, 0x7b, 0xd8, 0x99, 0x39, 0x66, 0x9b, 0x37, 0xe4,
+	0x49, 0x1f, 0xd2, 0x2e, 0x7e, 0x76, 0x1d, 0xcf, 0xf1, 0xd7, 0xa2, 0x3a, 0xa2, 0xe7, 0x84, 0x58,
+	0x43, 0xe3, 0x7c, 0x0b, 0xb6, 0x8f, 0x1d, 0xfc, 0xe7, 0xc5, 0x89, 0x5e, 0x56, 0xc5, 0xab, 0x71,
+	0xd6, 0x52, 0xbb, 0x67, 0xf7, 0x8f, 0xae, 0xf3, 0xf0, 0xe8, 0x3a, 0x3f, 0x1f, 0x5d, 0xe7, 0xcb,
+	0x93, 0xdb, 0x78, 0x78, 0x72, 0x1b, 0xdf, 0x9e, 0xdc, 0xc6, 0xe7, 0xff, 0x6a, 0xdd, 0xc6, 0xc2,
+	0xe1, 0xf4, 0x11, 0xb9, 0x9d, 0x2d, 0xb1, 0xeb, 0xf1, 0x12, 0xbe, 0x26, 0xaf, 0x7f, 0x0f, 0x00,
+	0xf7, 0xca, 0x5c, 0x0a, 0xf0, 0x04, 0x00, 0x00,
 }
 
 func (m *StoredGame) Marshal() (dAtA []byte, err error) {
@@ -203,15 +351,172 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Denom)))
+	if len(m.DeadPieces) > 0 {
+		for iNdEx := len(m.DeadPieces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeadPieces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.PackedBoard) > 0 {
+		i -= len(m.PackedBoard)
+		copy(dAtA[i:], m.PackedBoard)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.PackedBoard)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.PositionHash != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.PositionHash))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.RedPending {
+		i--
+		if m.RedPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.BlackPending {
+		i--
+		if m.BlackPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.PreviousIndex) > 0 {
+		i -= len(m.PreviousIndex)
+		copy(dAtA[i:], m.PreviousIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.PreviousIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.TournamentIndex) > 0 {
+		i -= len(m.TournamentIndex)
+		copy(dAtA[i:], m.TournamentIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.TournamentIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.Wager) > 0 {
+		for iNdEx := len(m.Wager) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Wager[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredGame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.RedTimeLeft != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.RedTimeLeft))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.BlackTimeLeft != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.BlackTimeLeft))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size, err := m.TimeControl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStoredGame(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.CapturingPiece) > 0 {
+		i -= len(m.CapturingPiece)
+		copy(dAtA[i:], m.CapturingPiece)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.CapturingPiece)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.PositionHistory) > 0 {
+		dAtA3 := make([]byte, len(m.PositionHistory)*10)
+		var j2 int
+		for _, num := range m.PositionHistory {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintStoredGame(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.DrawOfferer) > 0 {
+		i -= len(m.DrawOfferer)
+		copy(dAtA[i:], m.DrawOfferer)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.DrawOfferer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.LegacyDenom) > 0 {
+		i -= len(m.LegacyDenom)
+		copy(dAtA[i:], m.LegacyDenom)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.LegacyDenom)))
 		i--
 		dAtA[i] = 0x62
 	}
-	if m.Wager != 0 {
-		i = encodeVarintStoredGame(dAtA, i, uint64(m.Wager))
+	if m.LegacyWager != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.LegacyWager))
 		i--
 		dAtA[i] = 0x58
 	}
@@ -342,13 +647,73 @@ func (m *StoredGame) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
-	if m.Wager != 0 {
-		n += 1 + sovStoredGame(uint64(m.Wager))
+	if m.LegacyWager != 0 {
+		n += 1 + sovStoredGame(uint64(m.LegacyWager))
+	}
+	l = len(m.LegacyDenom)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.DrawOfferer)
+	if l > 0 {
+		n += 1 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.PositionHistory) > 0 {
+		l = 0
+		for _, e := range m.PositionHistory {
+			l += sovStoredGame(uint64(e))
+		}
+		n += 1 + sovStoredGame(uint64(l)) + l
 	}
-	l = len(m.Denom)
+	l = len(m.Variant)
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
+	l = len(m.CapturingPiece)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = m.TimeControl.Size()
+	n += 2 + l + sovStoredGame(uint64(l))
+	if m.BlackTimeLeft != 0 {
+		n += 2 + sovStoredGame(uint64(m.BlackTimeLeft))
+	}
+	if m.RedTimeLeft != 0 {
+		n += 2 + sovStoredGame(uint64(m.RedTimeLeft))
+	}
+	if len(m.Wager) > 0 {
+		for _, e := range m.Wager {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
+	l = len(m.TournamentIndex)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.PreviousIndex)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if m.BlackPending {
+		n += 3
+	}
+	if m.RedPending {
+		n += 3
+	}
+	if m.PositionHash != 0 {
+		n += 2 + sovStoredGame(uint64(m.PositionHash))
+	}
+	l = len(m.PackedBoard)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if len(m.DeadPieces) > 0 {
+		for _, e := range m.DeadPieces {
+			l = e.Size()
+			n += 2 + l + sovStoredGame(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -696,9 +1061,9 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyWager", wireType)
 			}
-			m.Wager = 0
+			m.LegacyWager = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStoredGame
@@ -708,14 +1073,14 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Wager |= uint64(b&0x7F) << shift
+				m.LegacyWager |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -743,7 +1108,475 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.LegacyDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawOfferer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrawOfferer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStoredGame
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PositionHistory = append(m.PositionHistory, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStoredGame
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStoredGame
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStoredGame
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PositionHistory) == 0 {
+					m.PositionHistory = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStoredGame
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PositionHistory = append(m.PositionHistory, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionHistory", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturingPiece", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CapturingPiece = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackTimeLeft", wireType)
+			}
+			m.BlackTimeLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlackTimeLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedTimeLeft", wireType)
+			}
+			m.RedTimeLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedTimeLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wager = append(m.Wager, types.Coin{})
+			if err := m.Wager[len(m.Wager)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlackPending = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedPending = bool(v != 0)
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionHash", wireType)
+			}
+			m.PositionHash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionHash |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedBoard", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PackedBoard = append(m.PackedBoard[:0], dAtA[iNdEx:postIndex]...)
+			if m.PackedBoard == nil {
+				m.PackedBoard = []byte{}
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadPieces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadPieces = append(m.DeadPieces, Position{})
+			if err := m.DeadPieces[len(m.DeadPieces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23,9 +23,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type SystemInfo struct {
-	NextId        uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
-	FifoHeadIndex string `protobuf:"bytes,2,opt,name=fifoHeadIndex,proto3" json:"fifoHeadIndex,omitempty"`
-	FifoTailIndex string `protobuf:"bytes,3,opt,name=fifoTailIndex,proto3" json:"fifoTailIndex,omitempty"`
+	NextId                 uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
+	NextChallengeId        uint64 `protobuf:"varint,4,opt,name=nextChallengeId,proto3" json:"nextChallengeId,omitempty"`
+	ChallengeFifoHeadIndex string `protobuf:"bytes,5,opt,name=challengeFifoHeadIndex,proto3" json:"challengeFifoHeadIndex,omitempty"`
+	ChallengeFifoTailIndex string `protobuf:"bytes,6,opt,name=challengeFifoTailIndex,proto3" json:"challengeFifoTailIndex,omitempty"`
+	NextTournamentId       uint64 `protobuf:"varint,7,opt,name=nextTournamentId,proto3" json:"nextTournamentId,omitempty"`
 }
 
 func (m *SystemInfo) Reset()         { *m = SystemInfo{} }
@@ -68,20 +70,34 @@ func (m *SystemInfo) GetNextId() uint64 {
 	return 0
 }
 
-func (m *SystemInfo) GetFifoHeadIndex() string {
+func (m *SystemInfo) GetNextChallengeId() uint64 {
 	if m != nil {
-		return m.FifoHeadIndex
+		return m.NextChallengeId
+	}
+	return 0
+}
+
+func (m *SystemInfo) GetChallengeFifoHeadIndex() string {
+	if m != nil {
+		return m.ChallengeFifoHeadIndex
 	}
 	return ""
 }
 
-func (m *SystemInfo) GetFifoTailIndex() string {
+func (m *SystemInfo) GetChallengeFifoTailIndex() string {
 	if m != nil {
-		return m.FifoTailIndex
+		return m.ChallengeFifoTailIndex
 	}
 	return ""
 }
 
+func (m *SystemInfo) GetNextTournamentId() uint64 {
+	if m != nil {
+		return m.NextTournamentId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SystemInfo)(nil), "b9lab.checkers.checkers.SystemInfo")
 }
@@ -89,19 +105,23 @@ func init() {
 func init() { proto.RegisterFile("checkers/system_info.proto", fileDescriptor_1580c0dd88c0be2b) }
 
 var fileDescriptor_1580c0dd88c0be2b = []byte{
-	// 191 bytes of a gzipped FileDescriptorProto
+	// 251 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x48, 0x4d,
 	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0xae, 0x2c, 0x2e, 0x49, 0xcd, 0x8d, 0xcf, 0xcc, 0x4b, 0xcb,
 	0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xb2, 0xcc, 0x49, 0x4c, 0xd2, 0x83, 0xa9,
-	0x80, 0x33, 0x94, 0x0a, 0xb8, 0xb8, 0x82, 0xc1, 0xaa, 0x3d, 0xf3, 0xd2, 0xf2, 0x85, 0xc4, 0xb8,
-	0xd8, 0xf2, 0x52, 0x2b, 0x4a, 0x3c, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0xa0, 0x3c,
-	0x21, 0x15, 0x2e, 0xde, 0xb4, 0xcc, 0xb4, 0x7c, 0x8f, 0xd4, 0xc4, 0x14, 0xcf, 0xbc, 0x94, 0xd4,
-	0x0a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x54, 0x41, 0x98, 0xaa, 0x90, 0xc4, 0xcc, 0x1c,
-	0x88, 0x2a, 0x66, 0x84, 0x2a, 0xb8, 0xa0, 0x93, 0xcb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x69, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83,
-	0xdd, 0xab, 0x0f, 0xf7, 0x51, 0x05, 0x82, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6,
-	0x97, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xeb, 0x58, 0xdf, 0xf5, 0x00, 0x00, 0x00,
+	0x80, 0x33, 0x94, 0xbe, 0x31, 0x72, 0x71, 0x05, 0x83, 0x95, 0x7b, 0xe6, 0xa5, 0xe5, 0x0b, 0x89,
+	0x71, 0xb1, 0xe5, 0xa5, 0x56, 0x94, 0x78, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x04, 0x41,
+	0x79, 0x42, 0x1a, 0x5c, 0xfc, 0x20, 0x96, 0x73, 0x46, 0x62, 0x4e, 0x4e, 0x6a, 0x5e, 0x7a, 0xaa,
+	0x67, 0x8a, 0x04, 0x0b, 0x58, 0x01, 0xba, 0xb0, 0x90, 0x19, 0x97, 0x58, 0x32, 0x8c, 0xeb, 0x96,
+	0x99, 0x96, 0xef, 0x91, 0x9a, 0x98, 0xe2, 0x99, 0x97, 0x92, 0x5a, 0x21, 0xc1, 0xaa, 0xc0, 0xa8,
+	0xc1, 0x19, 0x84, 0x43, 0x16, 0x43, 0x5f, 0x48, 0x62, 0x66, 0x0e, 0x44, 0x1f, 0x1b, 0x16, 0x7d,
+	0x70, 0x59, 0x21, 0x2d, 0x2e, 0x01, 0x90, 0x13, 0x42, 0xf2, 0x4b, 0x8b, 0xf2, 0x12, 0x73, 0x53,
+	0xf3, 0x40, 0x6e, 0x67, 0x07, 0x3b, 0x0d, 0x43, 0xdc, 0x8b, 0x85, 0x83, 0x49, 0x80, 0xd9, 0x8b,
+	0x85, 0x83, 0x59, 0x80, 0xc5, 0xc9, 0xe5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f,
+	0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18,
+	0xa2, 0xb4, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xc1, 0xc1, 0xa6,
+	0x0f, 0x0f, 0xd8, 0x0a, 0x04, 0xb3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xbc, 0xc6,
+	0x80, 0x01, 0x00, 0x9c, 0x5d, 0x12, 0xcc, 0x7c, 0x01, 0x00, 0x00,
 }
 
 func (m *SystemInfo) Marshal() (dAtA []byte, err error) {
@@ -124,19 +144,29 @@ func (m *SystemInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.FifoTailIndex) > 0 {
-		i -= len(m.FifoTailIndex)
-		copy(dAtA[i:], m.FifoTailIndex)
-		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.FifoTailIndex)))
+	if m.NextTournamentId != 0 {
+		i = encodeVarintSystemInfo(dAtA, i, uint64(m.NextTournamentId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x38
 	}
-	if len(m.FifoHeadIndex) > 0 {
-		i -= len(m.FifoHeadIndex)
-		copy(dAtA[i:], m.FifoHeadIndex)
-		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.FifoHeadIndex)))
+	if len(m.ChallengeFifoTailIndex) > 0 {
+		i -= len(m.ChallengeFifoTailIndex)
+		copy(dAtA[i:], m.ChallengeFifoTailIndex)
+		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.ChallengeFifoTailIndex)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
+	}
+	if len(m.ChallengeFifoHeadIndex) > 0 {
+		i -= len(m.ChallengeFifoHeadIndex)
+		copy(dAtA[i:], m.ChallengeFifoHeadIndex)
+		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.ChallengeFifoHeadIndex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NextChallengeId != 0 {
+		i = encodeVarintSystemInfo(dAtA, i, uint64(m.NextChallengeId))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.NextId != 0 {
 		i = encodeVarintSystemInfo(dAtA, i, uint64(m.NextId))
@@ -166,14 +196,20 @@ func (m *SystemInfo) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovSystemInfo(uint64(m.NextId))
 	}
-	l = len(m.FifoHeadIndex)
+	if m.NextChallengeId != 0 {
+		n += 1 + sovSystemInfo(uint64(m.NextChallengeId))
+	}
+	l = len(m.ChallengeFifoHeadIndex)
 	if l > 0 {
 		n += 1 + l + sovSystemInfo(uint64(l))
 	}
-	l = len(m.FifoTailIndex)
+	l = len(m.ChallengeFifoTailIndex)
 	if l > 0 {
 		n += 1 + l + sovSystemInfo(uint64(l))
 	}
+	if m.NextTournamentId != 0 {
+		n += 1 + sovSystemInfo(uint64(m.NextTournamentId))
+	}
 	return n
 }
 
@@ -231,9 +267,28 @@ func (m *SystemInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChallengeId", wireType)
+			}
+			m.NextChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSystemInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifoHeadIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeFifoHeadIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -261,11 +316,11 @@ func (m *SystemInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FifoHeadIndex = string(dAtA[iNdEx:postIndex])
+			m.ChallengeFifoHeadIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifoTailIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeFifoTailIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -293,8 +348,27 @@ func (m *SystemInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FifoTailIndex = string(dAtA[iNdEx:postIndex])
+			m.ChallengeFifoTailIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTournamentId", wireType)
+			}
+			m.NextTournamentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSystemInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextTournamentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSystemInfo(dAtA[iNdEx:])
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func SecondsToDuration(seconds uint64) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
+// IsClock tells whether each player has a total time to spend across all their moves.
+func (timeControl TimeControl) IsClock() bool {
+	return 0 < timeControl.TotalTime
+}
+
+func (timeControl TimeControl) Validate() (err error) {
+	if timeControl.MoveTime != 0 && timeControl.TotalTime != 0 {
+		return sdkerrors.Wrapf(ErrInvalidTimeControl, "cannot have both a move time and a total time")
+	}
+	if timeControl.Increment != 0 && timeControl.TotalTime == 0 {
+		return sdkerrors.Wrapf(ErrInvalidTimeControl, "increment %d needs a total time", timeControl.Increment)
+	}
+	return nil
+}
+
+func (timeControl TimeControl) ValidateWithParams(params Params) (err error) {
+	if timeControl.MoveTime != 0 &&
+		(timeControl.MoveTime < params.MinMoveTime || params.MaxMoveTime < timeControl.MoveTime) {
+		return sdkerrors.Wrapf(ErrTimeControlOutOfBounds, "move time %d is not within %d and %d",
+			timeControl.MoveTime, params.MinMoveTime, params.MaxMoveTime)
+	}
+	if timeControl.TotalTime != 0 &&
+		(timeControl.TotalTime < params.MinTotalTime || params.MaxTotalTime < timeControl.TotalTime) {
+		return sdkerrors.Wrapf(ErrTimeControlOutOfBounds, "total time %d is not within %d and %d",
+			timeControl.TotalTime, params.MinTotalTime, params.MaxTotalTime)
+	}
+	if params.MaxIncrement < timeControl.Increment {
+		return sdkerrors.Wrapf(ErrTimeControlOutOfBounds, "increment %d is above %d",
+			timeControl.Increment, params.MaxIncrement)
+	}
+	return nil
+}
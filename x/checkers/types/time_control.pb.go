@@ -0,0 +1,372 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/time_control.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TimeControl is either a limit per move, or a Fischer clock with an increment. All in seconds.
+// When all are 0, the default turn duration applies.
+type TimeControl struct {
+	MoveTime  uint64 `protobuf:"varint,1,opt,name=moveTime,proto3" json:"moveTime,omitempty"`
+	TotalTime uint64 `protobuf:"varint,2,opt,name=totalTime,proto3" json:"totalTime,omitempty"`
+	Increment uint64 `protobuf:"varint,3,opt,name=increment,proto3" json:"increment,omitempty"`
+}
+
+func (m *TimeControl) Reset()         { *m = TimeControl{} }
+func (m *TimeControl) String() string { return proto.CompactTextString(m) }
+func (*TimeControl) ProtoMessage()    {}
+func (*TimeControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1ba887c1e2c29615, []int{0}
+}
+func (m *TimeControl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeControl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeControl.Merge(m, src)
+}
+func (m *TimeControl) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeControl proto.InternalMessageInfo
+
+func (m *TimeControl) GetMoveTime() uint64 {
+	if m != nil {
+		return m.MoveTime
+	}
+	return 0
+}
+
+func (m *TimeControl) GetTotalTime() uint64 {
+	if m != nil {
+		return m.TotalTime
+	}
+	return 0
+}
+
+func (m *TimeControl) GetIncrement() uint64 {
+	if m != nil {
+		return m.Increment
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TimeControl)(nil), "b9lab.checkers.checkers.TimeControl")
+}
+
+func init() { proto.RegisterFile("checkers/time_control.proto", fileDescriptor_1ba887c1e2c29615) }
+
+var fileDescriptor_1ba887c1e2c29615 = []byte{
+	// 183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4e, 0xce, 0x48, 0x4d,
+	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0xc9, 0xcc, 0x4d, 0x8d, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca,
+	0xcf, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xb2, 0xcc, 0x49, 0x4c, 0xd2, 0x83,
+	0x29, 0x81, 0x33, 0x94, 0x52, 0xb9, 0xb8, 0x43, 0x32, 0x73, 0x53, 0x9d, 0x21, 0xaa, 0x85, 0xa4,
+	0xb8, 0x38, 0x72, 0xf3, 0xcb, 0x52, 0x41, 0x42, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x2c, 0x41, 0x70,
+	0xbe, 0x90, 0x0c, 0x17, 0x67, 0x49, 0x7e, 0x49, 0x62, 0x0e, 0x58, 0x92, 0x09, 0x2c, 0x89, 0x10,
+	0x00, 0xc9, 0x66, 0xe6, 0x25, 0x17, 0xa5, 0xe6, 0xa6, 0xe6, 0x95, 0x48, 0x30, 0x43, 0x64, 0xe1,
+	0x02, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
+	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e,
+	0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x76, 0xa4, 0x3e, 0xdc, 0x1f, 0x15,
+	0x08, 0x66, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x33, 0xc6, 0x80, 0x01, 0x00, 0x5c,
+	0x7d, 0x4b, 0x3b, 0xeb, 0x00, 0x00, 0x00,
+}
+
+func (m *TimeControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeControl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Increment != 0 {
+		i = encodeVarintTimeControl(dAtA, i, uint64(m.Increment))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalTime != 0 {
+		i = encodeVarintTimeControl(dAtA, i, uint64(m.TotalTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MoveTime != 0 {
+		i = encodeVarintTimeControl(dAtA, i, uint64(m.MoveTime))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTimeControl(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimeControl(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TimeControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MoveTime != 0 {
+		n += 1 + sovTimeControl(uint64(m.MoveTime))
+	}
+	if m.TotalTime != 0 {
+		n += 1 + sovTimeControl(uint64(m.TotalTime))
+	}
+	if m.Increment != 0 {
+		n += 1 + sovTimeControl(uint64(m.Increment))
+	}
+	return n
+}
+
+func sovTimeControl(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTimeControl(x uint64) (n int) {
+	return sovTimeControl(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TimeControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimeControl
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveTime", wireType)
+			}
+			m.MoveTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTime", wireType)
+			}
+			m.TotalTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			m.Increment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimeControl
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Increment |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimeControl(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimeControl
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTimeControl(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimeControl
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimeControl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimeControl
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimeControl
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimeControl
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimeControl
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimeControl        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimeControl          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimeControl = fmt.Errorf("proto: unexpected end of group")
+)
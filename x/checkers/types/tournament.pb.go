@@ -0,0 +1,1773 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: checkers/tournament.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TournamentPlayer struct {
+	Address           string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Score             uint64                                   `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Opponents         []string                                 `protobuf:"bytes,3,rep,name=opponents,proto3" json:"opponents,omitempty"`
+	HadBye            bool                                     `protobuf:"varint,4,opt,name=hadBye,proto3" json:"hadBye,omitempty"`
+	EliminatedInRound uint64                                   `protobuf:"varint,5,opt,name=eliminatedInRound,proto3" json:"eliminatedInRound,omitempty"`
+	Prize             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=prize,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prize"`
+}
+
+func (m *TournamentPlayer) Reset()         { *m = TournamentPlayer{} }
+func (m *TournamentPlayer) String() string { return proto.CompactTextString(m) }
+func (*TournamentPlayer) ProtoMessage()    {}
+func (*TournamentPlayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7d97b950688cad, []int{0}
+}
+func (m *TournamentPlayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TournamentPlayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TournamentPlayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TournamentPlayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentPlayer.Merge(m, src)
+}
+func (m *TournamentPlayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *TournamentPlayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentPlayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentPlayer proto.InternalMessageInfo
+
+func (m *TournamentPlayer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TournamentPlayer) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetOpponents() []string {
+	if m != nil {
+		return m.Opponents
+	}
+	return nil
+}
+
+func (m *TournamentPlayer) GetHadBye() bool {
+	if m != nil {
+		return m.HadBye
+	}
+	return false
+}
+
+func (m *TournamentPlayer) GetEliminatedInRound() uint64 {
+	if m != nil {
+		return m.EliminatedInRound
+	}
+	return 0
+}
+
+func (m *TournamentPlayer) GetPrize() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Prize
+	}
+	return nil
+}
+
+type Tournament struct {
+	Index            string                                   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Creator          string                                   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Format           string                                   `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	EntryFee         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=entryFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"entryFee"`
+	MaxPlayers       uint64                                   `protobuf:"varint,5,opt,name=maxPlayers,proto3" json:"maxPlayers,omitempty"`
+	Rounds           uint64                                   `protobuf:"varint,6,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	Variant          string                                   `protobuf:"bytes,7,opt,name=variant,proto3" json:"variant,omitempty"`
+	PrizeSharesBps   []uint64                                 `protobuf:"varint,8,rep,packed,name=prizeSharesBps,proto3" json:"prizeSharesBps,omitempty"`
+	Status           string                                   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CurrentRound     uint64                                   `protobuf:"varint,10,opt,name=currentRound,proto3" json:"currentRound,omitempty"`
+	Players          []TournamentPlayer                       `protobuf:"bytes,11,rep,name=players,proto3" json:"players"`
+	RoundGameIndices []string                                 `protobuf:"bytes,12,rep,name=roundGameIndices,proto3" json:"roundGameIndices,omitempty"`
+	PrizePool        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=prizePool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prizePool"`
+}
+
+func (m *Tournament) Reset()         { *m = Tournament{} }
+func (m *Tournament) String() string { return proto.CompactTextString(m) }
+func (*Tournament) ProtoMessage()    {}
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7d97b950688cad, []int{1}
+}
+func (m *Tournament) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tournament) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tournament.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tournament) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tournament.Merge(m, src)
+}
+func (m *Tournament) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tournament) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tournament.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tournament proto.InternalMessageInfo
+
+func (m *Tournament) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *Tournament) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Tournament) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *Tournament) GetEntryFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EntryFee
+	}
+	return nil
+}
+
+func (m *Tournament) GetMaxPlayers() uint64 {
+	if m != nil {
+		return m.MaxPlayers
+	}
+	return 0
+}
+
+func (m *Tournament) GetRounds() uint64 {
+	if m != nil {
+		return m.Rounds
+	}
+	return 0
+}
+
+func (m *Tournament) GetVariant() string {
+	if m != nil {
+		return m.Variant
+	}
+	return ""
+}
+
+func (m *Tournament) GetPrizeSharesBps() []uint64 {
+	if m != nil {
+		return m.PrizeSharesBps
+	}
+	return nil
+}
+
+func (m *Tournament) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Tournament) GetCurrentRound() uint64 {
+	if m != nil {
+		return m.CurrentRound
+	}
+	return 0
+}
+
+func (m *Tournament) GetPlayers() []TournamentPlayer {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+func (m *Tournament) GetRoundGameIndices() []string {
+	if m != nil {
+		return m.RoundGameIndices
+	}
+	return nil
+}
+
+func (m *Tournament) GetPrizePool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PrizePool
+	}
+	return nil
+}
+
+type TournamentStanding struct {
+	Rank              uint64                                   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Address           string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Score             uint64                                   `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Tiebreak          uint64                                   `protobuf:"varint,4,opt,name=tiebreak,proto3" json:"tiebreak,omitempty"`
+	EliminatedInRound uint64                                   `protobuf:"varint,5,opt,name=eliminatedInRound,proto3" json:"eliminatedInRound,omitempty"`
+	Prize             github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=prize,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"prize"`
+}
+
+func (m *TournamentStanding) Reset()         { *m = TournamentStanding{} }
+func (m *TournamentStanding) String() string { return proto.CompactTextString(m) }
+func (*TournamentStanding) ProtoMessage()    {}
+func (*TournamentStanding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7d97b950688cad, []int{2}
+}
+func (m *TournamentStanding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TournamentStanding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TournamentStanding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TournamentStanding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentStanding.Merge(m, src)
+}
+func (m *TournamentStanding) XXX_Size() int {
+	return m.Size()
+}
+func (m *TournamentStanding) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentStanding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentStanding proto.InternalMessageInfo
+
+func (m *TournamentStanding) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *TournamentStanding) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TournamentStanding) GetScore() uint64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *TournamentStanding) GetTiebreak() uint64 {
+	if m != nil {
+		return m.Tiebreak
+	}
+	return 0
+}
+
+func (m *TournamentStanding) GetEliminatedInRound() uint64 {
+	if m != nil {
+		return m.EliminatedInRound
+	}
+	return 0
+}
+
+func (m *TournamentStanding) GetPrize() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Prize
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TournamentPlayer)(nil), "b9lab.checkers.checkers.TournamentPlayer")
+	proto.RegisterType((*Tournament)(nil), "b9lab.checkers.checkers.Tournament")
+	proto.RegisterType((*TournamentStanding)(nil), "b9lab.checkers.checkers.TournamentStanding")
+}
+
+func init() { proto.RegisterFile("checkers/tournament.proto", fileDescriptor_bd7d97b950688cad) }
+
+var fileDescriptor_bd7d97b950688cad = []byte{
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x63, 0x37, 0x8d, 0xb7, 0x05, 0x95, 0x55, 0x05, 0xdb, 0x0a, 0xb9, 0x56, 0x0e, 0xc8,
+	0x54, 0x60, 0x53, 0x38, 0x71, 0x0d, 0x08, 0xd4, 0x5b, 0xe5, 0x72, 0xe2, 0xb6, 0xb6, 0x87, 0x64,
+	0x95, 0x78, 0xd7, 0xda, 0xdd, 0x54, 0x09, 0x77, 0x0e, 0xdc, 0x78, 0x0e, 0x9e, 0xa4, 0xc7, 0x5e,
+	0x90, 0x38, 0x01, 0x4a, 0x5e, 0x04, 0x79, 0xd7, 0xf9, 0xa1, 0x55, 0x6f, 0x3d, 0x70, 0xf2, 0x7c,
+	0x33, 0xeb, 0xd9, 0xf9, 0xbe, 0x99, 0x59, 0x74, 0x90, 0x0f, 0x21, 0x1f, 0x81, 0x54, 0x89, 0x16,
+	0x13, 0xc9, 0x69, 0x09, 0x5c, 0xc7, 0x95, 0x14, 0x5a, 0xe0, 0x47, 0xd9, 0xeb, 0x31, 0xcd, 0xe2,
+	0xe5, 0x81, 0x95, 0x71, 0xb8, 0x3f, 0x10, 0x03, 0x61, 0xce, 0x24, 0xb5, 0x65, 0x8f, 0x1f, 0x06,
+	0xb9, 0x50, 0xa5, 0x50, 0x49, 0x46, 0x15, 0x24, 0x17, 0x27, 0x19, 0x68, 0x7a, 0x92, 0xe4, 0x82,
+	0x71, 0x1b, 0xef, 0x7d, 0x6d, 0xa3, 0xbd, 0x0f, 0xab, 0x3b, 0xce, 0xc6, 0x74, 0x06, 0x12, 0x13,
+	0xb4, 0x4d, 0x8b, 0x42, 0x82, 0x52, 0xc4, 0x09, 0x9d, 0xc8, 0x4f, 0x97, 0x10, 0xef, 0xa3, 0x2d,
+	0x95, 0x0b, 0x09, 0xa4, 0x1d, 0x3a, 0x91, 0x97, 0x5a, 0x80, 0x1f, 0x23, 0x5f, 0x54, 0x95, 0xe0,
+	0xc0, 0xb5, 0x22, 0x6e, 0xe8, 0x46, 0x7e, 0xba, 0x76, 0xe0, 0x87, 0xa8, 0x33, 0xa4, 0x45, 0x7f,
+	0x06, 0xc4, 0x0b, 0x9d, 0xa8, 0x9b, 0x36, 0x08, 0x3f, 0x43, 0x0f, 0x60, 0xcc, 0x4a, 0xc6, 0xa9,
+	0x86, 0xe2, 0x94, 0xa7, 0x62, 0xc2, 0x0b, 0xb2, 0x65, 0xf2, 0xde, 0x0c, 0x60, 0x8a, 0xb6, 0x2a,
+	0xc9, 0x3e, 0x03, 0xe9, 0x84, 0x6e, 0xb4, 0xf3, 0xf2, 0x20, 0xb6, 0xc4, 0xe2, 0x9a, 0x58, 0xdc,
+	0x10, 0x8b, 0xdf, 0x08, 0xc6, 0xfb, 0x2f, 0x2e, 0x7f, 0x1d, 0xb5, 0xbe, 0xff, 0x3e, 0x8a, 0x06,
+	0x4c, 0x0f, 0x27, 0x59, 0x9c, 0x8b, 0x32, 0x69, 0x54, 0xb0, 0x9f, 0xe7, 0xaa, 0x18, 0x25, 0x7a,
+	0x56, 0x81, 0x32, 0x3f, 0xa8, 0xd4, 0x66, 0xee, 0xfd, 0xf0, 0x10, 0x5a, 0x6b, 0x51, 0x73, 0x65,
+	0xbc, 0x80, 0x69, 0xa3, 0x81, 0x05, 0xb5, 0x36, 0xb9, 0x04, 0xaa, 0x85, 0x34, 0x1a, 0xf8, 0xe9,
+	0x12, 0xd6, 0x3c, 0x3f, 0x09, 0x59, 0x52, 0x4d, 0x5c, 0x13, 0x68, 0x10, 0x1e, 0xa0, 0x2e, 0x70,
+	0x2d, 0x67, 0xef, 0xa0, 0x56, 0xe0, 0xce, 0x8b, 0x5f, 0x25, 0xc7, 0x01, 0x42, 0x25, 0x9d, 0xda,
+	0x1e, 0xaa, 0x46, 0xc9, 0x0d, 0x4f, 0x5d, 0xa0, 0xac, 0xb5, 0x54, 0xa4, 0x63, 0x62, 0x0d, 0xaa,
+	0x29, 0x5d, 0x50, 0xc9, 0x28, 0xd7, 0x64, 0xdb, 0x52, 0x6a, 0x20, 0x7e, 0x82, 0xee, 0x1b, 0x69,
+	0xce, 0x87, 0x54, 0x82, 0xea, 0x57, 0x8a, 0x74, 0x43, 0x37, 0xf2, 0xd2, 0x6b, 0xde, 0x3a, 0xb3,
+	0xd2, 0x54, 0x4f, 0x14, 0xf1, 0x2d, 0x75, 0x8b, 0x70, 0x0f, 0xed, 0xe6, 0x13, 0x29, 0x81, 0x6b,
+	0xdb, 0x5d, 0x64, 0xee, 0xfd, 0xc7, 0x87, 0x4f, 0xd1, 0x76, 0xd5, 0x94, 0xbc, 0x63, 0xd4, 0x79,
+	0x1a, 0xdf, 0x32, 0xe2, 0xf1, 0xf5, 0x41, 0xed, 0x7b, 0xb5, 0x5a, 0xe9, 0xf2, 0x7f, 0x7c, 0x8c,
+	0xf6, 0x0c, 0xa5, 0xf7, 0xb4, 0x84, 0x53, 0x5e, 0xb0, 0x1c, 0x14, 0xd9, 0x35, 0xe3, 0x78, 0xc3,
+	0x8f, 0x19, 0xf2, 0x0d, 0x89, 0x33, 0x21, 0xc6, 0xe4, 0xde, 0xdd, 0xb7, 0x65, 0x9d, 0xbd, 0xf7,
+	0xa5, 0x8d, 0xf0, 0xba, 0xf4, 0x73, 0x4d, 0x79, 0xc1, 0xf8, 0x00, 0x63, 0xe4, 0x49, 0xca, 0x47,
+	0x66, 0xbc, 0xbc, 0xd4, 0xd8, 0x9b, 0x9b, 0xd7, 0xbe, 0x65, 0xf3, 0xdc, 0xcd, 0xcd, 0x3b, 0x44,
+	0x5d, 0xcd, 0x20, 0x93, 0x40, 0x47, 0x66, 0xbb, 0xbc, 0x74, 0x85, 0xff, 0xbb, 0xfd, 0xea, 0xbf,
+	0xbd, 0x9c, 0x07, 0xce, 0xd5, 0x3c, 0x70, 0xfe, 0xcc, 0x03, 0xe7, 0xdb, 0x22, 0x68, 0x5d, 0x2d,
+	0x82, 0xd6, 0xcf, 0x45, 0xd0, 0xfa, 0x78, 0xbc, 0x91, 0xca, 0x34, 0x3f, 0x59, 0x3d, 0x80, 0xd3,
+	0xb5, 0x69, 0x52, 0x66, 0x1d, 0xf3, 0x70, 0xbd, 0xfa, 0x3b, 0x00, 0xc8, 0x82, 0xfa, 0xfa, 0x24,
+	0x05, 0x00, 0x00,
+}
+
+func (m *TournamentPlayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TournamentPlayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentPlayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prize) > 0 {
+		for iNdEx := len(m.Prize) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTournament(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EliminatedInRound != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.EliminatedInRound))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HadBye {
+		i--
+		if m.HadBye {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Opponents) > 0 {
+		for iNdEx := len(m.Opponents) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Opponents[iNdEx])
+			copy(dAtA[i:], m.Opponents[iNdEx])
+			i = encodeVarintTournament(dAtA, i, uint64(len(m.Opponents[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Score != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tournament) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tournament) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrizePool) > 0 {
+		for iNdEx := len(m.PrizePool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrizePool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTournament(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RoundGameIndices) > 0 {
+		for iNdEx := len(m.RoundGameIndices) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RoundGameIndices[iNdEx])
+			copy(dAtA[i:], m.RoundGameIndices[iNdEx])
+			i = encodeVarintTournament(dAtA, i, uint64(len(m.RoundGameIndices[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Players) > 0 {
+		for iNdEx := len(m.Players) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Players[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTournament(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.CurrentRound != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.CurrentRound))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PrizeSharesBps) > 0 {
+		dAtA2 := make([]byte, len(m.PrizeSharesBps)*10)
+		var j1 int
+		for _, num := range m.PrizeSharesBps {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTournament(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Rounds != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Rounds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxPlayers != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.MaxPlayers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EntryFee) > 0 {
+		for iNdEx := len(m.EntryFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntryFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTournament(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TournamentStanding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TournamentStanding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentStanding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prize) > 0 {
+		for iNdEx := len(m.Prize) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prize[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTournament(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EliminatedInRound != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.EliminatedInRound))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Tiebreak != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Tiebreak))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Score != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTournament(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Rank != 0 {
+		i = encodeVarintTournament(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTournament(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTournament(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TournamentPlayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovTournament(uint64(m.Score))
+	}
+	if len(m.Opponents) > 0 {
+		for _, s := range m.Opponents {
+			l = len(s)
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	if m.HadBye {
+		n += 2
+	}
+	if m.EliminatedInRound != 0 {
+		n += 1 + sovTournament(uint64(m.EliminatedInRound))
+	}
+	if len(m.Prize) > 0 {
+		for _, e := range m.Prize {
+			l = e.Size()
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Tournament) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	if len(m.EntryFee) > 0 {
+		for _, e := range m.EntryFee {
+			l = e.Size()
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	if m.MaxPlayers != 0 {
+		n += 1 + sovTournament(uint64(m.MaxPlayers))
+	}
+	if m.Rounds != 0 {
+		n += 1 + sovTournament(uint64(m.Rounds))
+	}
+	l = len(m.Variant)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	if len(m.PrizeSharesBps) > 0 {
+		l = 0
+		for _, e := range m.PrizeSharesBps {
+			l += sovTournament(uint64(e))
+		}
+		n += 1 + sovTournament(uint64(l)) + l
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	if m.CurrentRound != 0 {
+		n += 1 + sovTournament(uint64(m.CurrentRound))
+	}
+	if len(m.Players) > 0 {
+		for _, e := range m.Players {
+			l = e.Size()
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	if len(m.RoundGameIndices) > 0 {
+		for _, s := range m.RoundGameIndices {
+			l = len(s)
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	if len(m.PrizePool) > 0 {
+		for _, e := range m.PrizePool {
+			l = e.Size()
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TournamentStanding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rank != 0 {
+		n += 1 + sovTournament(uint64(m.Rank))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTournament(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovTournament(uint64(m.Score))
+	}
+	if m.Tiebreak != 0 {
+		n += 1 + sovTournament(uint64(m.Tiebreak))
+	}
+	if m.EliminatedInRound != 0 {
+		n += 1 + sovTournament(uint64(m.EliminatedInRound))
+	}
+	if len(m.Prize) > 0 {
+		for _, e := range m.Prize {
+			l = e.Size()
+			n += 1 + l + sovTournament(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTournament(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTournament(x uint64) (n int) {
+	return sovTournament(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TournamentPlayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTournament
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentPlayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentPlayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opponents", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opponents = append(m.Opponents, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HadBye", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HadBye = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EliminatedInRound", wireType)
+			}
+			m.EliminatedInRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EliminatedInRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prize = append(m.Prize, types.Coin{})
+			if err := m.Prize[len(m.Prize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTournament(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tournament) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTournament
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tournament: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tournament: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntryFee = append(m.EntryFee, types.Coin{})
+			if err := m.EntryFee[len(m.EntryFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPlayers", wireType)
+			}
+			m.MaxPlayers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPlayers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			m.Rounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rounds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTournament
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PrizeSharesBps = append(m.PrizeSharesBps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTournament
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTournament
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTournament
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PrizeSharesBps) == 0 {
+					m.PrizeSharesBps = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTournament
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PrizeSharesBps = append(m.PrizeSharesBps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizeSharesBps", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRound", wireType)
+			}
+			m.CurrentRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Players", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Players = append(m.Players, TournamentPlayer{})
+			if err := m.Players[len(m.Players)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundGameIndices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundGameIndices = append(m.RoundGameIndices, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrizePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrizePool = append(m.PrizePool, types.Coin{})
+			if err := m.PrizePool[len(m.PrizePool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTournament(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TournamentStanding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTournament
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentStanding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentStanding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiebreak", wireType)
+			}
+			m.Tiebreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tiebreak |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EliminatedInRound", wireType)
+			}
+			m.EliminatedInRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EliminatedInRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTournament
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prize = append(m.Prize, types.Coin{})
+			if err := m.Prize[len(m.Prize)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTournament(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTournament
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTournament(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTournament
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTournament
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTournament
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTournament
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTournament
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTournament        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTournament          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTournament = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,202 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/b9lab/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// How many event bus results a slow watcher can lag behind before the node drops some.
+const outCapacity = 100
+
+var (
+	moveEventType    = proto.MessageName(&types.EventMovePlayed{})
+	resignEventType  = proto.MessageName(&types.EventGameResigned{})
+	drawEventType    = proto.MessageName(&types.EventGameDrawn{})
+	rejectEventType  = proto.MessageName(&types.EventGameRejected{})
+	forfeitEventType = proto.MessageName(&types.EventGameForfeited{})
+
+	// The event bus wants a distinct subscriber per stream
+	subscriberCount uint64
+)
+
+// query picks the events of the given type, from the transactions or from the new blocks.
+func query(eventBusType string, eventType string) string {
+	return fmt.Sprintf("%s = '%s' AND %s.gameIndex EXISTS", tmtypes.EventTypeKey, eventBusType, eventType)
+}
+
+type server struct {
+	events  rpcclient.EventsClient
+	queries types.QueryClient
+}
+
+// NewServer returns a Watch server that follows the games on the event bus, and asks the queries for the game
+// as it stands when the watch starts. The events client is nil when the node has no event bus to offer.
+func NewServer(events rpcclient.EventsClient, queries types.QueryClient) types.WatchServer {
+	return &server{
+		events:  events,
+		queries: queries,
+	}
+}
+
+func (s *server) Watch(req *types.QueryWatchRequest, stream types.Watch_WatchServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if (req.GameIndex == "") == (req.Player == "") {
+		return status.Error(codes.InvalidArgument, "watch either a game index or a player")
+	}
+	if req.Player != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Player); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if s.events == nil {
+		return status.Error(codes.Unavailable, "the node has no event bus to watch")
+	}
+
+	ctx := stream.Context()
+	subscriber := fmt.Sprintf("%s-watch-%d", types.ModuleName, atomic.AddUint64(&subscriberCount, 1))
+	defer s.events.UnsubscribeAll(context.Background(), subscriber)
+	subscribe := func(eventBusType string, eventType string) (<-chan ctypes.ResultEvent, error) {
+		results, err := s.events.Subscribe(ctx, subscriber, query(eventBusType, eventType), outCapacity)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		return results, nil
+	}
+	// Forfeits happen in EndBlock, the rest in transactions
+	moves, err := subscribe(tmtypes.EventTx, moveEventType)
+	if err != nil {
+		return err
+	}
+	resigns, err := subscribe(tmtypes.EventTx, resignEventType)
+	if err != nil {
+		return err
+	}
+	draws, err := subscribe(tmtypes.EventTx, drawEventType)
+	if err != nil {
+		return err
+	}
+	rejects, err := subscribe(tmtypes.EventTx, rejectEventType)
+	if err != nil {
+		return err
+	}
+	forfeits, err := subscribe(tmtypes.EventNewBlock, forfeitEventType)
+	if err != nil {
+		return err
+	}
+
+	// Subscribed first so that nothing is missed between the snapshot and the first update
+	var snapshotHeight int64
+	if req.GameIndex != "" {
+		var header metadata.MD
+		res, err := s.queries.StoredGame(ctx, &types.QueryGetStoredGameRequest{Index: req.GameIndex}, grpc.Header(&header))
+		if err != nil {
+			return err
+		}
+		if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			snapshotHeight, err = strconv.ParseInt(heights[0], 10, 64)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+		snapshot, err := types.NewBoardUpdateFromStoredGame(res.StoredGame, snapshotHeight)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if err := stream.Send(&snapshot); err != nil {
+			return err
+		}
+	}
+
+	for {
+		var result ctypes.ResultEvent
+		var eventType string
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case result, ok = <-moves:
+			eventType = moveEventType
+		case result, ok = <-resigns:
+			eventType = resignEventType
+		case result, ok = <-draws:
+			eventType = drawEventType
+		case result, ok = <-rejects:
+			eventType = rejectEventType
+		case result, ok = <-forfeits:
+			eventType = forfeitEventType
+		}
+		if !ok {
+			return status.Error(codes.Unavailable, "the event bus closed the subscription")
+		}
+		updates, err := BoardUpdates(result.Data, eventType)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		for i := range updates {
+			// Already in the snapshot
+			if updates[i].Height <= snapshotHeight || !req.Watches(updates[i]) {
+				continue
+			}
+			if err := stream.Send(&updates[i]); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// BoardUpdates picks the events of the given type out of a transaction, or out of a new block, as published on the
+// event bus. A transaction with several types of events reaches the subscription of each, which takes only its own.
+func BoardUpdates(data tmtypes.TMEventData, eventType string) (updates []types.BoardUpdate, err error) {
+	var height int64
+	var events []abci.Event
+	switch data := data.(type) {
+	case tmtypes.EventDataTx:
+		if data.Result.IsErr() {
+			return nil, nil
+		}
+		height, events = data.Height, data.Result.Events
+	case tmtypes.EventDataNewBlock:
+		height, events = data.Block.Height, data.ResultEndBlock.Events
+	default:
+		return nil, nil
+	}
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		parsed, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		switch parsed := parsed.(type) {
+		case *types.EventMovePlayed:
+			updates = append(updates, types.NewBoardUpdateFromMove(*parsed, height))
+		case *types.EventGameResigned:
+			updates = append(updates, types.NewBoardUpdateFromResign(*parsed, height))
+		case *types.EventGameDrawn:
+			updates = append(updates, types.NewBoardUpdateFromDraw(*parsed, height))
+		case *types.EventGameRejected:
+			updates = append(updates, types.NewBoardUpdateFromReject(*parsed, height))
+		case *types.EventGameForfeited:
+			updates = append(updates, types.NewBoardUpdateFromForfeit(*parsed, height))
+		}
+	}
+	return updates, nil
+}
@@ -0,0 +1,339 @@
+package watch_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/b9lab/checkers/x/checkers/rules"
+	"github.com/b9lab/checkers/x/checkers/testutil"
+	"github.com/b9lab/checkers/x/checkers/types"
+	"github.com/b9lab/checkers/x/checkers/watch"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	alice = testutil.Alice
+	bob   = testutil.Bob
+	carol = testutil.Carol
+)
+
+var watchedEventTypes = []string{
+	proto.MessageName(&types.EventMovePlayed{}),
+	proto.MessageName(&types.EventGameResigned{}),
+	proto.MessageName(&types.EventGameDrawn{}),
+	proto.MessageName(&types.EventGameRejected{}),
+	proto.MessageName(&types.EventGameForfeited{}),
+}
+
+// eventBus has one channel per watched event type, and tells when all are subscribed.
+type eventBus struct {
+	results      map[string]chan ctypes.ResultEvent
+	subscribed   chan string
+	unsubscribed []string
+}
+
+func newEventBus() *eventBus {
+	results := make(map[string]chan ctypes.ResultEvent, len(watchedEventTypes))
+	for _, eventType := range watchedEventTypes {
+		results[eventType] = make(chan ctypes.ResultEvent, 10)
+	}
+	return &eventBus{
+		results:    results,
+		subscribed: make(chan string, len(watchedEventTypes)),
+	}
+}
+
+func (bus *eventBus) Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
+	for eventType, results := range bus.results {
+		if strings.Contains(query, fmt.Sprintf(" %s.gameIndex ", eventType)) {
+			bus.subscribed <- query
+			return results, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected query %s", query)
+}
+
+func (bus *eventBus) Unsubscribe(ctx context.Context, subscriber, query string) error {
+	return nil
+}
+
+func (bus *eventBus) UnsubscribeAll(ctx context.Context, subscriber string) error {
+	bus.unsubscribed = append(bus.unsubscribed, subscriber)
+	return nil
+}
+
+func (bus *eventBus) waitForSubscriptions() {
+	for range watchedEventTypes {
+		<-bus.subscribed
+	}
+}
+
+// publish sends the data to the subscription of the event type, as the node does for every event type it holds.
+func (bus *eventBus) publish(event proto.Message, data tmtypes.TMEventData) {
+	bus.results[proto.MessageName(event)] <- ctypes.ResultEvent{Data: data}
+}
+
+// queryClient only knows the games it is given, at the given height.
+type queryClient struct {
+	types.QueryClient
+	games  map[string]types.StoredGame
+	height string
+}
+
+func (client queryClient) StoredGame(ctx context.Context, in *types.QueryGetStoredGameRequest, opts ...grpc.CallOption) (*types.QueryGetStoredGameResponse, error) {
+	storedGame, found := client.games[in.Index]
+	if !found {
+		return nil, types.ErrGameNotFound
+	}
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, client.height)
+		}
+	}
+	return &types.QueryGetStoredGameResponse{StoredGame: storedGame}, nil
+}
+
+// stream passes on what the server sends, until the test cancels it.
+type stream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	sent   chan types.BoardUpdate
+}
+
+func newStream() *stream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &stream{ctx: ctx, cancel: cancel, sent: make(chan types.BoardUpdate, 10)}
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) Send(update *types.BoardUpdate) error {
+	s.sent <- *update
+	return nil
+}
+
+func tx(t *testing.T, height int64, typedEvents ...proto.Message) tmtypes.EventDataTx {
+	var events []abci.Event
+	for _, typedEvent := range typedEvents {
+		event, err := sdk.TypedEventToEvent(typedEvent)
+		require.Nil(t, err)
+		events = append(events, abci.Event(event))
+	}
+	return tmtypes.EventDataTx{TxResult: abci.TxResult{
+		Height: height,
+		Result: abci.ResponseDeliverTx{Events: events},
+	}}
+}
+
+func forfeitBlock(t *testing.T, height int64, forfeit types.EventGameForfeited) tmtypes.EventDataNewBlock {
+	event, err := sdk.TypedEventToEvent(&forfeit)
+	require.Nil(t, err)
+	return tmtypes.EventDataNewBlock{
+		Block:          &tmtypes.Block{Header: tmtypes.Header{Height: height}},
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{abci.Event(event)}},
+	}
+}
+
+func move(gameIndex string, black string, red string, board string) *types.EventMovePlayed {
+	return &types.EventMovePlayed{
+		Creator:   black,
+		GameIndex: gameIndex,
+		Positions: []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+		Captured:  []types.Position{},
+		Winner:    "*",
+		Board:     board,
+		Black:     black,
+		Red:       red,
+		Turn:      "r",
+		Deadline:  types.DeadlineLayout,
+	}
+}
+
+func TestBoardUpdatesFromTx(t *testing.T) {
+	updates, err := watch.BoardUpdates(tx(t, 5, move("1", alice, bob, "board1"), move("2", bob, carol, "board2")),
+		proto.MessageName(&types.EventMovePlayed{}))
+	require.Nil(t, err)
+	require.Equal(t, []types.BoardUpdate{
+		types.NewBoardUpdateFromMove(*move("1", alice, bob, "board1"), 5),
+		types.NewBoardUpdateFromMove(*move("2", bob, carol, "board2"), 5),
+	}, updates)
+}
+
+func TestBoardUpdatesFromFailedTx(t *testing.T) {
+	failed := tx(t, 5, move("1", alice, bob, "board1"))
+	failed.Result.Code = 1105
+	updates, err := watch.BoardUpdates(failed, proto.MessageName(&types.EventMovePlayed{}))
+	require.Nil(t, err)
+	require.Empty(t, updates)
+}
+
+func TestBoardUpdatesFromBlock(t *testing.T) {
+	forfeit := types.EventGameForfeited{GameIndex: "1", Winner: "r", Board: "board1", Black: alice, Red: bob}
+	updates, err := watch.BoardUpdates(forfeitBlock(t, 6, forfeit), proto.MessageName(&types.EventGameForfeited{}))
+	require.Nil(t, err)
+	require.Equal(t, []types.BoardUpdate{types.NewBoardUpdateFromForfeit(forfeit, 6)}, updates)
+}
+
+func TestBoardUpdatesFromGameEndings(t *testing.T) {
+	resign := types.EventGameResigned{Creator: alice, GameIndex: "1", Winner: "r", Board: "board1", Black: alice, Red: bob}
+	draw := types.EventGameDrawn{Creator: carol, GameIndex: "2", Winner: "d", Board: "board2", Black: bob, Red: carol}
+	reject := types.EventGameRejected{Creator: carol, GameIndex: "3", Winner: "*", Board: "board3", Black: alice, Red: carol}
+	endings := tx(t, 7, move("4", alice, bob, "board4"), &resign, &draw, &reject)
+
+	updates, err := watch.BoardUpdates(endings, proto.MessageName(&types.EventGameResigned{}))
+	require.Nil(t, err)
+	require.Equal(t, []types.BoardUpdate{types.NewBoardUpdateFromResign(resign, 7)}, updates)
+	updates, err = watch.BoardUpdates(endings, proto.MessageName(&types.EventGameDrawn{}))
+	require.Nil(t, err)
+	require.Equal(t, []types.BoardUpdate{types.NewBoardUpdateFromDraw(draw, 7)}, updates)
+	updates, err = watch.BoardUpdates(endings, proto.MessageName(&types.EventGameRejected{}))
+	require.Nil(t, err)
+	require.Equal(t, []types.BoardUpdate{types.NewBoardUpdateFromReject(reject, 7)}, updates)
+}
+
+func TestBoardUpdatesIgnoreOtherEvents(t *testing.T) {
+	created, err := sdk.TypedEventToEvent(&types.EventGameCreated{Creator: alice, GameIndex: "1"})
+	require.Nil(t, err)
+	updates, err := watch.BoardUpdates(tmtypes.EventDataTx{TxResult: abci.TxResult{
+		Height: 5,
+		Result: abci.ResponseDeliverTx{Events: []abci.Event{
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: []byte("action"), Value: []byte("create_game")}}},
+			abci.Event(created),
+		}},
+	}}, proto.MessageName(&types.EventMovePlayed{}))
+	require.Nil(t, err)
+	require.Empty(t, updates)
+}
+
+func TestBoardUpdatesBadTypedEvent(t *testing.T) {
+	_, err := watch.BoardUpdates(tmtypes.EventDataTx{TxResult: abci.TxResult{
+		Height: 5,
+		Result: abci.ResponseDeliverTx{Events: []abci.Event{{
+			Type:       proto.MessageName(&types.EventMovePlayed{}),
+			Attributes: []abci.EventAttribute{{Key: []byte("gameIndex"), Value: []byte("not json")}},
+		}}},
+	}}, proto.MessageName(&types.EventMovePlayed{}))
+	require.NotNil(t, err)
+}
+
+func TestWatchNilRequest(t *testing.T) {
+	server := watch.NewServer(newEventBus(), queryClient{})
+	err := server.Watch(nil, newStream())
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid request")
+}
+
+func TestWatchNeitherGameNorPlayer(t *testing.T) {
+	server := watch.NewServer(newEventBus(), queryClient{})
+	err := server.Watch(&types.QueryWatchRequest{}, newStream())
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = watch either a game index or a player")
+}
+
+func TestWatchBothGameAndPlayer(t *testing.T) {
+	server := watch.NewServer(newEventBus(), queryClient{})
+	err := server.Watch(&types.QueryWatchRequest{GameIndex: "1", Player: alice}, newStream())
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = watch either a game index or a player")
+}
+
+func TestWatchBadPlayer(t *testing.T) {
+	server := watch.NewServer(newEventBus(), queryClient{})
+	err := server.Watch(&types.QueryWatchRequest{Player: "cosmos1wrong"}, newStream())
+	require.EqualError(t, err, "rpc error: code = InvalidArgument desc = decoding bech32 failed: invalid separator index 6")
+}
+
+func TestWatchWithoutEventBus(t *testing.T) {
+	server := watch.NewServer(nil, queryClient{})
+	err := server.Watch(&types.QueryWatchRequest{GameIndex: "1"}, newStream())
+	require.EqualError(t, err, "rpc error: code = Unavailable desc = the node has no event bus to watch")
+}
+
+func TestWatchUnknownGame(t *testing.T) {
+	bus := newEventBus()
+	server := watch.NewServer(bus, queryClient{})
+	err := server.Watch(&types.QueryWatchRequest{GameIndex: "1"}, newStream())
+	require.EqualError(t, err, "game by id not found")
+	require.Len(t, bus.unsubscribed, 1)
+}
+
+func TestWatchGame(t *testing.T) {
+	storedGame := types.StoredGame{
+		Index:       "1",
+		Black:       alice,
+		Red:         bob,
+		PackedBoard: rules.New().Pack(),
+		Turn:        "b",
+		Deadline:    types.DeadlineLayout,
+		Winner:      "*",
+	}
+	bus := newEventBus()
+	server := watch.NewServer(bus, queryClient{
+		games:  map[string]types.StoredGame{"1": storedGame},
+		height: "4",
+	})
+	stream := newStream()
+	done := make(chan error)
+	go func() {
+		done <- server.Watch(&types.QueryWatchRequest{GameIndex: "1"}, stream)
+	}()
+	bus.waitForSubscriptions()
+	snapshot, err := types.NewBoardUpdateFromStoredGame(storedGame, 4)
+	require.Nil(t, err)
+	require.Equal(t, snapshot, <-stream.sent)
+
+	bus.publish(&types.EventMovePlayed{}, tx(t, 4, move("1", alice, bob, "already in the snapshot")))
+	bus.publish(&types.EventMovePlayed{}, tx(t, 5, move("2", alice, bob, "other game"), move("1", alice, bob, "board2")))
+	require.Equal(t, types.NewBoardUpdateFromMove(*move("1", alice, bob, "board2"), 5), <-stream.sent)
+
+	forfeit := types.EventGameForfeited{GameIndex: "1", Winner: "b", Board: "board2", Black: alice, Red: bob}
+	bus.publish(&forfeit, forfeitBlock(t, 6, forfeit))
+	require.Equal(t, types.NewBoardUpdateFromForfeit(forfeit, 6), <-stream.sent)
+
+	stream.cancel()
+	require.Nil(t, <-done)
+	require.Empty(t, stream.sent)
+	require.Len(t, bus.unsubscribed, 1)
+}
+
+func TestWatchPlayer(t *testing.T) {
+	bus := newEventBus()
+	server := watch.NewServer(bus, queryClient{})
+	stream := newStream()
+	done := make(chan error)
+	go func() {
+		done <- server.Watch(&types.QueryWatchRequest{Player: bob}, stream)
+	}()
+	bus.waitForSubscriptions()
+	bus.publish(&types.EventMovePlayed{}, tx(t, 5, move("1", alice, bob, "board1"), move("2", alice, carol, "board2")))
+	require.Equal(t, types.NewBoardUpdateFromMove(*move("1", alice, bob, "board1"), 5), <-stream.sent)
+	bus.publish(&types.EventMovePlayed{}, tx(t, 6, move("3", bob, carol, "board3")))
+	require.Equal(t, types.NewBoardUpdateFromMove(*move("3", bob, carol, "board3"), 6), <-stream.sent)
+
+	// One transaction that ends three games reaches the three subscriptions, each update is sent once
+	resign := types.EventGameResigned{Creator: bob, GameIndex: "1", Winner: "b", Board: "board1", Black: alice, Red: bob}
+	draw := types.EventGameDrawn{Creator: carol, GameIndex: "3", Winner: "d", Board: "board3", Black: bob, Red: carol}
+	reject := types.EventGameRejected{Creator: bob, GameIndex: "4", Winner: "*", Board: "board4", Black: carol, Red: bob}
+	endings := tx(t, 7, &resign, &draw, &reject)
+	bus.publish(&resign, endings)
+	require.Equal(t, types.NewBoardUpdateFromResign(resign, 7), <-stream.sent)
+	bus.publish(&draw, endings)
+	require.Equal(t, types.NewBoardUpdateFromDraw(draw, 7), <-stream.sent)
+	bus.publish(&reject, endings)
+	require.Equal(t, types.NewBoardUpdateFromReject(reject, 7), <-stream.sent)
+
+	stream.cancel()
+	require.Nil(t, <-done)
+	require.Empty(t, stream.sent)
+	require.Len(t, bus.unsubscribed, 1)
+}